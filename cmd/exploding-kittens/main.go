@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	kittens_clientserver "github.com/sweetloveinyourheart/exploding-kittens/cmd/exploding-kittens/services/clientserver"
+	kittens_gameserver "github.com/sweetloveinyourheart/exploding-kittens/cmd/exploding-kittens/services/gameserver"
 	kittens_lobbyserver "github.com/sweetloveinyourheart/exploding-kittens/cmd/exploding-kittens/services/lobbyserver"
 	kittens_userserver "github.com/sweetloveinyourheart/exploding-kittens/cmd/exploding-kittens/services/userserver"
 	kittens_utils "github.com/sweetloveinyourheart/exploding-kittens/cmd/exploding-kittens/utils"
@@ -24,8 +25,10 @@ func main() {
 
 	commands = append(commands, kittens_clientserver.Command(cmdutil.ServiceRootCmd))
 	commands = append(commands, kittens_lobbyserver.Command(cmdutil.ServiceRootCmd))
+	commands = append(commands, kittens_gameserver.Command(cmdutil.ServiceRootCmd))
 	commands = append(commands, kittens_userserver.Command(cmdutil.ServiceRootCmd))
 	commands = append(commands, kittens_utils.CheckCommand())
+	commands = append(commands, kittens_utils.SimulateCommand())
 
 	cmdutil.InitializeService(commands...)
 }
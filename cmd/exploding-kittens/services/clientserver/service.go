@@ -12,15 +12,19 @@ import (
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/chat"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/cmdutil"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/emotes"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/interceptors"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/presence"
 	"github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go/grpcconnect"
 
 	auth_interceptors "github.com/sweetloveinyourheart/exploding-kittens/pkg/interceptors/auth"
 	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+	gameServerConnect "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go/grpcconnect"
 	userServerConnect "github.com/sweetloveinyourheart/exploding-kittens/proto/code/userserver/go/grpcconnect"
 
 	"github.com/sweetloveinyourheart/exploding-kittens/services/client"
@@ -96,6 +100,15 @@ func Command(rootCmd *cobra.Command) *cobra.Command {
 	// config options
 	config.Int64Default(clientServerCommand, "clientserver.grpc.port", "grpc-port", DEFAULT_CLIENTSERVER_GRPC_PORT, "GRPC Port to listen on", "CLIENTSERVER_GRPC_PORT")
 	config.StringDefault(clientServerCommand, "clientserver.userserver.url", "userserver-url", "http://userserver:50052", "Userserver connection URL", "CLIENTSERVER_USERSERVER_URL")
+	config.StringDefault(clientServerCommand, "clientserver.gameserver.url", "gameserver-url", "http://gameserver:50054", "Gameserver connection URL", "CLIENTSERVER_GAMESERVER_URL")
+	config.Int32Default(clientServerCommand, "clientserver.game.max_streams", "game-max-streams", 50, "Maximum number of players and spectators streaming a single game, 0 for no limit", "CLIENTSERVER_GAME_MAX_STREAMS")
+	config.Int32Default(clientServerCommand, "clientserver.chat.max_length", "chat-max-length", chat.DefaultMaxLength, "Maximum number of characters of a chat message", "CLIENTSERVER_CHAT_MAX_LENGTH")
+	config.Int32Default(clientServerCommand, "clientserver.chat.rate_limit", "chat-rate-limit", 5, "Maximum number of chat messages a player can send within the rate window, 0 for no limit", "CLIENTSERVER_CHAT_RATE_LIMIT")
+	config.StringDefault(clientServerCommand, "clientserver.chat.rate_window", "chat-rate-window", "10s", "Window of the chat rate limit", "CLIENTSERVER_CHAT_RATE_WINDOW")
+	config.Int32Default(clientServerCommand, "clientserver.chat.history_size", "chat-history-size", chat.DefaultHistorySize, "Number of recent messages kept for each lobby and game chat", "CLIENTSERVER_CHAT_HISTORY_SIZE")
+	config.StringDefault(clientServerCommand, "clientserver.chat.wordlist", "chat-wordlist", "", "Comma separated words masked in the chat messages, the built-in wordlist when empty", "CLIENTSERVER_CHAT_WORDLIST")
+	config.StringDefault(clientServerCommand, "clientserver.emotes.cooldown", "emotes-cooldown", emotes.DefaultCooldown.String(), "Time a player waits between two emotes", "CLIENTSERVER_EMOTES_COOLDOWN")
+	config.StringDefault(clientServerCommand, "clientserver.presence.heartbeat", "presence-heartbeat", presence.DefaultHeartbeat.String(), "How often the open streams are refreshed in the presence bucket, a stream missing three heartbeats is closed", "CLIENTSERVER_PRESENCE_HEARTBEAT")
 
 	cmdutil.BoilerplateFlagsCore(clientServerCommand, serviceType, envPrefix)
 	cmdutil.BoilerplateFlagsNats(clientServerCommand, serviceType, envPrefix)
@@ -137,10 +150,23 @@ func setupDependencies() error {
 		)...),
 	)
 
+	gameServerClient := gameServerConnect.NewGameServerClient(
+		http.DefaultClient,
+		config.Instance().GetString("clientserver.gameserver.url"),
+		connect.WithInterceptors(interceptors.CommonConnectClientInterceptors(
+			serviceType,
+			signingKey,
+		)...),
+	)
+
 	do.Provide[userServerConnect.UserServerClient](nil, func(i *do.Injector) (userServerConnect.UserServerClient, error) {
 		return userServerClient, nil
 	})
 
+	do.Provide[gameServerConnect.GameServerClient](nil, func(i *do.Injector) (gameServerConnect.GameServerClient, error) {
+		return gameServerClient, nil
+	})
+
 	do.ProvideNamed[*pool.ConnPool](nil, string(constants.ConnectionPool),
 		func(i *do.Injector) (*pool.ConnPool, error) {
 			return connPool, nil
@@ -0,0 +1,188 @@
+package kittens_gameserver
+
+import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go"
+	pool "github.com/octu0/nats-pool"
+	"github.com/samber/do"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/cmdutil"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/db"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/interceptors"
+	"github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go/grpcconnect"
+
+	auth_interceptors "github.com/sweetloveinyourheart/exploding-kittens/pkg/interceptors/auth"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/actions"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/repos"
+)
+
+const DEFAULT_GAMESERVER_GRPC_PORT = 50054
+
+const serviceType = "gameserver"
+const dbTablePrefix = "kittens_gameserver"
+const defDBName = "kittens_gameserver"
+const envPrefix = "GAMESERVER"
+
+func Command(rootCmd *cobra.Command) *cobra.Command {
+	var gameServerCommand = &cobra.Command{
+		Use:   fmt.Sprintf("%s [flags]", serviceType),
+		Short: fmt.Sprintf("Run as %s service", serviceType),
+		Run: func(cmd *cobra.Command, args []string) {
+			app, err := cmdutil.BoilerplateRun(serviceType)
+			if err != nil {
+				log.GlobalSugared().Fatal(err)
+			}
+
+			app.Migrations(game.FS, dbTablePrefix)
+
+			if err := setupDependencies(); err != nil {
+				log.GlobalSugared().Fatal(err)
+			}
+
+			if err := game.InitializeRepos(app.Ctx()); err != nil {
+				log.GlobalSugared().Fatal(err)
+			}
+
+			signingKey := config.Instance().GetString("gameserver.secrets.token_signing_key")
+			actions := actions.NewActions(app.Ctx(), signingKey)
+
+			opt := connect.WithInterceptors(
+				interceptors.CommonConnectInterceptors(
+					serviceType,
+					signingKey,
+					interceptors.ConnectServerAuthHandler(signingKey),
+					auth_interceptors.WithOverride(actions),
+				)...,
+			)
+			path, handler := grpcconnect.NewGameServerHandler(
+				actions,
+				opt,
+			)
+			go grpc.ServeBuf(app.Ctx(), path, handler, config.Instance().GetUint64("gameserver.grpc.port"), serviceType)
+
+			app.Run()
+		},
+		Args: func(cmd *cobra.Command, args []string) error {
+			return nil
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			config.Instance().Set("service_prefix", serviceType)
+
+			cmdutil.BoilerplateMetaConfig(serviceType)
+
+			config.RegisterService(cmd, config.Service{
+				Command: cmd,
+			})
+
+			config.AddServicePort(cmd, cmd.PersistentFlags().Lookup("grpc-port"), config.ServicePort{
+				Name:         serviceType,
+				WireProtocol: "tcp",
+				Protocol:     "grpc",
+			})
+
+			config.AddDefaultServicePorts(cmd, rootCmd)
+			config.AddDefaultDatabase(cmd, defDBName)
+			return nil
+		},
+	}
+
+	// config options
+	config.Int64Default(gameServerCommand, "gameserver.grpc.port", "grpc-port", DEFAULT_GAMESERVER_GRPC_PORT, "GRPC Port to listen on", "GAMESERVER_GRPC_PORT")
+	config.StringDefault(gameServerCommand, "gameserver.game.reaction_window", "game-reaction-window", "5s", "How long players can answer an action card with a Nope", "GAMESERVER_GAME_REACTION_WINDOW")
+	config.StringDefault(gameServerCommand, "gameserver.game.turn_timeout", "game-turn-timeout", "60s", "How long players have to finish a turn before drawing for them", "GAMESERVER_GAME_TURN_TIMEOUT")
+	config.Int32Default(gameServerCommand, "gameserver.game.afk_timeouts", "game-afk-timeouts", 3, "Consecutive turn timeouts after which a player is marked AFK", "GAMESERVER_GAME_AFK_TIMEOUTS")
+	config.StringDefault(gameServerCommand, "gameserver.game.disconnect_grace", "game-disconnect-grace", "60s", "How long disconnected players have to reconnect before being eliminated", "GAMESERVER_GAME_DISCONNECT_GRACE")
+	config.BoolDefault(gameServerCommand, "gameserver.ratings.recompute", "ratings-recompute", false, "Compute the ratings again from the match history on start, after a change of the rating formula", "GAMESERVER_RATINGS_RECOMPUTE")
+
+	cmdutil.BoilerplateFlagsCore(gameServerCommand, serviceType, envPrefix)
+	cmdutil.BoilerplateFlagsNats(gameServerCommand, serviceType, envPrefix)
+	cmdutil.BoilerplateSecureFlags(gameServerCommand, serviceType)
+	cmdutil.BoilerplateFlagsDB(gameServerCommand, serviceType, envPrefix)
+
+	return gameServerCommand
+}
+
+func setupDependencies() error {
+	timeout := 2 * time.Second
+
+	dbConn, err := db.NewDbWithWait(config.Instance().GetString("gameserver.db.url"), db.DBOptions{
+		TimeoutSec:      config.Instance().GetInt("gameserver.db.postgres.timeout"),
+		MaxOpenConns:    config.Instance().GetInt("gameserver.db.postgres.max_open_connections"),
+		MaxIdleConns:    config.Instance().GetInt("gameserver.db.postgres.max_idle_connections"),
+		ConnMaxLifetime: config.Instance().GetInt("gameserver.db.postgres.max_lifetime"),
+		ConnMaxIdleTime: config.Instance().GetInt("gameserver.db.postgres.max_idletime"),
+		EnableTracing:   config.Instance().GetBool("gameserver.db.tracing"),
+	})
+	if err != nil {
+		return err
+	}
+
+	busConnection, err := nats.Connect(config.Instance().GetString("gameserver.nats.url"),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.Name("kittens/gameserver/1.0/single"),
+		nats.ErrorHandler(func(nc *nats.Conn, sub *nats.Subscription, err error) {
+			log.Global().Error("nats error", zap.String("type", "nats"), zap.Error(err))
+		}))
+	if err != nil {
+		return errors.WithStack(errors.Wrap(err, "failed to connect to  nats"))
+	}
+
+	if err := cmdutil.WaitForNatsConnection(timeout, busConnection); err != nil {
+		return errors.WithStack(errors.Wrap(err, "failed to connect to  nats"))
+	}
+
+	connPool := pool.New(100, config.Instance().GetString("gameserver.nats.url"),
+		nats.NoEcho(),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.Name("kittens/gameserver/1.0"),
+		nats.ErrorHandler(func(nc *nats.Conn, sub *nats.Subscription, err error) {
+			log.Global().Error("nats error", zap.String("type", "nats"), zap.Error(err))
+		}),
+	)
+
+	do.Provide[*pgxpool.Pool](nil, func(i *do.Injector) (*pgxpool.Pool, error) {
+		return dbConn, nil
+	})
+
+	matchRepo := repos.NewMatchRepository(dbConn)
+	do.Provide[repos.IMatchRepository](nil, func(i *do.Injector) (repos.IMatchRepository, error) {
+		return matchRepo, nil
+	})
+
+	ratingRepo := repos.NewRatingRepository(dbConn)
+	do.Provide[repos.IRatingRepository](nil, func(i *do.Injector) (repos.IRatingRepository, error) {
+		return ratingRepo, nil
+	})
+
+	achievementRepo := repos.NewAchievementRepository(dbConn)
+	do.Provide[repos.IAchievementRepository](nil, func(i *do.Injector) (repos.IAchievementRepository, error) {
+		return achievementRepo, nil
+	})
+
+	do.ProvideNamed[*pool.ConnPool](nil, string(constants.ConnectionPool),
+		func(i *do.Injector) (*pool.ConnPool, error) {
+			return connPool, nil
+		})
+
+	do.ProvideNamed[*nats.Conn](nil, fmt.Sprintf("%s-conn", string(constants.Bus)),
+		func(i *do.Injector) (*nats.Conn, error) {
+			return busConnection, nil
+		})
+
+	return nil
+}
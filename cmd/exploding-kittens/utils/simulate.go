@@ -0,0 +1,93 @@
+package kittens_utils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/simulator"
+)
+
+var (
+	simulateGames      int
+	simulateBots       []string
+	simulateExpansions []string
+	simulateDefuses    int
+	simulateNopeDefuse bool
+	simulateRemoved    []string
+	simulateMaxMoves   int
+	simulateFormat     string
+	simulateOutput     string
+)
+
+var simulateCmd = &cobra.Command{
+	Use:     `simulate`,
+	Short:   "Simulate bot games",
+	Long:    "Plays headless games between bots in-process, without a NATS cluster, and reports win rates by seat, game length, card usage and elimination causes",
+	Example: `  simulate --games=5000 --bots=EASY,HARD,HARD --expansions=IMPLODING_KITTENS --defuses=2 --remove-cards=NOPE --format=csv --output=stats.csv`,
+	RunE:    runSimulate,
+}
+
+func SimulateCommand() *cobra.Command {
+	simulateCmd.Flags().IntVar(&simulateGames, "games", 1000, "number of games to play")
+	simulateCmd.Flags().StringSliceVar(&simulateBots, "bots", []string{"HARD", "HARD", "HARD"}, "difficulty of the bot of each seat, in turn order")
+	simulateCmd.Flags().StringSliceVar(&simulateExpansions, "expansions", nil, "expansions shuffled into the deck")
+	simulateCmd.Flags().IntVar(&simulateDefuses, "defuses", game.DefaultDefuses, "number of Defuses dealt to each player")
+	simulateCmd.Flags().BoolVar(&simulateNopeDefuse, "nope-defuse", false, "let the players Nope a Defuse")
+	simulateCmd.Flags().StringSliceVar(&simulateRemoved, "remove-cards", nil, "cards taken out of the deck")
+	simulateCmd.Flags().IntVar(&simulateMaxMoves, "max-moves", simulator.DefaultMaxMoves, "moves after which a game is reported as unfinished")
+	simulateCmd.Flags().StringVar(&simulateFormat, "format", "json", "report format, json or csv")
+	simulateCmd.Flags().StringVar(&simulateOutput, "output", "", "file the report is written to, stdout when empty")
+
+	return simulateCmd
+}
+
+func runSimulate(cmd *cobra.Command, args []string) error {
+	format := strings.ToLower(strings.TrimSpace(simulateFormat))
+	if format != "json" && format != "csv" {
+		return fmt.Errorf("unknown format %q, expected json or csv", simulateFormat)
+	}
+
+	config := simulator.Config{
+		Games:    simulateGames,
+		MaxMoves: simulateMaxMoves,
+		Rules: game.GameRules{
+			Defuses:    simulateDefuses,
+			NopeDefuse: simulateNopeDefuse,
+		},
+	}
+	for _, bot := range simulateBots {
+		config.Bots = append(config.Bots, game.BotDifficulty(strings.ToUpper(strings.TrimSpace(bot))))
+	}
+	for _, expansion := range simulateExpansions {
+		config.Expansions = append(config.Expansions, game.Expansion(strings.ToUpper(strings.TrimSpace(expansion))))
+	}
+	for _, card := range simulateRemoved {
+		config.Rules.RemovedCards = append(config.Rules.RemovedCards, game.CardType(strings.ToUpper(strings.TrimSpace(card))))
+	}
+
+	report, err := simulator.Run(cmd.Context(), config)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if simulateOutput != "" {
+		file, err := os.Create(simulateOutput)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		out = file
+	}
+
+	if format == "csv" {
+		return report.WriteCSV(out)
+	}
+
+	return report.WriteJSON(out)
+}
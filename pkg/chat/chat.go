@@ -0,0 +1,94 @@
+package chat
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+)
+
+const (
+	// DefaultMaxLength is the default maximum number of characters of a message.
+	DefaultMaxLength = 280
+	// DefaultHistorySize is the default number of recent messages kept for each channel.
+	DefaultHistorySize = 100
+)
+
+var (
+	ErrInvalidChannel = errors.New("invalid chat channel")
+	ErrEmptyMessage   = errors.New("empty chat message")
+	ErrMessageTooLong = errors.New("chat message too long")
+	ErrRateLimited    = errors.New("too many chat messages")
+)
+
+// ChannelType tells whether a channel is the chat of a lobby or of a game.
+type ChannelType string
+
+const (
+	ChannelTypeLobby ChannelType = "LOBBY"
+	ChannelTypeGame  ChannelType = "GAME"
+)
+
+func (t ChannelType) String() string {
+	return string(t)
+}
+
+func (t ChannelType) IsValid() bool {
+	return t == ChannelTypeLobby || t == ChannelTypeGame
+}
+
+// Channel is the chat of a lobby or of a game, its id is the id of the lobby or the game.
+type Channel struct {
+	Type ChannelType `json:"type"`
+	ID   uuid.UUID   `json:"id"`
+}
+
+// ParseChannel reads a channel from its type and id.
+func ParseChannel(channelType string, id string) (Channel, error) {
+	channel := Channel{
+		Type: ChannelType(strings.ToUpper(strings.TrimSpace(channelType))),
+	}
+	if !channel.Type.IsValid() {
+		return Channel{}, ErrInvalidChannel
+	}
+
+	var err error
+	if channel.ID, err = uuid.FromString(strings.TrimSpace(id)); err != nil || channel.ID == uuid.Nil {
+		return Channel{}, ErrInvalidChannel
+	}
+
+	return channel, nil
+}
+
+// Subject is the NATS subject the messages of the channel are published on.
+func (c Channel) Subject() string {
+	return fmt.Sprintf("%s.%s.%s", constants.ChatStream, strings.ToLower(c.Type.String()), c.ID)
+}
+
+// Message is a text message sent to a channel.
+type Message struct {
+	MessageID uuid.UUID `json:"message_id"`
+	Channel   Channel   `json:"channel"`
+	UserID    uuid.UUID `json:"user_id"`
+	Text      string    `json:"text"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Text trims a message and checks it is neither empty nor longer than maxLength characters.
+func Text(text string, maxLength int) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", ErrEmptyMessage
+	}
+
+	if maxLength > 0 && utf8.RuneCountInString(text) > maxLength {
+		return "", ErrMessageTooLong
+	}
+
+	return text, nil
+}
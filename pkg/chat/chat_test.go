@@ -0,0 +1,108 @@
+package chat_test
+
+import (
+	"context"
+	goTesting "testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/chat"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+)
+
+type ChatSuite struct {
+	*testing.Suite
+}
+
+func TestChatSuite(t *goTesting.T) {
+	cs := &ChatSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, cs)
+}
+
+func (cs *ChatSuite) Test_ParseChannel() {
+	lobbyID := uuid.Must(uuid.NewV7())
+
+	channel, err := chat.ParseChannel(" lobby ", lobbyID.String())
+	cs.NoError(err)
+	cs.Equal(chat.Channel{Type: chat.ChannelTypeLobby, ID: lobbyID}, channel)
+	cs.Equal("kittens-chat.lobby."+lobbyID.String(), channel.Subject())
+
+	_, err = chat.ParseChannel("TEAM", lobbyID.String())
+	cs.ErrorIs(err, chat.ErrInvalidChannel)
+
+	_, err = chat.ParseChannel("GAME", uuid.Nil.String())
+	cs.ErrorIs(err, chat.ErrInvalidChannel)
+}
+
+func (cs *ChatSuite) Test_Text() {
+	text, err := chat.Text("  meow  ", 4)
+	cs.NoError(err)
+	cs.Equal("meow", text)
+
+	// The length is counted in characters, not bytes.
+	_, err = chat.Text("ニャー", 3)
+	cs.NoError(err)
+
+	_, err = chat.Text("meows", 4)
+	cs.ErrorIs(err, chat.ErrMessageTooLong)
+
+	_, err = chat.Text(" \n ", 4)
+	cs.ErrorIs(err, chat.ErrEmptyMessage)
+}
+
+func (cs *ChatSuite) Test_Filter_MasksWholeWords() {
+	filter := chat.NewFilter([]string{" Darn ", "heck"})
+
+	cs.Equal("**** it, what the ****!", filter.Clean("Darn it, what the HECK!"))
+	cs.Equal("darned heckle", filter.Clean("darned heckle"))
+	cs.Equal("no words", chat.NewFilter(nil).Clean("no words"))
+}
+
+func (cs *ChatSuite) Test_RateLimiter_SlidingWindow() {
+	timeutil.MockClock()
+
+	userID, otherID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	limiter := chat.NewRateLimiter(2, 10*time.Second)
+
+	cs.True(limiter.Allow(userID))
+	timeutil.MockedClock.Add(5 * time.Second)
+	cs.True(limiter.Allow(userID))
+	cs.False(limiter.Allow(userID))
+	cs.True(limiter.Allow(otherID))
+
+	// The first message leaves the window.
+	timeutil.MockedClock.Add(5 * time.Second)
+	cs.True(limiter.Allow(userID))
+	cs.False(limiter.Allow(userID))
+}
+
+func (cs *ChatSuite) Test_RateLimiter_NoLimit() {
+	limiter := chat.NewRateLimiter(0, time.Second)
+
+	for range 10 {
+		cs.True(limiter.Allow(uuid.Must(uuid.NewV7())))
+	}
+}
+
+func (cs *ChatSuite) Test_SharedRateLimiter_NoLimit() {
+	// Without a limit the bucket is never created nor read.
+	for _, limiter := range []struct {
+		limit  int
+		window time.Duration
+	}{{0, time.Second}, {1, 0}} {
+		shared, err := chat.NewSharedRateLimiter(context.Background(), nil, "unused", limiter.limit, limiter.window)
+		cs.NoError(err)
+
+		for range 3 {
+			allowed, err := shared.Allow(context.Background(), uuid.Must(uuid.NewV7()))
+			cs.NoError(err)
+			cs.True(allowed)
+		}
+	}
+}
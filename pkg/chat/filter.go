@@ -0,0 +1,63 @@
+package chat
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DefaultWordlist are the words masked when no wordlist is configured.
+var DefaultWordlist = []string{
+	"arse", "arsehole", "asshole", "bastard", "bitch", "bollocks", "bullshit", "cock", "cunt", "dick",
+	"fuck", "fucker", "fucking", "motherfucker", "piss", "prick", "pussy", "shit", "slut", "twat", "wanker", "whore",
+}
+
+// Filter masks the words of a wordlist in the messages, whatever their case.
+type Filter struct {
+	words map[string]struct{}
+}
+
+func NewFilter(words []string) *Filter {
+	filter := &Filter{
+		words: make(map[string]struct{}, len(words)),
+	}
+	for _, word := range words {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			filter.words[word] = struct{}{}
+		}
+	}
+
+	return filter
+}
+
+// Clean replaces every character of the listed words with a star, only whole words are matched.
+func (f *Filter) Clean(text string) string {
+	if len(f.words) == 0 {
+		return text
+	}
+
+	runes := []rune(text)
+	for start := 0; start < len(runes); {
+		if !isWordRune(runes[start]) {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+
+		if _, ok := f.words[strings.ToLower(string(runes[start:end]))]; ok {
+			for i := start; i < end; i++ {
+				runes[i] = '*'
+			}
+		}
+		start = end
+	}
+
+	return string(runes)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
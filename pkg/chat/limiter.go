@@ -0,0 +1,175 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+)
+
+// sharedLimiterAttempts bounds the retries of a shared limiter when other servers record messages
+// of the same user at once.
+const sharedLimiterAttempts = 5
+
+// RateLimiter lets a user send at most limit messages within any window, across all the channels.
+// It only counts the messages sent through this server, SharedRateLimiter counts them on all servers.
+type RateLimiter struct {
+	limit  int
+	window time.Duration
+
+	mu   sync.Mutex
+	sent map[uuid.UUID][]time.Time
+}
+
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:  limit,
+		window: window,
+		sent:   make(map[uuid.UUID][]time.Time),
+	}
+}
+
+// Allow records a message of the user unless the user already sent limit messages within the window,
+// a limit of 0 means no limit.
+func (l *RateLimiter) Allow(userID uuid.UUID) bool {
+	if l.limit <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := timeutil.Clock.Now()
+
+	// The users who stopped chatting are forgotten, so the map only holds the recent senders.
+	for id, sent := range l.sent {
+		if !sent[len(sent)-1].After(now.Add(-l.window)) {
+			delete(l.sent, id)
+		}
+	}
+
+	sent := recent(l.sent[userID], now, l.window)
+	if len(sent) >= l.limit {
+		l.sent[userID] = sent
+		return false
+	}
+
+	l.sent[userID] = append(sent, now)
+	return true
+}
+
+// SharedRateLimiter is a RateLimiter shared by every server through a JetStream key-value bucket.
+// The recent messages of a user are kept under their key, which is only updated at the revision it
+// was read at, and they expire with the window.
+type SharedRateLimiter struct {
+	kv     jetstream.KeyValue
+	limit  int
+	window time.Duration
+}
+
+// NewSharedRateLimiter creates or updates the bucket of a limiter, a limit or a window of 0 means no limit.
+func NewSharedRateLimiter(ctx context.Context, js jetstream.JetStream, bucket string, limit int, window time.Duration) (*SharedRateLimiter, error) {
+	limiter := &SharedRateLimiter{
+		limit:  limit,
+		window: window,
+	}
+	if limit <= 0 || window <= 0 {
+		limiter.limit = 0
+		return limiter, nil
+	}
+
+	replicas := 1
+	if reps := config.Instance().GetInt(config.NatsStreamReplicas); reps > 0 {
+		replicas = reps
+	}
+
+	storage := jetstream.FileStorage
+	if ss := config.Instance().GetString(config.NatsStreamStorage); strings.EqualFold(ss, "memory") {
+		storage = jetstream.MemoryStorage
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:   bucket,
+		TTL:      window,
+		Storage:  storage,
+		Replicas: replicas,
+	})
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("could not create rate limit bucket %s: %w", bucket, err))
+	}
+
+	limiter.kv = kv
+	return limiter, nil
+}
+
+// Allow records a message of the user unless the user already sent limit messages within the window
+// through any server.
+func (l *SharedRateLimiter) Allow(ctx context.Context, userID uuid.UUID) (bool, error) {
+	if l.limit <= 0 {
+		return true, nil
+	}
+
+	key := userID.String()
+	for range sharedLimiterAttempts {
+		var sent []time.Time
+		var revision uint64
+
+		entry, err := l.kv.Get(ctx, key)
+		switch {
+		case errors.Is(err, jetstream.ErrKeyNotFound):
+		case err != nil:
+			return false, err
+		default:
+			if err := json.Unmarshal(entry.Value(), &sent); err != nil {
+				return false, err
+			}
+			revision = entry.Revision()
+		}
+
+		now := timeutil.Clock.Now()
+		sent = recent(sent, now, l.window)
+		if len(sent) >= l.limit {
+			return false, nil
+		}
+
+		data, err := json.Marshal(append(sent, now))
+		if err != nil {
+			return false, err
+		}
+
+		// The update fails when another server recorded a message of the user since it was read.
+		_, err = l.kv.Update(ctx, key, data, revision)
+		if err == nil {
+			return true, nil
+		}
+
+		var apiErr *jetstream.APIError
+		if !errors.As(err, &apiErr) || apiErr.ErrorCode != jetstream.JSErrCodeStreamWrongLastSequence {
+			return false, err
+		}
+	}
+
+	// The user keeps sending messages through several servers at once.
+	return false, nil
+}
+
+// recent returns the messages sent within the window.
+func recent(sent []time.Time, now time.Time, window time.Duration) []time.Time {
+	for len(sent) > 0 && !sent[0].After(now.Add(-window)) {
+		sent = sent[1:]
+	}
+
+	return sent
+}
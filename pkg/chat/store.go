@@ -0,0 +1,119 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+)
+
+// Store keeps the recent messages of every channel in a JetStream stream. The lobbies are never
+// removed, so neither is the history of their channels, only the oldest messages beyond the
+// history size are dropped.
+type Store struct {
+	js jetstream.JetStream
+}
+
+// NewStore creates or updates the chat stream, keeping historySize messages for each channel.
+func NewStore(ctx context.Context, js jetstream.JetStream, historySize int) (*Store, error) {
+	replicas := 1
+	if reps := config.Instance().GetInt(config.NatsStreamReplicas); reps > 0 {
+		replicas = reps
+	}
+
+	storage := jetstream.FileStorage
+	if ss := config.Instance().GetString(config.NatsStreamStorage); strings.EqualFold(ss, "memory") {
+		storage = jetstream.MemoryStorage
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if _, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:              constants.ChatStream,
+		Subjects:          []string{constants.ChatStream + ".>"},
+		Storage:           storage,
+		Retention:         jetstream.LimitsPolicy,
+		Replicas:          replicas,
+		MaxMsgsPerSubject: int64(historySize),
+		Discard:           jetstream.DiscardOld,
+	}); err != nil {
+		return nil, errors.WithStack(fmt.Errorf("could not create chat stream: %w", err))
+	}
+
+	return &Store{
+		js: js,
+	}, nil
+}
+
+// Publish sends a message to its channel.
+func (s *Store) Publish(ctx context.Context, message *Message) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.js.Publish(ctx, message.Channel.Subject(), data)
+	return err
+}
+
+// Subscribe delivers the recent messages of a channel, then the new ones as they are sent, until
+// the context is done.
+func (s *Store) Subscribe(ctx context.Context, channel Channel) (<-chan *Message, error) {
+	consumer, err := s.js.OrderedConsumer(ctx, constants.ChatStream, jetstream.OrderedConsumerConfig{
+		FilterSubjects:    []string{channel.Subject()},
+		DeliverPolicy:     jetstream.DeliverAllPolicy,
+		InactiveThreshold: 10 * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	iterator, err := consumer.Messages()
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		iterator.Stop()
+	}()
+
+	messages := make(chan *Message, constants.NatsChannelBufferSize)
+	go func() {
+		defer close(messages)
+
+		for {
+			msg, err := iterator.Next()
+			if err != nil {
+				if !errors.Is(err, jetstream.ErrMsgIteratorClosed) {
+					log.Global().WarnContext(ctx, "chat subscription closed", zap.String("subject", channel.Subject()), zap.Error(err))
+				}
+				return
+			}
+
+			var message Message
+			if err := json.Unmarshal(msg.Data(), &message); err != nil {
+				log.Global().WarnContext(ctx, "failed to read chat message", zap.String("subject", channel.Subject()), zap.Error(err))
+				continue
+			}
+
+			select {
+			case messages <- &message:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return messages, nil
+}
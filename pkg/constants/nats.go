@@ -11,6 +11,44 @@ const LobbyRoot = "lobby"
 
 const LobbyStream = ServicePrefix + "-" + LobbyRoot
 
+const GameRoot = "game"
+
+const GameStream = ServicePrefix + "-" + GameRoot
+
+// ActiveGameBucket is the key-value bucket of the games that are not finished yet, shared by the gameservers.
+const ActiveGameBucket = GameStream + "-active"
+
+const UserRoot = "user"
+
+// UserStream is the root of the NATS subjects of the notifications sent to a single user.
+const UserStream = ServicePrefix + "-" + UserRoot
+
+const ChatRoot = "chat"
+
+// ChatStream keeps the recent messages of the lobby and game chats.
+const ChatStream = ServicePrefix + "-" + ChatRoot
+
+// ChatLimitBucket is the key-value bucket of the chat messages each user recently sent, shared by the clientservers.
+const ChatLimitBucket = ChatStream + "-limit"
+
+const EmoteRoot = "emote"
+
+// EmoteStream is the root of the NATS core subjects of the emotes, no JetStream stream captures them.
+const EmoteStream = ServicePrefix + "-" + EmoteRoot
+
+// EmoteLimitBucket is the key-value bucket of the emotes each user recently sent, shared by the clientservers.
+const EmoteLimitBucket = EmoteStream + "-limit"
+
+const PresenceRoot = "presence"
+
+// PresenceBucket is the key-value bucket of the streams open on the games, shared by the clientservers.
+const PresenceBucket = ServicePrefix + "-" + PresenceRoot
+
+const MuteRoot = "mute"
+
+// MuteBucket is the key-value bucket of the players each user muted in their games.
+const MuteBucket = ServicePrefix + "-" + MuteRoot
+
 var ConnectionPool = connectionPoolKey("connectionPool")
 
 const NatsChannelBufferSize = 1000
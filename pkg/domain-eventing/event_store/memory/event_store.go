@@ -0,0 +1,173 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+)
+
+// EventStore keeps the events of the aggregates in memory, it is meant for tests, tools and simulations
+// running the aggregates without a NATS cluster.
+type EventStore struct {
+	db   map[string][]common.Event
+	dbMu sync.RWMutex
+
+	eventHandler eventing.EventHandler
+}
+
+// WithEventHandler adds an event handler that will be called when saving events.
+func WithEventHandler(h eventing.EventHandler) Option {
+	return func(s *EventStore) error {
+		s.eventHandler = h
+
+		return nil
+	}
+}
+
+// NewEventStore creates a new EventStore using memory as storage.
+func NewEventStore(options ...Option) (*EventStore, error) {
+	s := &EventStore{
+		db: make(map[string][]common.Event),
+	}
+
+	for _, option := range options {
+		if err := option(s); err != nil {
+			return nil, errors.WithStack(fmt.Errorf("error while applying option: %v", err))
+		}
+	}
+
+	return s, nil
+}
+
+var _ eventing.EventStore = (*EventStore)(nil)
+
+// Option is an option setter used to configure creation.
+type Option func(*EventStore) error
+
+// Close implements the Close method of the eventing.EventStore interface.
+func (s *EventStore) Close() error {
+	return nil
+}
+
+// Save implements the Save method of the eventing.EventStore interface.
+func (s *EventStore) Save(ctx context.Context, events []common.Event, originalVersion uint64) error {
+	if len(events) == 0 {
+		return &eventing.EventStoreError{
+			Err: eventing.ErrMissingEvents,
+			Op:  eventing.EventStoreOpSave,
+		}
+	}
+
+	id := events[0].AggregateID()
+	at := events[0].AggregateType()
+	for i, event := range events {
+		// Only accept events belonging to the same aggregate.
+		if event.AggregateID() != id {
+			return &eventing.EventStoreError{
+				Err:              eventing.ErrMismatchedEventAggregateIDs,
+				Op:               eventing.EventStoreOpSave,
+				AggregateType:    at,
+				AggregateID:      id,
+				AggregateVersion: originalVersion,
+				Events:           events,
+			}
+		}
+
+		if event.AggregateType() != at {
+			return &eventing.EventStoreError{
+				Err:              eventing.ErrMismatchedEventAggregateTypes,
+				Op:               eventing.EventStoreOpSave,
+				AggregateType:    at,
+				AggregateID:      id,
+				AggregateVersion: originalVersion,
+				Events:           events,
+			}
+		}
+
+		// The events must follow the version the aggregate was loaded at.
+		if event.Version() != originalVersion+uint64(i)+1 {
+			return &eventing.EventStoreError{
+				Err:              eventing.ErrIncorrectEventVersion,
+				Op:               eventing.EventStoreOpSave,
+				AggregateType:    at,
+				AggregateID:      id,
+				AggregateVersion: originalVersion,
+				Events:           events,
+			}
+		}
+	}
+
+	s.dbMu.Lock()
+	defer s.dbMu.Unlock()
+
+	if uint64(len(s.db[id])) != originalVersion {
+		return &eventing.EventStoreError{
+			Err:              eventing.ErrEventConflictFromOtherSave,
+			Op:               eventing.EventStoreOpSave,
+			AggregateType:    at,
+			AggregateID:      id,
+			AggregateVersion: originalVersion,
+			Events:           events,
+		}
+	}
+
+	// Let the optional event handler handle the events. Aborts the save in case of error.
+	if s.eventHandler != nil {
+		for _, e := range events {
+			if err := s.eventHandler.HandleEvent(ctx, e); err != nil {
+				return &eventing.EventStoreError{
+					Err: err,
+					Op:  eventing.EventStoreOpSave,
+				}
+			}
+		}
+	}
+
+	s.db[id] = append(s.db[id], events...)
+
+	return nil
+}
+
+// Load implements the Load method of the eventing.EventStore interface.
+func (s *EventStore) Load(ctx context.Context, id string) ([]common.Event, error) {
+	return s.LoadFrom(ctx, id, 1)
+}
+
+// LoadFrom loads all events from version for the aggregate id from the store.
+func (s *EventStore) LoadFrom(ctx context.Context, id string, version uint64) ([]common.Event, error) {
+	s.dbMu.RLock()
+	defer s.dbMu.RUnlock()
+
+	events, ok := s.db[id]
+	if !ok {
+		return nil, &eventing.EventStoreError{
+			Err:         eventing.ErrAggregateNotFound,
+			Op:          eventing.EventStoreOpLoad,
+			AggregateID: id,
+		}
+	}
+
+	if version == 0 {
+		version = 1
+	}
+
+	if version > uint64(len(events)) {
+		return []common.Event{}, nil
+	}
+
+	return slices.Clone(events[version-1:]), nil
+}
+
+// Delete removes the events of an aggregate from the store.
+func (s *EventStore) Delete(id string) {
+	s.dbMu.Lock()
+	defer s.dbMu.Unlock()
+
+	delete(s.db, id)
+}
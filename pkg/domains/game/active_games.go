@@ -0,0 +1,91 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+)
+
+// ActiveGames indexes the games that are not finished yet, so the scheduler only loads those
+// when it starts.
+type ActiveGames interface {
+	// Add records a game as active, adding it again does nothing.
+	Add(ctx context.Context, gameID uuid.UUID) error
+	// Remove drops a finished game from the index.
+	Remove(ctx context.Context, gameID uuid.UUID) error
+	// List returns the active games.
+	List(ctx context.Context) ([]uuid.UUID, error)
+}
+
+// kvActiveGames keeps the active games in a JetStream key-value bucket shared by the gameservers,
+// each active game has a key.
+type kvActiveGames struct {
+	kv jetstream.KeyValue
+}
+
+var _ ActiveGames = (*kvActiveGames)(nil)
+
+// NewActiveGames creates or updates the bucket of the active games.
+func NewActiveGames(ctx context.Context, js jetstream.JetStream) (ActiveGames, error) {
+	replicas := 1
+	if reps := config.Instance().GetInt(config.NatsStreamReplicas); reps > 0 {
+		replicas = reps
+	}
+
+	storage := jetstream.FileStorage
+	if ss := config.Instance().GetString(config.NatsStreamStorage); strings.EqualFold(ss, "memory") {
+		storage = jetstream.MemoryStorage
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:   constants.ActiveGameBucket,
+		Storage:  storage,
+		Replicas: replicas,
+	})
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("could not create active game bucket: %w", err))
+	}
+
+	return &kvActiveGames{
+		kv: kv,
+	}, nil
+}
+
+func (g *kvActiveGames) Add(ctx context.Context, gameID uuid.UUID) error {
+	_, err := g.kv.Put(ctx, gameID.String(), nil)
+	return err
+}
+
+func (g *kvActiveGames) Remove(ctx context.Context, gameID uuid.UUID) error {
+	return g.kv.Purge(ctx, gameID.String())
+}
+
+func (g *kvActiveGames) List(ctx context.Context) ([]uuid.UUID, error) {
+	lister, err := g.kv.ListKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = lister.Stop() }()
+
+	var gameIDs []uuid.UUID
+	for key := range lister.Keys() {
+		gameID, err := uuid.FromString(key)
+		if err != nil {
+			continue
+		}
+		gameIDs = append(gameIDs, gameID)
+	}
+
+	return gameIDs, nil
+}
@@ -0,0 +1,561 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/aggregate"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+)
+
+var SubjectFactory common.EventSubject
+
+func init() {
+	eventing.RegisterAggregate[Aggregate, *Aggregate]()
+	type gameIDer interface {
+		GetGameID() uuid.UUID
+	}
+
+	subjectFunc := func(ctx context.Context, event common.Event) string {
+		if event == nil {
+			return ""
+		}
+
+		if a, ok := event.Data().(gameIDer); ok {
+			return fmt.Sprintf("%s.%s.%s", event.AggregateType(), a.GetGameID(), event.AggregateID())
+		}
+
+		return ""
+	}
+
+	subjectRootFunc := func(ctx context.Context, event common.Event) string {
+		if event == nil {
+			return ""
+		}
+		return string(event.AggregateType())
+	}
+
+	subjectTokenFunc := func(ctx context.Context, event common.Event) []common.EventSubjectToken {
+		if event == nil {
+			return []common.EventSubjectToken{
+				eventing.NewEventSubjectToken("aggregate_type", "Aggregate Type", AggregateType, 0),
+				eventing.NewEventSubjectToken("game_id", "Game ID", uuid.Nil, 1),
+				eventing.NewEventSubjectToken("aggregate_id", "Aggregate ID", uuid.Nil, 2),
+			}
+		}
+		if a, ok := event.Data().(gameIDer); ok {
+			return []common.EventSubjectToken{
+				eventing.NewEventSubjectToken("aggregate_type", "Aggregate Type", AggregateType, 0),
+				eventing.NewEventSubjectToken("game_id", "Game ID", a.GetGameID(), 1),
+				eventing.NewEventSubjectToken("aggregate_id", "Aggregate ID", event.AggregateID(), 2),
+			}
+		}
+		return nil
+	}
+	SubjectFactory = eventing.NewEventSubjectFactory(
+		subjectFunc,
+		subjectRootFunc,
+		2,
+		subjectTokenFunc)
+	registerEvents(subjectFunc, subjectRootFunc, 2, subjectTokenFunc)
+}
+
+const AggregateType = common.AggregateType("game")
+
+type Aggregate struct {
+	*aggregate.AggregateBase
+
+	currentGameID uuid.UUID
+	state         Game
+}
+
+var _ eventing.Aggregate = (*Aggregate)(nil)
+var _ eventing.OnCreateHook = (*Aggregate)(nil)
+
+// TimeNow is a mockable version of time.Now.
+var TimeNow = timeutil.NowRoundedForGranularity
+
+func (a *Aggregate) OnCreate(id string) {
+	a.AggregateBase = aggregate.NewAggregateBase(AggregateType, SubjectFactory, id)
+}
+
+func (a *Aggregate) validateCommand(cmd eventing.Command) error {
+	if versioned, ok := cmd.(versionedCommand); ok {
+		if version := versioned.GetExpectedVersion(); version != 0 && version != a.state.Version {
+			return ErrOutdatedCommand
+		}
+	}
+
+	switch typed := cmd.(type) {
+	case *CreateGame:
+		// An aggregate can only be created once.
+		if a.currentGameID == typed.GameID {
+			return ErrGameAlreadyCreated
+		}
+
+		if !a.currentGameID.IsNil() {
+			return ErrGameAlreadyCreated
+		}
+	case *DrawCard:
+		if err := a.validateTurnAction(typed.PlayerID); err != nil {
+			return err
+		}
+
+		if len(a.state.DrawPile) == 0 {
+			return ErrDrawPileEmpty
+		}
+	case *PlayCard:
+		if isReactionCard(typed.Card) {
+			if err := a.validatePlayer(typed.PlayerID); err != nil {
+				return err
+			}
+		} else {
+			if err := a.validateTurnAction(typed.PlayerID); err != nil {
+				return err
+			}
+		}
+
+		if !a.state.HasCard(typed.PlayerID, typed.Card) {
+			return ErrCardNotInHand
+		}
+
+		rule, ok := cardRule(typed.Card)
+		if !ok {
+			return ErrCardNotPlayable
+		}
+
+		if err := rule.Validate(&a.state, typed); err != nil {
+			return err
+		}
+	case *PlayCombo:
+		if err := a.validateTurnAction(typed.PlayerID); err != nil {
+			return err
+		}
+
+		if !a.state.HasCards(typed.PlayerID, typed.Cards) {
+			return ErrCardNotInHand
+		}
+
+		_, rule, ok := matchCombo(typed.Cards)
+		if !ok {
+			return ErrInvalidCombo
+		}
+
+		if err := rule.Validate(&a.state, typed); err != nil {
+			return err
+		}
+	case *ChooseCard:
+		if err := a.validatePlayer(typed.PlayerID); err != nil {
+			return err
+		}
+
+		if err := validateChoice(&a.state, typed.PlayerID, typed.Card); err != nil {
+			return err
+		}
+	case *AlterFuture:
+		if err := a.validatePlayer(typed.PlayerID); err != nil {
+			return err
+		}
+
+		if err := validateArrangement(&a.state, typed.PlayerID, typed.Cards); err != nil {
+			return err
+		}
+	case *ExpireInteraction:
+		if a.currentGameID.IsNil() {
+			return ErrGameNotAvailable
+		}
+
+		if a.state.PendingInteraction == nil {
+			return ErrNoPendingInteraction
+		}
+
+		if TimeNow().Before(a.state.PendingInteraction.Deadline) {
+			return ErrDeadlineNotReached
+		}
+	case *ExpireTurn:
+		if err := a.validatePlayer(a.state.CurrentPlayerID); err != nil {
+			return err
+		}
+
+		if a.state.PendingAction != nil {
+			return ErrReactionWindowOpen
+		}
+
+		if a.state.PendingInteraction != nil {
+			return ErrInteractionPending
+		}
+
+		deadline := a.state.turnDeadline()
+		if deadline.IsZero() || TimeNow().Before(deadline) {
+			return ErrDeadlineNotReached
+		}
+	case *DefuseKitten:
+		if err := a.validatePlayer(typed.PlayerID); err != nil {
+			return err
+		}
+
+		if a.state.PendingDefuse == nil || a.state.PendingDefuse.PlayerID != typed.PlayerID {
+			return ErrNoPendingDefuse
+		}
+
+		// A Defuse that can be Noped waits for its reaction window like any other card.
+		if a.state.PendingAction != nil {
+			return ErrReactionWindowOpen
+		}
+
+		if typed.Position < 0 || typed.Position > len(a.state.DrawPile) {
+			return ErrInvalidPosition
+		}
+	case *DisconnectPlayer:
+		if err := a.validatePlayer(typed.PlayerID); err != nil {
+			return err
+		}
+
+		if a.state.IsDisconnected(typed.PlayerID) {
+			return ErrPlayerDisconnected
+		}
+	case *ReconnectPlayer:
+		if err := a.validatePlayer(typed.PlayerID); err != nil {
+			return err
+		}
+
+		if !a.state.IsDisconnected(typed.PlayerID) {
+			return ErrPlayerNotDisconnected
+		}
+	case *ExpireDisconnect:
+		if err := a.validatePlayer(typed.PlayerID); err != nil {
+			return err
+		}
+
+		if !a.state.Rules.EliminateDisconnected {
+			return ErrDisconnectKept
+		}
+
+		deadline, ok := a.state.Disconnected[typed.PlayerID]
+		if !ok {
+			return ErrPlayerNotDisconnected
+		}
+
+		if a.state.PendingAction != nil {
+			return ErrReactionWindowOpen
+		}
+
+		if a.state.PendingInteraction != nil {
+			return ErrInteractionPending
+		}
+
+		if a.state.PendingDefuse != nil {
+			return ErrDefusePending
+		}
+
+		if TimeNow().Before(deadline) {
+			return ErrDeadlineNotReached
+		}
+	case *ResolveAction:
+		if a.currentGameID.IsNil() {
+			return ErrGameNotAvailable
+		}
+
+		if a.state.PendingAction == nil {
+			return ErrNoPendingAction
+		}
+
+		if TimeNow().Before(a.state.PendingAction.Deadline) {
+			return ErrDeadlineNotReached
+		}
+	default:
+		// All other events require the aggregate to be created.
+		if a.currentGameID.IsNil() {
+			return ErrGameNotAvailable
+		}
+	}
+
+	return nil
+}
+
+// validatePlayer checks that the player is still playing the game.
+func (a *Aggregate) validatePlayer(playerID uuid.UUID) error {
+	if a.currentGameID.IsNil() {
+		return ErrGameNotAvailable
+	}
+
+	if a.state.Finished {
+		return ErrGameFinished
+	}
+
+	if !a.state.IsPlayer(playerID) {
+		return ErrPlayerNotInGame
+	}
+
+	return nil
+}
+
+// validateTurn checks that the player is the one whose turn it is.
+func (a *Aggregate) validateTurn(playerID uuid.UUID) error {
+	if err := a.validatePlayer(playerID); err != nil {
+		return err
+	}
+
+	if a.state.CurrentPlayerID != playerID {
+		return ErrNotPlayersTurn
+	}
+
+	return nil
+}
+
+// validateTurnAction checks that the player can take an action of their turn,
+// which is not the case while the game waits on a reaction window, a Defuse or a card choice.
+func (a *Aggregate) validateTurnAction(playerID uuid.UUID) error {
+	if err := a.validateTurn(playerID); err != nil {
+		return err
+	}
+
+	if a.state.PendingAction != nil {
+		return ErrReactionWindowOpen
+	}
+
+	if a.state.PendingDefuse != nil {
+		return ErrDefusePending
+	}
+
+	if a.state.PendingInteraction != nil {
+		return ErrInteractionPending
+	}
+
+	return nil
+}
+
+func (a *Aggregate) createEvent(cmd eventing.Command) error {
+	switch cmd := cmd.(type) {
+	case *CreateGame:
+		deckSeed := cmd.DeckSeed
+		if len(deckSeed) == 0 {
+			seed, err := NewDeckSeed()
+			if err != nil {
+				return err
+			}
+			deckSeed = seed
+		}
+
+		reactionWindow := cmd.Rules.ReactionWindow
+		if reactionWindow == 0 {
+			reactionWindow = DefaultReactionWindow
+		}
+
+		turnTimeout := cmd.Rules.TurnTimeout
+		if turnTimeout == 0 {
+			turnTimeout = DefaultTurnTimeout
+		}
+
+		afkTimeouts := cmd.AFKTimeouts
+		if afkTimeouts == 0 {
+			afkTimeouts = DefaultAFKTimeouts
+		}
+
+		a.AppendEvent(EventTypeGameCreated, &GameCreated{
+			GameID:         cmd.GameID,
+			LobbyID:        cmd.LobbyID,
+			PlayerIDs:      cmd.PlayerIDs,
+			DeckSeed:       deckSeed,
+			ReactionWindow: reactionWindow,
+			Expansions:     cmd.Expansions,
+			TurnTimeout:    turnTimeout,
+			AFKTimeouts:    afkTimeouts,
+			Deadline:       TimeNow().Add(turnTimeout),
+			Bots:           cmd.Bots,
+			Ranked:         cmd.Ranked,
+			Rules:          cmd.Rules,
+		}, TimeNow())
+	case *DrawCard:
+		e, err := a.newPlayerEmitter(cmd.PlayerID)
+		if err != nil {
+			return err
+		}
+
+		return drawCard(e, cmd.PlayerID)
+	case *PlayCard:
+		e, err := a.newPlayerEmitter(cmd.PlayerID)
+		if err != nil {
+			return err
+		}
+
+		play := &CardPlayed{
+			GameID:         cmd.GameID,
+			PlayerID:       cmd.PlayerID,
+			Card:           cmd.Card,
+			TargetPlayerID: cmd.TargetPlayerID,
+		}
+		if err := e.emit(EventTypeCardPlayed, play); err != nil {
+			return err
+		}
+
+		if isReactionCard(cmd.Card) {
+			rule, _ := cardRule(cmd.Card)
+			return rule.Resolve(e, play)
+		}
+
+		return openReactionWindow(e, play)
+	case *PlayCombo:
+		e, err := a.newPlayerEmitter(cmd.PlayerID)
+		if err != nil {
+			return err
+		}
+
+		combo, _, _ := matchCombo(cmd.Cards)
+		play := &CardPlayed{
+			GameID:         cmd.GameID,
+			PlayerID:       cmd.PlayerID,
+			Combo:          combo,
+			Cards:          cmd.Cards,
+			TargetPlayerID: cmd.TargetPlayerID,
+			NamedCard:      cmd.NamedCard,
+		}
+		if err := e.emit(EventTypeCardPlayed, play); err != nil {
+			return err
+		}
+
+		return openReactionWindow(e, play)
+	case *ChooseCard:
+		e, err := a.newPlayerEmitter(cmd.PlayerID)
+		if err != nil {
+			return err
+		}
+
+		return answerInteraction(e, cmd.Card)
+	case *AlterFuture:
+		e, err := a.newPlayerEmitter(cmd.PlayerID)
+		if err != nil {
+			return err
+		}
+
+		return answerArrangement(e, cmd.Cards)
+	case *ExpireInteraction:
+		e, err := a.newEmitter()
+		if err != nil {
+			return err
+		}
+
+		return expireInteraction(e)
+	case *ExpireTurn:
+		e, err := a.newEmitter()
+		if err != nil {
+			return err
+		}
+
+		return expireTurn(e)
+	case *DefuseKitten:
+		e, err := a.newPlayerEmitter(cmd.PlayerID)
+		if err != nil {
+			return err
+		}
+
+		if e.state.Rules.NopeDefuse && e.state.PendingDefuse.Card == CardExplodingKitten {
+			return openReactionWindow(e, &CardPlayed{
+				GameID:   cmd.GameID,
+				PlayerID: cmd.PlayerID,
+				Card:     CardDefuse,
+				Position: cmd.Position,
+			})
+		}
+
+		if err := placeKitten(e, cmd.PlayerID, cmd.Position); err != nil {
+			return err
+		}
+
+		return endTurn(e)
+	case *ResolveAction:
+		e, err := a.newEmitter()
+		if err != nil {
+			return err
+		}
+
+		return closeReactionWindow(e)
+	case *DisconnectPlayer:
+		grace := cmd.Grace
+		if grace == 0 {
+			grace = DefaultDisconnectGrace
+		}
+
+		a.AppendEvent(EventTypePlayerDisconnected, &PlayerDisconnected{
+			GameID:   cmd.GameID,
+			PlayerID: cmd.PlayerID,
+			Deadline: TimeNow().Add(grace),
+		}, TimeNow())
+	case *ReconnectPlayer:
+		a.AppendEvent(EventTypePlayerReconnected, &PlayerReconnected{
+			GameID:   cmd.GameID,
+			PlayerID: cmd.PlayerID,
+		}, TimeNow())
+	case *ExpireDisconnect:
+		e, err := a.newEmitter()
+		if err != nil {
+			return err
+		}
+
+		return eliminatePlayer(e, cmd.PlayerID, EliminationCauseDisconnected)
+
+	default:
+		return fmt.Errorf("could not handle command: %s", cmd.CommandType())
+	}
+
+	return nil
+}
+
+// newEmitter returns an emitter working on a copy of the current state.
+func (a *Aggregate) newEmitter() (*emitter, error) {
+	state, err := a.state.clone()
+	if err != nil {
+		return nil, err
+	}
+
+	return &emitter{aggregate: a, state: state}, nil
+}
+
+// newPlayerEmitter returns an emitter for a command issued by a player, who is no longer
+// considered away from the game.
+func (a *Aggregate) newPlayerEmitter(playerID uuid.UUID) (*emitter, error) {
+	e, err := a.newEmitter()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := returnPlayer(e, playerID); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// HandleCommand implements the HandleCommand method of the
+// eventing.CommandHandler interface.
+func (a *Aggregate) HandleCommand(ctx context.Context, cmd eventing.Command) error {
+	if err := a.validateCommand(cmd); err != nil {
+		return err
+	}
+
+	if err := a.createEvent(cmd); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ApplyEvent implements the ApplyEvent method of the
+// eventing.Aggregate interface.
+func (a *Aggregate) ApplyEvent(ctx context.Context, event common.Event) error {
+	if err := a.state.apply(event.EventType(), event.Data()); err != nil {
+		return errors.WithStack(fmt.Errorf("could not apply event: %s: %w", event.EventType(), err))
+	}
+	a.state.Version = event.Version()
+
+	if event.EventType() == EventTypeGameCreated {
+		a.currentGameID = a.state.GameID
+	}
+
+	return nil
+}
@@ -0,0 +1,1036 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	goTesting "testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+)
+
+type AggregateSuite struct {
+	*testing.Suite
+	ctx     context.Context
+	agg     *Aggregate
+	gameID  uuid.UUID
+	players []uuid.UUID
+	// events are all the events applied since the game was created.
+	events []common.Event
+}
+
+func TestAggregateSuite(t *goTesting.T) {
+	as := &AggregateSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, as)
+}
+
+func (as *AggregateSuite) SetupTest() {
+	timeutil.MockClock()
+
+	as.ctx = context.Background()
+	as.gameID = uuid.Must(uuid.NewV7())
+	as.players = []uuid.UUID{
+		uuid.Must(uuid.NewV7()),
+		uuid.Must(uuid.NewV7()),
+		uuid.Must(uuid.NewV7()),
+	}
+
+	as.agg = &Aggregate{}
+	as.agg.OnCreate(as.gameID.String())
+	as.events = nil
+
+	as.NoError(as.handle(&CreateGame{
+		GameID:    as.gameID,
+		LobbyID:   uuid.Must(uuid.NewV7()),
+		PlayerIDs: as.players,
+	}))
+}
+
+// handle runs a command and applies the resulting events, like the aggregate store does on save.
+func (as *AggregateSuite) handle(cmd eventing.Command) error {
+	if err := as.agg.HandleCommand(as.ctx, cmd); err != nil {
+		return err
+	}
+
+	for _, event := range as.agg.UncommittedEvents() {
+		as.NoError(as.agg.ApplyEvent(as.ctx, event))
+		as.events = append(as.events, event)
+	}
+	as.agg.ClearUncommittedEvents()
+
+	return nil
+}
+
+func (as *AggregateSuite) Test_CreateGame_OnlyOnce() {
+	as.ErrorIs(as.handle(&CreateGame{GameID: as.gameID, PlayerIDs: as.players}), ErrGameAlreadyCreated)
+	as.ErrorIs(as.handle(&CreateGame{GameID: uuid.Must(uuid.NewV7()), PlayerIDs: as.players}), ErrGameAlreadyCreated)
+}
+
+func (as *AggregateSuite) Test_CreateGame_Validate() {
+	var fieldErr *common.CommandFieldError
+	as.ErrorAs((&CreateGame{PlayerIDs: as.players}).Validate(), &fieldErr)
+	as.Equal("game_id", fieldErr.Field)
+}
+
+// giveCard puts a card in the hand of a player.
+func (as *AggregateSuite) giveCard(playerID uuid.UUID, card CardType) {
+	as.agg.state.Hands[playerID] = append(as.agg.state.Hands[playerID], card)
+}
+
+func (as *AggregateSuite) playCard(playerID uuid.UUID, card CardType) error {
+	return as.handle(&PlayCard{
+		GameID:   as.gameID,
+		PlayerID: playerID,
+		Card:     card,
+	})
+}
+
+// playAction plays an action card and lets its reaction window close.
+func (as *AggregateSuite) playAction(playerID uuid.UUID, card CardType) {
+	as.giveCard(playerID, card)
+	as.NoError(as.playCard(playerID, card))
+	as.NoError(as.closeWindow())
+}
+
+func (as *AggregateSuite) closeWindow() error {
+	timeutil.MockedClock.Add(as.agg.state.ReactionWindow)
+
+	return as.handle(&ResolveAction{GameID: as.gameID})
+}
+
+func (as *AggregateSuite) Test_DrawCard_OutOfTurn() {
+	err := as.handle(&DrawCard{
+		GameID:   as.gameID,
+		PlayerID: as.players[1],
+	})
+	as.ErrorIs(err, ErrNotPlayersTurn)
+}
+
+func (as *AggregateSuite) Test_DrawCard_EndsTurn() {
+	as.stackSafeCards(1)
+	top := as.agg.state.DrawPile[0]
+
+	err := as.handle(&DrawCard{
+		GameID:   as.gameID,
+		PlayerID: as.players[0],
+	})
+	as.NoError(err)
+
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+	as.Equal(1, as.agg.state.TurnsRemaining)
+	as.Len(as.agg.state.GetHand(as.players[0]), InitialHandSize+2)
+	as.Equal(top, as.agg.state.GetHand(as.players[0])[InitialHandSize+1])
+}
+
+func (as *AggregateSuite) Test_PlayCard_Skip_EndsTurnWithoutDrawing() {
+	drawPile := len(as.agg.state.DrawPile)
+
+	as.playAction(as.players[0], CardSkip)
+
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+	as.Len(as.agg.state.DrawPile, drawPile)
+	as.Equal(CardSkip, as.agg.state.DiscardPile[len(as.agg.state.DiscardPile)-1])
+}
+
+func (as *AggregateSuite) Test_PlayCard_Attack_Stacks() {
+	as.playAction(as.players[0], CardAttack)
+
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+	as.Equal(AttackTurns, as.agg.state.TurnsRemaining)
+
+	as.playAction(as.players[1], CardAttack)
+
+	as.Equal(as.players[2], as.agg.state.CurrentPlayerID)
+	as.Equal(2*AttackTurns, as.agg.state.TurnsRemaining)
+
+	as.playAction(as.players[2], CardSkip)
+
+	as.Equal(as.players[2], as.agg.state.CurrentPlayerID)
+	as.Equal(2*AttackTurns-1, as.agg.state.TurnsRemaining)
+}
+
+func (as *AggregateSuite) Test_PlayCard_NotPlayable() {
+	as.ErrorIs(as.playCard(as.players[0], CardDefuse), ErrCardNotPlayable)
+}
+
+func (as *AggregateSuite) Test_PlayCard_NotInHand() {
+	as.agg.state.Hands[as.players[0]] = []CardType{CardDefuse}
+
+	as.ErrorIs(as.playCard(as.players[0], CardSkip), ErrCardNotInHand)
+}
+
+func (as *AggregateSuite) Test_ReactionWindow_WaitsForDeadline() {
+	as.giveCard(as.players[0], CardSkip)
+	as.NoError(as.playCard(as.players[0], CardSkip))
+
+	as.NotNil(as.agg.state.PendingAction)
+	as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+
+	err := as.handle(&DrawCard{GameID: as.gameID, PlayerID: as.players[0]})
+	as.ErrorIs(err, ErrReactionWindowOpen)
+
+	err = as.handle(&ResolveAction{GameID: as.gameID})
+	as.ErrorIs(err, ErrDeadlineNotReached)
+
+	as.NoError(as.closeWindow())
+	as.Nil(as.agg.state.PendingAction)
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+
+	err = as.handle(&ResolveAction{GameID: as.gameID})
+	as.ErrorIs(err, ErrNoPendingAction)
+}
+
+func (as *AggregateSuite) Test_ReactionWindow_NopeCancelsAction() {
+	as.giveCard(as.players[0], CardSkip)
+	as.NoError(as.playCard(as.players[0], CardSkip))
+
+	as.giveCard(as.players[2], CardNope)
+	as.NoError(as.playCard(as.players[2], CardNope))
+	as.Equal(1, as.agg.state.PendingAction.NopeCount)
+
+	as.NoError(as.closeWindow())
+	as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_ReactionWindow_ChainedNopes() {
+	as.giveCard(as.players[0], CardSkip)
+	as.NoError(as.playCard(as.players[0], CardSkip))
+
+	as.giveCard(as.players[1], CardNope)
+	as.NoError(as.playCard(as.players[1], CardNope))
+
+	// The Nope restarts the window, so the original deadline is not enough anymore.
+	timeutil.MockedClock.Add(as.agg.state.ReactionWindow / 2)
+	as.giveCard(as.players[0], CardNope)
+	as.NoError(as.playCard(as.players[0], CardNope))
+	as.Equal(2, as.agg.state.PendingAction.NopeCount)
+
+	as.NoError(as.closeWindow())
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_PlayCard_Nope_WithoutPendingAction() {
+	as.giveCard(as.players[1], CardNope)
+
+	as.ErrorIs(as.playCard(as.players[1], CardNope), ErrNoPendingAction)
+}
+
+// stackKitten puts an Exploding Kitten on top of the draw pile.
+func (as *AggregateSuite) stackKitten() {
+	as.agg.state.DrawPile = append([]CardType{CardExplodingKitten}, as.agg.state.DrawPile...)
+}
+
+// stackSafeCards puts cards without effect on top of the draw pile, so the next draws only end turns.
+func (as *AggregateSuite) stackSafeCards(count int) {
+	for range count {
+		as.agg.state.DrawPile = append([]CardType{CardTacoCat}, as.agg.state.DrawPile...)
+	}
+}
+
+// takeDefuses removes all Defuses from the hand of a player.
+func (as *AggregateSuite) takeDefuses(playerID uuid.UUID) {
+	hand := make([]CardType, 0)
+	for _, card := range as.agg.state.Hands[playerID] {
+		if card != CardDefuse {
+			hand = append(hand, card)
+		}
+	}
+	as.agg.state.Hands[playerID] = hand
+}
+
+func (as *AggregateSuite) draw(playerID uuid.UUID) error {
+	return as.handle(&DrawCard{GameID: as.gameID, PlayerID: playerID})
+}
+
+func (as *AggregateSuite) Test_ExplodingKitten_Defused() {
+	as.stackKitten()
+	as.NoError(as.draw(as.players[0]))
+
+	as.NotNil(as.agg.state.PendingDefuse)
+	as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+	as.ErrorIs(as.draw(as.players[0]), ErrDefusePending)
+
+	err := as.handle(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[1], Position: 0})
+	as.ErrorIs(err, ErrNoPendingDefuse)
+
+	err = as.handle(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[0], Position: len(as.agg.state.DrawPile) + 1})
+	as.ErrorIs(err, ErrInvalidPosition)
+
+	as.NoError(as.handle(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[0], Position: 2}))
+
+	as.Nil(as.agg.state.PendingDefuse)
+	as.Equal(CardExplodingKitten, as.agg.state.DrawPile[2])
+	as.False(as.agg.state.HasCard(as.players[0], CardDefuse))
+	as.False(as.agg.state.HasCard(as.players[0], CardExplodingKitten))
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_ExplodingKitten_EliminatesUntilLastPlayer() {
+	as.takeDefuses(as.players[0])
+	as.stackKitten()
+	as.NoError(as.draw(as.players[0]))
+
+	as.False(as.agg.state.IsPlayer(as.players[0]))
+	as.Equal([]uuid.UUID{as.players[0]}, as.agg.state.EliminatedPlayerIDs)
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+	as.False(as.agg.state.Finished)
+
+	as.takeDefuses(as.players[1])
+	as.stackKitten()
+	as.NoError(as.draw(as.players[1]))
+
+	as.True(as.agg.state.Finished)
+	as.Equal(as.players[2], as.agg.state.WinnerID)
+	as.ErrorIs(as.draw(as.players[2]), ErrGameFinished)
+}
+
+func (as *AggregateSuite) playFavor(target uuid.UUID) {
+	as.giveCard(as.players[0], CardFavor)
+	as.NoError(as.handle(&PlayCard{
+		GameID:         as.gameID,
+		PlayerID:       as.players[0],
+		Card:           CardFavor,
+		TargetPlayerID: target,
+	}))
+	as.NoError(as.closeWindow())
+}
+
+func (as *AggregateSuite) Test_Favor_TargetChoosesCard() {
+	as.agg.state.Hands[as.players[1]] = []CardType{CardSkip, CardTacoCat}
+
+	as.playFavor(as.players[1])
+
+	as.NotNil(as.agg.state.PendingInteraction)
+	as.Equal(as.players[1], as.agg.state.PendingInteraction.ChooserID)
+	as.ErrorIs(as.draw(as.players[0]), ErrInteractionPending)
+
+	err := as.handle(&ChooseCard{GameID: as.gameID, PlayerID: as.players[0], Card: CardTacoCat})
+	as.ErrorIs(err, ErrNoPendingInteraction)
+
+	err = as.handle(&ChooseCard{GameID: as.gameID, PlayerID: as.players[1], Card: CardAttack})
+	as.ErrorIs(err, ErrInvalidChoice)
+
+	as.NoError(as.handle(&ChooseCard{GameID: as.gameID, PlayerID: as.players[1], Card: CardTacoCat}))
+
+	as.Nil(as.agg.state.PendingInteraction)
+	as.Equal([]CardType{CardSkip}, as.agg.state.GetHand(as.players[1]))
+	as.True(as.agg.state.HasCard(as.players[0], CardTacoCat))
+	as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_Favor_TimeoutGivesRandomCard() {
+	as.agg.state.Hands[as.players[1]] = []CardType{CardBeardCat}
+
+	as.playFavor(as.players[1])
+
+	as.ErrorIs(as.handle(&ExpireInteraction{GameID: as.gameID}), ErrDeadlineNotReached)
+
+	timeutil.MockedClock.Add(InteractionTimeout)
+	as.NoError(as.handle(&ExpireInteraction{GameID: as.gameID}))
+
+	as.Nil(as.agg.state.PendingInteraction)
+	as.Empty(as.agg.state.GetHand(as.players[1]))
+	as.True(as.agg.state.HasCard(as.players[0], CardBeardCat))
+}
+
+func (as *AggregateSuite) Test_Favor_InvalidTarget() {
+	as.giveCard(as.players[0], CardFavor)
+
+	err := as.handle(&PlayCard{GameID: as.gameID, PlayerID: as.players[0], Card: CardFavor, TargetPlayerID: as.players[0]})
+	as.ErrorIs(err, ErrInvalidTarget)
+}
+
+func (as *AggregateSuite) playCombo(cmd *PlayCombo) {
+	cmd.GameID = as.gameID
+	cmd.PlayerID = as.players[0]
+	for _, card := range cmd.Cards {
+		as.giveCard(as.players[0], card)
+	}
+
+	as.NoError(as.handle(cmd))
+	as.NoError(as.closeWindow())
+}
+
+func (as *AggregateSuite) Test_Combo_PairStealsRandomCard() {
+	as.agg.state.Hands[as.players[1]] = []CardType{CardSkip}
+
+	as.playCombo(&PlayCombo{
+		Cards:          []CardType{CardCattermelon, CardCattermelon},
+		TargetPlayerID: as.players[1],
+	})
+
+	as.Empty(as.agg.state.GetHand(as.players[1]))
+	as.True(as.agg.state.HasCard(as.players[0], CardSkip))
+}
+
+func (as *AggregateSuite) Test_Combo_ThreeOfAKindNamesCard() {
+	as.agg.state.Hands[as.players[1]] = []CardType{CardSkip, CardAttack}
+
+	as.playCombo(&PlayCombo{
+		Cards:          []CardType{CardTacoCat, CardTacoCat, CardTacoCat},
+		TargetPlayerID: as.players[1],
+		NamedCard:      CardAttack,
+	})
+
+	as.Equal([]CardType{CardSkip}, as.agg.state.GetHand(as.players[1]))
+	as.True(as.agg.state.HasCard(as.players[0], CardAttack))
+}
+
+func (as *AggregateSuite) Test_Combo_FiveDifferentPicksFromDiscard() {
+	as.agg.state.DiscardPile = []CardType{CardDefuse}
+
+	as.playCombo(&PlayCombo{
+		Cards: []CardType{CardTacoCat, CardBeardCat, CardCattermelon, CardHairyPotatoCat, CardRainbowRalphingCat},
+	})
+
+	as.NotNil(as.agg.state.PendingInteraction)
+	as.Equal(InteractionDiscardPick, as.agg.state.PendingInteraction.Kind)
+
+	as.takeDefuses(as.players[0])
+	as.NoError(as.handle(&ChooseCard{GameID: as.gameID, PlayerID: as.players[0], Card: CardDefuse}))
+
+	as.True(as.agg.state.HasCard(as.players[0], CardDefuse))
+	as.NotContains(as.agg.state.DiscardPile, CardDefuse)
+}
+
+func (as *AggregateSuite) Test_Combo_Invalid() {
+	as.agg.state.Hands[as.players[0]] = []CardType{CardTacoCat, CardBeardCat}
+
+	err := as.handle(&PlayCombo{
+		GameID:         as.gameID,
+		PlayerID:       as.players[0],
+		Cards:          []CardType{CardTacoCat, CardBeardCat},
+		TargetPlayerID: as.players[1],
+	})
+	as.ErrorIs(err, ErrInvalidCombo)
+
+	err = as.handle(&PlayCombo{
+		GameID:         as.gameID,
+		PlayerID:       as.players[0],
+		Cards:          []CardType{CardTacoCat, CardTacoCat},
+		TargetPlayerID: as.players[1],
+	})
+	as.ErrorIs(err, ErrCardNotInHand)
+}
+
+func (as *AggregateSuite) Test_SeeTheFuture_RevealedOnlyToPlayer() {
+	top := slices.Clone(as.agg.state.DrawPile[:SeeTheFutureCards])
+
+	as.playAction(as.players[0], CardSeeTheFuture)
+
+	view := NewPlayerView(&as.agg.state, as.players[0])
+	as.NotNil(view.Reveal)
+	as.Equal(RevealFuture, view.Reveal.Kind)
+	as.Equal(top, view.Reveal.Cards)
+
+	as.Nil(NewPlayerView(&as.agg.state, as.players[1]).Reveal)
+
+	// Drawing makes the revealed cards stale.
+	as.NoError(as.draw(as.players[0]))
+	as.Nil(NewPlayerView(&as.agg.state, as.players[0]).Reveal)
+}
+
+func (as *AggregateSuite) Test_PlayerView_HidesSecrets() {
+	as.agg.state.Hands[as.players[1]] = []CardType{CardShuffle}
+	as.agg.state.Hands[as.players[2]] = []CardType{CardDefuse}
+	as.playFavor(as.players[1])
+	as.NoError(as.handle(&ChooseCard{GameID: as.gameID, PlayerID: as.players[1], Card: CardShuffle}))
+
+	view := NewPlayerView(&as.agg.state, as.players[2])
+
+	as.Equal(as.agg.state.GetHand(as.players[2]), view.Hand)
+	as.Equal(len(as.agg.state.DrawPile), view.DrawPileSize)
+	as.Equal(CardFavor, view.DiscardTop)
+	as.Nil(view.Reveal)
+	for _, seat := range view.Players {
+		as.Equal(len(as.agg.state.GetHand(seat.PlayerID)), seat.CardCount)
+	}
+
+	bytes, err := json.Marshal(view)
+	as.NoError(err)
+	as.NotContains(string(bytes), "deck_seed")
+	as.NotContains(string(bytes), "draw_pile\"")
+	as.NotContains(string(bytes), string(CardShuffle))
+
+	received := NewPlayerView(&as.agg.state, as.players[0]).Reveal
+	as.Equal(RevealCardReceived, received.Kind)
+	as.Equal([]CardType{CardShuffle}, received.Cards)
+	as.Equal(as.players[1], received.OtherPlayerID)
+}
+
+func (as *AggregateSuite) Test_SpectatorView_HidesHands() {
+	as.playAction(as.players[0], CardSeeTheFuture)
+
+	view := NewSpectatorView(&as.agg.state)
+
+	as.Empty(view.Hand)
+	as.False(view.HandHidden)
+	as.Nil(view.Reveal)
+	as.Equal(uuid.Nil, view.ViewerID)
+	for _, seat := range view.Players {
+		as.Equal(len(as.agg.state.GetHand(seat.PlayerID)), seat.CardCount)
+	}
+
+	bytes, err := json.Marshal(view)
+	as.NoError(err)
+	as.NotContains(string(bytes), "deck_seed")
+	as.NotContains(string(bytes), "draw_pile\"")
+}
+
+func (as *AggregateSuite) Test_Reverse_FlipsTurnOrder() {
+	as.stackSafeCards(1)
+	as.playAction(as.players[0], CardReverse)
+
+	as.True(as.agg.state.Reversed)
+	as.Equal(as.players[2], as.agg.state.CurrentPlayerID)
+
+	as.NoError(as.draw(as.players[2]))
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+
+	as.playAction(as.players[1], CardReverse)
+
+	as.False(as.agg.state.Reversed)
+	as.Equal(as.players[2], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_DrawFromTheBottom_DrawsBottomCard() {
+	as.agg.state.DrawPile = append(as.agg.state.DrawPile, CardTacoCat)
+	bottom := as.agg.state.DrawPile[len(as.agg.state.DrawPile)-1]
+	top := as.agg.state.DrawPile[0]
+	hand := len(as.agg.state.GetHand(as.players[0]))
+
+	as.playAction(as.players[0], CardDrawFromTheBottom)
+
+	as.Equal(top, as.agg.state.DrawPile[0])
+	as.Len(as.agg.state.GetHand(as.players[0]), hand+1)
+	as.Equal(bottom, as.agg.state.GetHand(as.players[0])[hand])
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_TargetedAttack_PassesTurnsToTarget() {
+	as.giveCard(as.players[0], CardTargetedAttack)
+
+	err := as.handle(&PlayCard{GameID: as.gameID, PlayerID: as.players[0], Card: CardTargetedAttack})
+	as.ErrorIs(err, ErrInvalidTarget)
+
+	as.NoError(as.handle(&PlayCard{
+		GameID:         as.gameID,
+		PlayerID:       as.players[0],
+		Card:           CardTargetedAttack,
+		TargetPlayerID: as.players[2],
+	}))
+	as.NoError(as.closeWindow())
+
+	as.Equal(as.players[2], as.agg.state.CurrentPlayerID)
+	as.Equal(AttackTurns, as.agg.state.TurnsRemaining)
+
+	as.playAction(as.players[2], CardSkip)
+	as.playAction(as.players[2], CardSkip)
+
+	as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_Combo_FeralCatIsWildcard() {
+	as.agg.state.Hands[as.players[1]] = []CardType{CardSkip}
+
+	as.playCombo(&PlayCombo{
+		Cards:          []CardType{CardFeralCat, CardBeardCat},
+		TargetPlayerID: as.players[1],
+	})
+
+	as.True(as.agg.state.HasCard(as.players[0], CardSkip))
+
+	as.giveCard(as.players[0], CardFeralCat)
+	as.giveCard(as.players[0], CardAttack)
+
+	err := as.handle(&PlayCombo{
+		GameID:         as.gameID,
+		PlayerID:       as.players[0],
+		Cards:          []CardType{CardFeralCat, CardAttack},
+		TargetPlayerID: as.players[1],
+	})
+	as.ErrorIs(err, ErrInvalidCombo)
+}
+
+func (as *AggregateSuite) Test_AlterTheFuture_RearrangesTop() {
+	as.agg.state.DrawPile = append([]CardType{CardSkip, CardAttack, CardExplodingKitten}, as.agg.state.DrawPile...)
+
+	as.playAction(as.players[0], CardAlterTheFuture)
+
+	as.NotNil(as.agg.state.PendingInteraction)
+	as.Equal(InteractionAlterTheFuture, as.agg.state.PendingInteraction.Kind)
+	as.Equal([]CardType{CardSkip, CardAttack, CardExplodingKitten}, as.agg.state.GetReveal(as.players[0]).Cards)
+
+	err := as.handle(&ChooseCard{GameID: as.gameID, PlayerID: as.players[0], Card: CardSkip})
+	as.ErrorIs(err, ErrNoPendingInteraction)
+
+	err = as.handle(&AlterFuture{GameID: as.gameID, PlayerID: as.players[0], Cards: []CardType{CardSkip, CardSkip, CardAttack}})
+	as.ErrorIs(err, ErrInvalidArrangement)
+
+	as.NoError(as.handle(&AlterFuture{
+		GameID:   as.gameID,
+		PlayerID: as.players[0],
+		Cards:    []CardType{CardExplodingKitten, CardSkip, CardAttack},
+	}))
+
+	as.Nil(as.agg.state.PendingInteraction)
+	as.Equal([]CardType{CardExplodingKitten, CardSkip, CardAttack}, as.agg.state.DrawPile[:3])
+	as.Equal([]CardType{CardExplodingKitten, CardSkip, CardAttack}, as.agg.state.GetReveal(as.players[0]).Cards)
+	as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_AlterTheFuture_TimeoutKeepsOrder() {
+	top := slices.Clone(as.agg.state.DrawPile[:AlterTheFutureCards])
+
+	as.playAction(as.players[0], CardAlterTheFuture)
+
+	timeutil.MockedClock.Add(InteractionTimeout)
+	as.NoError(as.handle(&ExpireInteraction{GameID: as.gameID}))
+
+	as.Nil(as.agg.state.PendingInteraction)
+	as.Equal(top, as.agg.state.DrawPile[:AlterTheFutureCards])
+}
+
+func (as *AggregateSuite) Test_ImplodingKitten_PutBackFaceUpThenEliminates() {
+	as.agg.state.DrawPile = append([]CardType{CardImplodingKitten}, as.agg.state.DrawPile...)
+	as.NoError(as.draw(as.players[0]))
+
+	as.NotNil(as.agg.state.PendingDefuse)
+	as.Equal(CardImplodingKitten, as.agg.state.PendingDefuse.Card)
+
+	as.NoError(as.handle(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[0], Position: 0}))
+
+	// No Defuse is spent on the Imploding Kitten.
+	as.True(as.agg.state.HasCard(as.players[0], CardDefuse))
+	as.True(as.agg.state.ImplodingKittenFaceUp)
+	as.Equal(CardImplodingKitten, as.agg.state.DrawPile[0])
+	as.Equal(0, NewPlayerView(&as.agg.state, as.players[2]).ImplodingKittenPosition)
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+
+	// A Defuse does not save the next player from a face up Imploding Kitten.
+	as.NoError(as.draw(as.players[1]))
+
+	as.False(as.agg.state.IsPlayer(as.players[1]))
+	as.False(as.agg.state.ImplodingKittenFaceUp)
+	as.Equal(-1, NewPlayerView(&as.agg.state, as.players[2]).ImplodingKittenPosition)
+	as.Equal(as.players[2], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_StreakingKitten_KeepsDrawnKitten() {
+	as.giveCard(as.players[0], CardStreakingKitten)
+	as.stackKitten()
+	as.NoError(as.draw(as.players[0]))
+
+	as.Nil(as.agg.state.PendingDefuse)
+	as.True(as.agg.state.HasCard(as.players[0], CardExplodingKitten))
+	as.True(as.agg.state.HasCard(as.players[0], CardDefuse))
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_StreakingKitten_LostForcesDefuse() {
+	as.agg.state.Hands[as.players[1]] = []CardType{CardStreakingKitten, CardExplodingKitten, CardDefuse}
+	drawPile := len(as.agg.state.DrawPile)
+
+	as.playCombo(&PlayCombo{
+		Cards:          []CardType{CardTacoCat, CardTacoCat, CardTacoCat},
+		TargetPlayerID: as.players[1],
+		NamedCard:      CardStreakingKitten,
+	})
+
+	as.True(as.agg.state.HasCard(as.players[0], CardStreakingKitten))
+	as.True(as.agg.state.IsPlayer(as.players[1]))
+	as.Empty(as.agg.state.GetHand(as.players[1]))
+	as.Len(as.agg.state.DrawPile, drawPile+1)
+	as.Contains(as.agg.state.DrawPile, CardExplodingKitten)
+
+	// Without a Defuse, losing the Streaking Kitten explodes the player.
+	as.agg.state.Hands[as.players[2]] = []CardType{CardStreakingKitten, CardExplodingKitten}
+
+	as.playCombo(&PlayCombo{
+		Cards:          []CardType{CardTacoCat, CardTacoCat, CardTacoCat},
+		TargetPlayerID: as.players[2],
+		NamedCard:      CardStreakingKitten,
+	})
+
+	as.False(as.agg.state.IsPlayer(as.players[2]))
+	as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_SuperSkip_EndsAllTurns() {
+	as.agg.state.TurnsRemaining = AttackTurns
+
+	as.playAction(as.players[0], CardSuperSkip)
+
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+	as.Equal(1, as.agg.state.TurnsRemaining)
+}
+
+func (as *AggregateSuite) Test_SwapTopAndBottom_KeepsTurn() {
+	top := as.agg.state.DrawPile[0]
+	bottom := as.agg.state.DrawPile[len(as.agg.state.DrawPile)-1]
+
+	as.playAction(as.players[0], CardSwapTopAndBottom)
+
+	as.Equal(bottom, as.agg.state.DrawPile[0])
+	as.Equal(top, as.agg.state.DrawPile[len(as.agg.state.DrawPile)-1])
+	as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_CatomicBomb_MovesKittensToTop() {
+	as.agg.state.DrawPile = []CardType{CardSkip, CardExplodingKitten, CardAttack, CardExplodingKitten}
+
+	as.playAction(as.players[0], CardCatomicBomb)
+
+	as.Equal([]CardType{CardExplodingKitten, CardExplodingKitten, CardSkip, CardAttack}, as.agg.state.DrawPile)
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_Mark_TurnsCardFaceUp() {
+	as.agg.state.Hands[as.players[1]] = []CardType{CardBeardCat}
+
+	as.giveCard(as.players[0], CardMark)
+	as.NoError(as.handle(&PlayCard{
+		GameID:         as.gameID,
+		PlayerID:       as.players[0],
+		Card:           CardMark,
+		TargetPlayerID: as.players[1],
+	}))
+	as.NoError(as.closeWindow())
+
+	seat := NewPlayerView(&as.agg.state, as.players[2]).Players[1]
+	as.Equal([]CardType{CardBeardCat}, seat.MarkedCards)
+
+	// The mark goes away with the card.
+	as.playCombo(&PlayCombo{
+		Cards:          []CardType{CardTacoCat, CardTacoCat},
+		TargetPlayerID: as.players[1],
+	})
+
+	as.Empty(as.agg.state.GetMarkedCards(as.players[1]))
+}
+
+func (as *AggregateSuite) Test_CurseOfTheCatButt_HidesHandForATurn() {
+	as.giveCard(as.players[0], CardCurseOfTheCatButt)
+	as.NoError(as.handle(&PlayCard{
+		GameID:         as.gameID,
+		PlayerID:       as.players[0],
+		Card:           CardCurseOfTheCatButt,
+		TargetPlayerID: as.players[1],
+	}))
+	as.NoError(as.closeWindow())
+
+	view := NewPlayerView(&as.agg.state, as.players[1])
+	as.True(view.HandHidden)
+	as.Empty(view.Hand)
+	as.True(view.Players[1].Cursed)
+
+	as.stackSafeCards(2)
+	as.NoError(as.draw(as.players[0]))
+	as.True(as.agg.state.IsCursed(as.players[1]))
+
+	as.NoError(as.draw(as.players[1]))
+	as.False(as.agg.state.IsCursed(as.players[1]))
+	as.NotEmpty(NewPlayerView(&as.agg.state, as.players[1]).Hand)
+}
+
+func (as *AggregateSuite) Test_GarbageCollection_EveryoneGivesACard() {
+	as.agg.state.Hands[as.players[0]] = []CardType{CardSkip}
+	as.agg.state.Hands[as.players[1]] = []CardType{}
+	as.agg.state.Hands[as.players[2]] = []CardType{CardAttack, CardTacoCat}
+	drawPile := len(as.agg.state.DrawPile)
+
+	as.playAction(as.players[0], CardGarbageCollection)
+
+	as.NotNil(as.agg.state.PendingInteraction)
+	as.Equal(InteractionGarbageCollection, as.agg.state.PendingInteraction.Kind)
+	as.Equal(as.players[0], as.agg.state.PendingInteraction.ChooserID)
+
+	as.NoError(as.handle(&ChooseCard{GameID: as.gameID, PlayerID: as.players[0], Card: CardSkip}))
+
+	// A player with an empty hand is skipped.
+	as.Equal(as.players[2], as.agg.state.PendingInteraction.ChooserID)
+
+	timeutil.MockedClock.Add(InteractionTimeout)
+	as.NoError(as.handle(&ExpireInteraction{GameID: as.gameID}))
+
+	as.Nil(as.agg.state.PendingInteraction)
+	as.Empty(as.agg.state.GetHand(as.players[0]))
+	as.Len(as.agg.state.GetHand(as.players[2]), 1)
+	as.Len(as.agg.state.DrawPile, drawPile+2)
+	as.Contains(as.agg.state.DrawPile, CardSkip)
+	as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) expireTurn() error {
+	return as.handle(&ExpireTurn{GameID: as.gameID})
+}
+
+func (as *AggregateSuite) Test_TurnTimeout_DrawsForPlayer() {
+	as.stackSafeCards(1)
+	hand := len(as.agg.state.GetHand(as.players[0]))
+
+	as.ErrorIs(as.expireTurn(), ErrDeadlineNotReached)
+
+	timeutil.MockedClock.Add(DefaultTurnTimeout)
+	as.NoError(as.expireTurn())
+
+	as.Len(as.agg.state.GetHand(as.players[0]), hand+1)
+	as.Equal(1, as.agg.state.Timeouts[as.players[0]])
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+	as.Equal(TimeNow().Add(DefaultTurnTimeout), as.agg.state.TurnDeadline)
+}
+
+func (as *AggregateSuite) Test_TurnTimeout_WaitsForReactionWindow() {
+	as.giveCard(as.players[0], CardSkip)
+	as.NoError(as.playCard(as.players[0], CardSkip))
+
+	timeutil.MockedClock.Add(DefaultTurnTimeout)
+	as.ErrorIs(as.expireTurn(), ErrReactionWindowOpen)
+	as.Equal([]Deadline{{At: as.agg.state.PendingAction.Deadline, Command: &ResolveAction{GameID: as.gameID}}}, as.agg.state.Deadlines())
+}
+
+func (as *AggregateSuite) Test_TurnTimeout_PausedByReactionWindow() {
+	deadline := as.agg.state.TurnDeadline
+
+	timeutil.MockedClock.Add(10 * time.Second)
+	as.giveCard(as.players[0], CardSeeTheFuture)
+	as.NoError(as.playCard(as.players[0], CardSeeTheFuture))
+
+	// A Nope keeps the window open longer, the whole time it was open is given back.
+	timeutil.MockedClock.Add(time.Second)
+	as.giveCard(as.players[1], CardNope)
+	as.NoError(as.playCard(as.players[1], CardNope))
+	as.NoError(as.closeWindow())
+
+	as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+	as.Equal(deadline.Add(time.Second+as.agg.state.ReactionWindow), as.agg.state.TurnDeadline)
+}
+
+func (as *AggregateSuite) Test_TurnTimeout_DefusePausedByReactionWindow() {
+	as.createWithRules(GameRules{NopeDefuse: true})
+
+	as.stackKitten()
+	as.NoError(as.draw(as.players[0]))
+	deadline := as.agg.state.PendingDefuse.Deadline
+
+	as.NoError(as.handle(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[0], Position: 1}))
+	as.giveCard(as.players[1], CardNope)
+	as.NoError(as.playCard(as.players[1], CardNope))
+	as.giveCard(as.players[0], CardDefuse)
+	as.NoError(as.closeWindow())
+
+	// The Noped Defuse leaves the kitten waiting for the Defuse the player still holds.
+	as.NotNil(as.agg.state.PendingDefuse)
+	as.Equal(deadline.Add(as.agg.state.ReactionWindow), as.agg.state.PendingDefuse.Deadline)
+}
+
+func (as *AggregateSuite) Test_TurnTimeout_PutsKittenBackAtRandom() {
+	as.stackKitten()
+	as.NoError(as.draw(as.players[0]))
+
+	as.Equal(TimeNow().Add(DefuseTimeout), as.agg.state.PendingDefuse.Deadline)
+
+	timeutil.MockedClock.Add(DefuseTimeout)
+	as.NoError(as.expireTurn())
+
+	as.Nil(as.agg.state.PendingDefuse)
+	as.False(as.agg.state.HasCard(as.players[0], CardExplodingKitten))
+	as.Contains(as.agg.state.DrawPile, CardExplodingKitten)
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_TurnTimeout_MarksPlayerAFK() {
+	for range DefaultAFKTimeouts {
+		as.Equal(as.players[0], as.agg.state.CurrentPlayerID)
+		as.False(as.agg.state.IsAFK(as.players[0]))
+
+		as.stackSafeCards(3)
+		timeutil.MockedClock.Add(DefaultTurnTimeout)
+		as.NoError(as.expireTurn())
+
+		as.NoError(as.draw(as.players[1]))
+		as.NoError(as.draw(as.players[2]))
+	}
+
+	as.True(as.agg.state.IsAFK(as.players[0]))
+	as.True(NewPlayerView(&as.agg.state, as.players[1]).Players[0].AFK)
+
+	// An AFK player is played for without waiting for the whole turn.
+	as.Equal(TimeNow().Add(AFKTimeout), as.agg.state.TurnDeadline)
+
+	// Acting again brings the player back.
+	as.stackSafeCards(1)
+	as.NoError(as.draw(as.players[0]))
+
+	as.False(as.agg.state.IsAFK(as.players[0]))
+	as.Zero(as.agg.state.Timeouts[as.players[0]])
+}
+
+func (as *AggregateSuite) disconnect(playerID uuid.UUID) error {
+	return as.handle(&DisconnectPlayer{GameID: as.gameID, PlayerID: playerID})
+}
+
+func (as *AggregateSuite) Test_Disconnect_PlaysForPlayer() {
+	as.NoError(as.disconnect(as.players[1]))
+	as.ErrorIs(as.disconnect(as.players[1]), ErrPlayerDisconnected)
+
+	as.True(as.agg.state.IsDisconnected(as.players[1]))
+	seat := NewPlayerView(&as.agg.state, as.players[0]).Players[1]
+	as.True(seat.Disconnected)
+	as.Equal(TimeNow().Add(DefaultDisconnectGrace), seat.ReconnectDeadline)
+
+	// The turn of a disconnected player is played for without waiting for the whole turn.
+	as.stackSafeCards(2)
+	as.NoError(as.draw(as.players[0]))
+	as.Equal(TimeNow().Add(AFKTimeout), as.agg.state.TurnDeadline)
+
+	timeutil.MockedClock.Add(AFKTimeout)
+	as.NoError(as.expireTurn())
+	as.Equal(as.players[2], as.agg.state.CurrentPlayerID)
+	as.True(as.agg.state.IsPlayer(as.players[1]))
+}
+
+func (as *AggregateSuite) Test_Reconnect_ClearsDisconnect() {
+	as.ErrorIs(as.handle(&ReconnectPlayer{GameID: as.gameID, PlayerID: as.players[1]}), ErrPlayerNotDisconnected)
+
+	as.NoError(as.disconnect(as.players[1]))
+	as.NoError(as.handle(&ReconnectPlayer{GameID: as.gameID, PlayerID: as.players[1]}))
+
+	as.False(as.agg.state.IsDisconnected(as.players[1]))
+	as.Empty(as.agg.state.Deadlines()[1:])
+
+	as.stackSafeCards(1)
+	as.NoError(as.draw(as.players[0]))
+	as.Equal(TimeNow().Add(DefaultTurnTimeout), as.agg.state.TurnDeadline)
+}
+
+func (as *AggregateSuite) Test_Disconnect_ExpiryKeepsPlayingFor() {
+	as.NoError(as.disconnect(as.players[1]))
+	for _, deadline := range as.agg.state.Deadlines() {
+		as.NotEqual(ExpireDisconnectCommand, deadline.Command.CommandType())
+	}
+
+	timeutil.MockedClock.Add(DefaultDisconnectGrace)
+	as.ErrorIs(as.handle(&ExpireDisconnect{GameID: as.gameID, PlayerID: as.players[1]}), ErrDisconnectKept)
+	as.True(as.agg.state.IsPlayer(as.players[1]))
+	as.True(as.agg.state.IsDisconnected(as.players[1]))
+}
+
+func (as *AggregateSuite) Test_Disconnect_ExpiryEliminatesPlayer() {
+	as.createWithRules(GameRules{EliminateDisconnected: true})
+	as.NoError(as.disconnect(as.players[1]))
+
+	deadline := TimeNow().Add(DefaultDisconnectGrace)
+	as.Contains(as.agg.state.Deadlines(), Deadline{At: deadline, Command: &ExpireDisconnect{GameID: as.gameID, PlayerID: as.players[1]}})
+
+	expire := &ExpireDisconnect{GameID: as.gameID, PlayerID: as.players[1]}
+	as.ErrorIs(as.handle(expire), ErrDeadlineNotReached)
+
+	timeutil.MockedClock.Add(DefaultDisconnectGrace)
+
+	// A disconnected player is only eliminated in between moves.
+	as.giveCard(as.players[0], CardSkip)
+	as.NoError(as.playCard(as.players[0], CardSkip))
+	as.ErrorIs(as.handle(expire), ErrReactionWindowOpen)
+	as.NoError(as.closeWindow())
+
+	as.NoError(as.handle(expire))
+	as.False(as.agg.state.IsPlayer(as.players[1]))
+	as.False(as.agg.state.IsDisconnected(as.players[1]))
+	as.Contains(as.agg.state.EliminatedPlayerIDs, as.players[1])
+	as.ErrorIs(as.handle(expire), ErrPlayerNotInGame)
+}
+
+// createWithRules starts the game of the suite over with house rules.
+func (as *AggregateSuite) createWithRules(rules GameRules) {
+	as.agg = &Aggregate{}
+	as.agg.OnCreate(as.gameID.String())
+	as.events = nil
+
+	as.NoError(as.handle(&CreateGame{
+		GameID:    as.gameID,
+		LobbyID:   uuid.Must(uuid.NewV7()),
+		PlayerIDs: as.players,
+		Rules:     rules,
+	}))
+}
+
+func (as *AggregateSuite) Test_Rules_OverrideTimers() {
+	as.createWithRules(GameRules{ReactionWindow: 5 * time.Second, TurnTimeout: time.Minute, Defuses: 2})
+
+	as.Equal(5*time.Second, as.agg.state.ReactionWindow)
+	as.Equal(time.Minute, as.agg.state.TurnTimeout)
+	as.Equal(TimeNow().Add(time.Minute), as.agg.state.TurnDeadline)
+
+	// The event keeps the rules as they were asked for.
+	created, ok := as.events[0].Data().(*GameCreated)
+	as.True(ok)
+	as.Equal(5*time.Second, created.ReactionWindow)
+	as.Equal(time.Minute, created.TurnTimeout)
+	as.Equal(5*time.Second, created.Rules.ReactionWindow)
+	as.Equal(time.Minute, created.Rules.TurnTimeout)
+	as.Equal(2, created.Rules.Defuses)
+	as.Len(as.agg.state.Hands[as.players[0]], InitialHandSize+2)
+
+	as.giveCard(as.players[0], CardSkip)
+	as.NoError(as.playCard(as.players[0], CardSkip))
+	as.Equal(TimeNow().Add(5*time.Second), as.agg.state.PendingAction.Deadline)
+}
+
+func (as *AggregateSuite) Test_Rules_NopedDefuseExplodes() {
+	as.createWithRules(GameRules{NopeDefuse: true})
+
+	as.stackKitten()
+	as.NoError(as.draw(as.players[0]))
+	as.NoError(as.handle(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[0], Position: 1}))
+
+	// The Defuse waits for its reaction window before the kitten is put back.
+	as.NotNil(as.agg.state.PendingAction)
+	as.NotNil(as.agg.state.PendingDefuse)
+	as.ErrorIs(as.handle(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[0], Position: 1}), ErrReactionWindowOpen)
+
+	as.giveCard(as.players[1], CardNope)
+	as.NoError(as.playCard(as.players[1], CardNope))
+	as.NoError(as.closeWindow())
+
+	as.False(as.agg.state.IsPlayer(as.players[0]))
+	as.Contains(as.agg.state.EliminatedPlayerIDs, as.players[0])
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_Rules_DefuseWithoutNope() {
+	as.createWithRules(GameRules{NopeDefuse: true})
+
+	as.stackKitten()
+	as.NoError(as.draw(as.players[0]))
+	as.NoError(as.handle(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[0], Position: 1}))
+	as.NoError(as.closeWindow())
+
+	as.Nil(as.agg.state.PendingDefuse)
+	as.Equal(CardExplodingKitten, as.agg.state.DrawPile[1])
+	as.False(as.agg.state.HasCard(as.players[0], CardDefuse))
+	as.Equal(as.players[1], as.agg.state.CurrentPlayerID)
+}
+
+func (as *AggregateSuite) Test_Rules_RevealEliminatedHands() {
+	as.createWithRules(GameRules{RevealEliminatedHands: true})
+
+	as.takeDefuses(as.players[0])
+	hand := slices.Clone(as.agg.state.Hands[as.players[0]])
+	as.stackKitten()
+	as.NoError(as.draw(as.players[0]))
+
+	as.False(as.agg.state.IsPlayer(as.players[0]))
+	seat := NewPlayerView(&as.agg.state, as.players[1]).Players[0]
+	as.ElementsMatch(append(hand, CardExplodingKitten), seat.RevealedHand)
+}
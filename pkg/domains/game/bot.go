@@ -0,0 +1,579 @@
+package game
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+)
+
+// BotThinkTime is how long a bot waits before making its move, so the other players can follow the game.
+const BotThinkTime = time.Second
+
+const (
+	// hardBotPeekRisk is the odds of drawing a kitten from which a hard bot looks at the future before drawing.
+	hardBotPeekRisk = 0.2
+	// hardBotEscapeRisk is the odds of drawing a kitten from which a hard bot without a Defuse avoids drawing.
+	hardBotEscapeRisk = 0.34
+)
+
+// BotDifficulty tells how well a bot plays.
+type BotDifficulty string
+
+const (
+	// BotEasy plays randomly among the legal moves.
+	BotEasy BotDifficulty = "EASY"
+	// BotHard keeps track of the cards it has seen and holds its Nope and Defuse cards for when they matter.
+	BotHard BotDifficulty = "HARD"
+)
+
+func (d BotDifficulty) String() string {
+	return string(d)
+}
+
+// IsValid reports whether the difficulty is known.
+func (d BotDifficulty) IsValid() bool {
+	return d == BotEasy || d == BotHard
+}
+
+// botEscapes are the cards a hard bot plays to avoid drawing a kitten, in order of preference.
+var botEscapes = []CardType{
+	CardSkip,
+	CardSuperSkip,
+	CardAttack,
+	CardTargetedAttack,
+	CardCatomicBomb,
+	CardReverse,
+	CardDrawFromTheBottom,
+	CardSwapTopAndBottom,
+	CardAlterTheFuture,
+	CardAlterTheFuture5,
+	CardShuffle,
+}
+
+// botPeeks are the cards a hard bot plays to see the top of the draw pile.
+var botPeeks = []CardType{
+	CardSeeTheFuture,
+	CardSeeTheFuture5,
+	CardAlterTheFuture,
+	CardAlterTheFuture5,
+}
+
+// botReorders are the cards that change the order of the draw pile once played, after which a bot
+// forgets the future it has seen.
+var botReorders = []CardType{
+	CardDefuse,
+	CardShuffle,
+	CardSwapTopAndBottom,
+	CardCatomicBomb,
+	CardGarbageCollection,
+	CardAlterTheFuture,
+	CardAlterTheFuture5,
+}
+
+// botCardValues ranks the cards by how much a hard bot wants to keep them, the cards left out are worth the least.
+var botCardValues = map[CardType]int{
+	CardExplodingKitten:   -1,
+	CardDefuse:            10,
+	CardStreakingKitten:   9,
+	CardNope:              8,
+	CardSuperSkip:         7,
+	CardSkip:              6,
+	CardAttack:            6,
+	CardTargetedAttack:    6,
+	CardSeeTheFuture5:     5,
+	CardAlterTheFuture5:   5,
+	CardAlterTheFuture:    5,
+	CardSeeTheFuture:      4,
+	CardCatomicBomb:       4,
+	CardDrawFromTheBottom: 4,
+	CardReverse:           3,
+	CardShuffle:           3,
+	CardFavor:             2,
+	CardSwapTopAndBottom:  2,
+	CardFeralCat:          2,
+	CardMark:              1,
+	CardCurseOfTheCatButt: 1,
+	CardGarbageCollection: 1,
+}
+
+// BotMove returns the next command one of the bots of the game issues, nil while the game waits on
+// the other players. A bot decides from its player view and from the discard pile, which is face up,
+// so it never knows more than a human in its seat would.
+func BotMove(state *Game) (eventing.Command, error) {
+	if state.Finished || len(state.Bots) == 0 {
+		return nil, nil
+	}
+
+	// A card choice or a kitten to put back holds the game until the player answers.
+	if pending := state.PendingInteraction; pending != nil {
+		if !state.IsBot(pending.ChooserID) {
+			return nil, nil
+		}
+
+		return newBot(state, pending.ChooserID).answer()
+	}
+
+	// A Defuse that can be Noped is answered like any other action.
+	if pending := state.PendingDefuse; pending != nil && state.PendingAction == nil {
+		if !state.IsBot(pending.PlayerID) {
+			return nil, nil
+		}
+
+		return newBot(state, pending.PlayerID).defuse()
+	}
+
+	if state.PendingAction != nil {
+		for _, playerID := range state.TurnOrder {
+			if !state.IsBot(playerID) {
+				continue
+			}
+
+			cmd, err := newBot(state, playerID).react()
+			if err != nil || cmd != nil {
+				return cmd, err
+			}
+		}
+
+		return nil, nil
+	}
+
+	if !state.IsBot(state.CurrentPlayerID) {
+		return nil, nil
+	}
+
+	return newBot(state, state.CurrentPlayerID).play()
+}
+
+// botDeadline returns the next move of the bots of the game, made once they thought about it
+// and before the reaction window they answer closes.
+func botDeadline(state *Game) (*Deadline, error) {
+	cmd, err := BotMove(state)
+	if err != nil || cmd == nil {
+		return nil, err
+	}
+
+	// The move only holds in the state it was decided from, a move already played by another scheduler
+	// is rejected by the game.
+	if versioned, ok := cmd.(versionedCommand); ok {
+		versioned.SetExpectedVersion(state.Version)
+	}
+
+	now := TimeNow()
+	at := now.Add(BotThinkTime)
+	if pending := state.PendingAction; pending != nil {
+		if halfway := now.Add(pending.Deadline.Sub(now) / 2); halfway.Before(at) {
+			at = halfway
+		}
+	}
+
+	return &Deadline{At: at, Command: cmd}, nil
+}
+
+// bot decides the moves of a player played by the server.
+type bot struct {
+	// state is only used to check that a move is legal, decisions are made from the view.
+	state      *Game
+	view       *PlayerView
+	playerID   uuid.UUID
+	difficulty BotDifficulty
+}
+
+func newBot(state *Game, playerID uuid.UUID) *bot {
+	return &bot{
+		state:      state,
+		view:       NewPlayerView(state, playerID),
+		playerID:   playerID,
+		difficulty: state.Bots[playerID],
+	}
+}
+
+// legal reports whether the game would accept the command right now.
+func (b *bot) legal(cmd eventing.Command) bool {
+	a := &Aggregate{currentGameID: b.state.GameID, state: *b.state}
+
+	return cmd.Validate() == nil && a.validateCommand(cmd) == nil
+}
+
+// firstLegal returns the first legal command.
+func (b *bot) firstLegal(cmds ...eventing.Command) eventing.Command {
+	for _, cmd := range cmds {
+		if cmd != nil && b.legal(cmd) {
+			return cmd
+		}
+	}
+
+	return nil
+}
+
+// answer picks a card, or an order of cards, for the pending interaction of the bot.
+func (b *bot) answer() (eventing.Command, error) {
+	pending := b.state.PendingInteraction
+	options, _ := interactionOptions(b.state, pending)
+	if len(options) == 0 {
+		return nil, nil
+	}
+
+	if _, ok := arrangeRules[pending.Kind]; ok {
+		cards, err := b.arrange(options)
+		if err != nil {
+			return nil, err
+		}
+
+		return &AlterFuture{GameID: b.view.GameID, PlayerID: b.playerID, Cards: cards}, nil
+	}
+
+	card, err := b.choose(pending.Kind, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChooseCard{GameID: b.view.GameID, PlayerID: b.playerID, Card: card}, nil
+}
+
+// choose picks one of the options: a hard bot keeps its best cards and takes the best card it is offered.
+func (b *bot) choose(kind InteractionKind, options []CardType) (CardType, error) {
+	if b.difficulty != BotHard || b.view.HandHidden {
+		index, err := randomIndex(len(options))
+		if err != nil {
+			return "", err
+		}
+
+		return options[index], nil
+	}
+
+	ranked := slices.Clone(options)
+	slices.SortStableFunc(ranked, func(a, b CardType) int {
+		return botCardValues[a] - botCardValues[b]
+	})
+
+	if kind == InteractionDiscardPick {
+		return ranked[len(ranked)-1], nil
+	}
+
+	return ranked[0], nil
+}
+
+// arrange puts the top cards of the draw pile back: a hard bot buries the kittens under the other cards.
+func (b *bot) arrange(cards []CardType) ([]CardType, error) {
+	if b.difficulty == BotHard {
+		safe := slices.DeleteFunc(slices.Clone(cards), isKitten)
+		kittens := slices.DeleteFunc(slices.Clone(cards), func(card CardType) bool {
+			return !isKitten(card)
+		})
+
+		return slices.Concat(safe, kittens), nil
+	}
+
+	arranged := slices.Clone(cards)
+	for i := len(arranged) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return nil, err
+		}
+		arranged[i], arranged[j] = arranged[j], arranged[i]
+	}
+
+	return arranged, nil
+}
+
+// defuse puts the kitten back: a hard bot leaves it on top for the next player, unless it has to draw again.
+func (b *bot) defuse() (eventing.Command, error) {
+	position := 0
+	switch {
+	case b.difficulty != BotHard:
+		index, err := randomIndex(b.view.DrawPileSize + 1)
+		if err != nil {
+			return nil, err
+		}
+		position = index
+	case b.view.TurnsRemaining > 1:
+		position = b.view.DrawPileSize
+	}
+
+	return &DefuseKitten{GameID: b.view.GameID, PlayerID: b.playerID, Position: position}, nil
+}
+
+// react decides whether the bot nopes the pending action.
+func (b *bot) react() (eventing.Command, error) {
+	nope := &PlayCard{GameID: b.view.GameID, PlayerID: b.playerID, Card: CardNope}
+	if b.view.HandHidden || !b.legal(nope) {
+		return nil, nil
+	}
+
+	if b.difficulty == BotHard {
+		if b.wantsNope(b.view.PendingAction) {
+			return nope, nil
+		}
+
+		return nil, nil
+	}
+
+	index, err := randomIndex(2)
+	if err != nil || index == 0 {
+		return nil, err
+	}
+
+	return nope, nil
+}
+
+// wantsNope reports whether a hard bot spends a Nope on the action: to stop an action aimed at the bot,
+// or to take back its own way out of drawing a kitten.
+func (b *bot) wantsNope(action *ActionView) bool {
+	effective := action.NopeCount%2 == 0
+	if action.PlayerID == b.playerID {
+		return !effective && (slices.Contains(botEscapes, action.Card) || action.Card == CardDefuse)
+	}
+
+	if !effective {
+		return false
+	}
+
+	if action.TargetPlayerID == b.playerID {
+		return true
+	}
+
+	switch action.Card {
+	case CardDefuse:
+		return true
+	case CardAttack, CardCatomicBomb:
+		return b.state.NextPlayerID(action.PlayerID) == b.playerID
+	}
+
+	return false
+}
+
+// play decides the move of the bot during its turn.
+func (b *bot) play() (eventing.Command, error) {
+	draw := &DrawCard{GameID: b.view.GameID, PlayerID: b.playerID}
+
+	// A cursed bot does not know what it would play.
+	if b.view.HandHidden {
+		return b.firstLegal(draw), nil
+	}
+
+	if b.difficulty == BotHard {
+		if cmd := b.hardPlay(); cmd != nil {
+			return cmd, nil
+		}
+
+		// Once the kittens held thanks to Streaking Kittens emptied the draw pile, the only way
+		// out of the turn is a card ending it.
+		return b.firstLegal(append([]eventing.Command{draw}, b.plays(botEscapes...)...)...), nil
+	}
+
+	moves := b.turnMoves()
+	if b.legal(draw) {
+		moves = append(moves, draw)
+	}
+
+	if len(moves) == 0 {
+		return nil, nil
+	}
+
+	index, err := randomIndex(len(moves))
+	if err != nil {
+		return nil, err
+	}
+
+	return moves[index], nil
+}
+
+// hardPlay returns the card a hard bot plays before drawing, nil when it draws right away.
+func (b *bot) hardPlay() eventing.Command {
+	top, known := b.topCard()
+	if known && !isKitten(top) {
+		return nil
+	}
+
+	hasDefuse := slices.Contains(b.view.Hand, CardDefuse)
+
+	if known {
+		if cmd := b.firstLegal(b.plays(botEscapes...)...); cmd != nil {
+			return cmd
+		}
+
+		if hasDefuse {
+			return nil
+		}
+
+		return b.steal()
+	}
+
+	risk := b.kittenRisk()
+	if risk >= hardBotPeekRisk {
+		if cmd := b.firstLegal(b.plays(botPeeks...)...); cmd != nil {
+			return cmd
+		}
+	}
+
+	if hasDefuse {
+		return nil
+	}
+
+	if risk >= hardBotEscapeRisk {
+		if cmd := b.firstLegal(b.plays(botEscapes...)...); cmd != nil {
+			return cmd
+		}
+	}
+
+	return b.steal()
+}
+
+// steal plays a Favor or a pair on the opponent holding the most cards, hoping for a Defuse.
+func (b *bot) steal() eventing.Command {
+	targetID := uuid.Nil
+	most := 0
+	for _, seat := range b.view.Players {
+		if seat.PlayerID != b.playerID && !seat.Eliminated && seat.CardCount > most {
+			targetID = seat.PlayerID
+			most = seat.CardCount
+		}
+	}
+
+	if targetID == uuid.Nil {
+		return nil
+	}
+
+	cmds := []eventing.Command{
+		&PlayCard{GameID: b.view.GameID, PlayerID: b.playerID, Card: CardFavor, TargetPlayerID: targetID},
+	}
+	for _, card := range b.distinctHand() {
+		if IsCatCard(card) && countCards(b.view.Hand, card) >= 2 {
+			cmds = append(cmds, &PlayCombo{GameID: b.view.GameID, PlayerID: b.playerID, Cards: []CardType{card, card}, TargetPlayerID: targetID})
+		}
+	}
+
+	return b.firstLegal(cmds...)
+}
+
+// topCard returns the top card of the draw pile when the bot knows it, from a face up Imploding Kitten
+// or from a future it has seen and that no card played since may have changed.
+func (b *bot) topCard() (CardType, bool) {
+	if b.view.ImplodingKittenPosition == 0 {
+		return CardImplodingKitten, true
+	}
+
+	reveal := b.view.Reveal
+	if reveal == nil || reveal.Kind != RevealFuture || len(reveal.Cards) == 0 {
+		return "", false
+	}
+
+	discards := b.state.DiscardPile
+	if reveal.DrawPileSize != b.view.DrawPileSize || reveal.DiscardPileSize > len(discards) {
+		return "", false
+	}
+
+	for _, card := range discards[reveal.DiscardPileSize:] {
+		if slices.Contains(botReorders, card) {
+			return "", false
+		}
+	}
+
+	// The Imploding Kitten put back face up since moved the cards under it.
+	if position := b.view.ImplodingKittenPosition; position > 0 && position < len(reveal.Cards) && reveal.Cards[position] != CardImplodingKitten {
+		return "", false
+	}
+
+	return reveal.Cards[0], true
+}
+
+// kittenRisk estimates the odds of drawing a kitten. The draw pile holds one kitten less than there
+// are players left, apart from the kittens held thanks to a Streaking Kitten.
+func (b *bot) kittenRisk() float64 {
+	if b.view.DrawPileSize == 0 {
+		return 0
+	}
+
+	kittens := -1 - countCards(b.view.Hand, CardExplodingKitten)
+	for _, seat := range b.view.Players {
+		if !seat.Eliminated {
+			kittens++
+		}
+	}
+
+	return float64(max(kittens, 0)) / float64(b.view.DrawPileSize)
+}
+
+// turnMoves returns the legal plays of the bot during its turn, besides drawing.
+func (b *bot) turnMoves() []eventing.Command {
+	moves := make([]eventing.Command, 0)
+	for _, cmd := range b.plays(b.distinctHand()...) {
+		if b.legal(cmd) {
+			moves = append(moves, cmd)
+		}
+	}
+
+	combos := make([]eventing.Command, 0)
+	cards := b.distinctHand()
+	for _, card := range cards {
+		count := countCards(b.view.Hand, card)
+		for _, targetID := range b.opponents() {
+			if count >= 2 {
+				combos = append(combos, &PlayCombo{GameID: b.view.GameID, PlayerID: b.playerID, Cards: []CardType{card, card}, TargetPlayerID: targetID})
+			}
+
+			if count >= 3 {
+				combos = append(combos, &PlayCombo{GameID: b.view.GameID, PlayerID: b.playerID, Cards: []CardType{card, card, card}, TargetPlayerID: targetID, NamedCard: CardDefuse})
+			}
+		}
+	}
+
+	if len(cards) >= 5 && len(b.state.DiscardPile) > 0 {
+		combos = append(combos, &PlayCombo{GameID: b.view.GameID, PlayerID: b.playerID, Cards: cards[:5]})
+	}
+
+	for _, cmd := range combos {
+		if b.legal(cmd) {
+			moves = append(moves, cmd)
+		}
+	}
+
+	return moves
+}
+
+// plays returns the ways to play each of the cards held by the bot: on its own when the card allows it,
+// otherwise aimed at each opponent.
+func (b *bot) plays(cards ...CardType) []eventing.Command {
+	cmds := make([]eventing.Command, 0)
+	for _, card := range cards {
+		if !slices.Contains(b.view.Hand, card) {
+			continue
+		}
+
+		play := &PlayCard{GameID: b.view.GameID, PlayerID: b.playerID, Card: card}
+		if b.legal(play) {
+			cmds = append(cmds, play)
+			continue
+		}
+
+		for _, targetID := range b.opponents() {
+			cmds = append(cmds, &PlayCard{GameID: b.view.GameID, PlayerID: b.playerID, Card: card, TargetPlayerID: targetID})
+		}
+	}
+
+	return cmds
+}
+
+// distinctHand returns the card types held by the bot, sorted.
+func (b *bot) distinctHand() []CardType {
+	return slices.Compact(slices.Sorted(slices.Values(b.view.Hand)))
+}
+
+// opponents returns the other players still in the game.
+func (b *bot) opponents() []uuid.UUID {
+	opponents := make([]uuid.UUID, 0, len(b.view.Players))
+	for _, seat := range b.view.Players {
+		if seat.PlayerID != b.playerID && !seat.Eliminated {
+			opponents = append(opponents, seat.PlayerID)
+		}
+	}
+
+	return opponents
+}
+
+func isKitten(card CardType) bool {
+	return card == CardExplodingKitten || card == CardImplodingKitten
+}
@@ -0,0 +1,169 @@
+package game
+
+import (
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+)
+
+// playBotGame plays a game between bots until it finishes, issuing the deadlines of the game
+// whenever the bots wait.
+func (as *AggregateSuite) playBotGame(expansions []Expansion, difficulties ...BotDifficulty) {
+	players := make([]uuid.UUID, 0, len(difficulties))
+	bots := make(map[uuid.UUID]BotDifficulty)
+	for _, difficulty := range difficulties {
+		playerID := uuid.Must(uuid.NewV7())
+		players = append(players, playerID)
+		bots[playerID] = difficulty
+	}
+
+	as.gameID = uuid.Must(uuid.NewV7())
+	as.agg = &Aggregate{}
+	as.agg.OnCreate(as.gameID.String())
+	as.events = nil
+	as.Require().NoError(as.handle(&CreateGame{
+		GameID:     as.gameID,
+		LobbyID:    uuid.Must(uuid.NewV7()),
+		PlayerIDs:  players,
+		Expansions: expansions,
+		Bots:       bots,
+	}))
+
+	for range 5000 {
+		if as.agg.state.Finished {
+			return
+		}
+
+		cmd, err := BotMove(&as.agg.state)
+		as.Require().NoError(err)
+
+		if cmd == nil {
+			deadlines := as.agg.state.Deadlines()
+			as.Require().NotEmpty(deadlines)
+
+			if wait := deadlines[0].At.Sub(TimeNow()); wait > 0 {
+				timeutil.MockedClock.Add(wait)
+			}
+			cmd = deadlines[0].Command
+		}
+
+		as.Require().NoError(as.handle(cmd), cmd.CommandType().String())
+	}
+
+	as.Fail("the bots did not finish the game")
+}
+
+func (as *AggregateSuite) Test_Bot_PlaysWholeGames() {
+	expansions := []Expansion{ExpansionImplodingKittens, ExpansionPartyPack}
+
+	for range 10 {
+		as.playBotGame(nil, BotEasy, BotEasy, BotEasy)
+		as.playBotGame(nil, BotHard, BotHard)
+		as.playBotGame(expansions, BotEasy, BotHard, BotEasy, BotHard, BotEasy, BotHard)
+	}
+}
+
+func (as *AggregateSuite) Test_Bot_WaitsForHumans() {
+	as.agg.state.Bots = map[uuid.UUID]BotDifficulty{as.players[1]: BotHard}
+
+	cmd, err := BotMove(&as.agg.state)
+	as.NoError(err)
+	as.Nil(cmd)
+}
+
+func (as *AggregateSuite) Test_Bot_HardEscapesKnownKitten() {
+	as.agg.state.Bots = map[uuid.UUID]BotDifficulty{as.players[0]: BotHard}
+	as.giveCard(as.players[0], CardSkip)
+	as.stackKitten()
+
+	as.playAction(as.players[0], CardSeeTheFuture)
+
+	cmd, err := BotMove(&as.agg.state)
+	as.NoError(err)
+	as.Equal(&PlayCard{GameID: as.gameID, PlayerID: as.players[0], Card: CardSkip}, cmd)
+
+	// A card played since that changes the order of the draw pile makes the seen future stale.
+	as.playAction(as.players[0], CardSwapTopAndBottom)
+
+	cmd, err = BotMove(&as.agg.state)
+	as.NoError(err)
+	as.Equal(&DrawCard{GameID: as.gameID, PlayerID: as.players[0]}, cmd)
+}
+
+func (as *AggregateSuite) Test_Bot_HardDrawsKnownSafeCard() {
+	as.agg.state.Bots = map[uuid.UUID]BotDifficulty{as.players[0]: BotHard}
+	as.giveCard(as.players[0], CardSkip)
+	as.stackSafeCards(1)
+
+	as.playAction(as.players[0], CardSeeTheFuture)
+
+	cmd, err := BotMove(&as.agg.state)
+	as.NoError(err)
+	as.Equal(&DrawCard{GameID: as.gameID, PlayerID: as.players[0]}, cmd)
+}
+
+func (as *AggregateSuite) Test_Bot_HardPutsKittenBackOnTop() {
+	as.agg.state.Bots = map[uuid.UUID]BotDifficulty{as.players[0]: BotHard}
+	as.stackKitten()
+	as.NoError(as.draw(as.players[0]))
+
+	cmd, err := BotMove(&as.agg.state)
+	as.NoError(err)
+	as.Equal(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[0], Position: 0}, cmd)
+
+	// With a turn left to play, the kitten goes to the bottom instead.
+	as.agg.state.TurnsRemaining = 2
+
+	cmd, err = BotMove(&as.agg.state)
+	as.NoError(err)
+	as.Equal(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[0], Position: len(as.agg.state.DrawPile)}, cmd)
+}
+
+func (as *AggregateSuite) Test_Bot_HardNopesActionsAimedAtIt() {
+	as.agg.state.Bots = map[uuid.UUID]BotDifficulty{as.players[1]: BotHard}
+	as.giveCard(as.players[1], CardNope)
+
+	as.giveCard(as.players[0], CardFavor)
+	as.NoError(as.handle(&PlayCard{GameID: as.gameID, PlayerID: as.players[0], Card: CardFavor, TargetPlayerID: as.players[2]}))
+
+	cmd, err := BotMove(&as.agg.state)
+	as.NoError(err)
+	as.Nil(cmd)
+
+	as.NoError(as.closeWindow())
+	as.NoError(as.handle(&ChooseCard{GameID: as.gameID, PlayerID: as.players[2], Card: as.agg.state.Hands[as.players[2]][0]}))
+
+	as.giveCard(as.players[0], CardFavor)
+	as.NoError(as.handle(&PlayCard{GameID: as.gameID, PlayerID: as.players[0], Card: CardFavor, TargetPlayerID: as.players[1]}))
+
+	cmd, err = BotMove(&as.agg.state)
+	as.NoError(err)
+	as.Equal(&PlayCard{GameID: as.gameID, PlayerID: as.players[1], Card: CardNope}, cmd)
+}
+
+func (as *AggregateSuite) Test_Bot_HardGivesLeastValuableCard() {
+	as.agg.state.Bots = map[uuid.UUID]BotDifficulty{as.players[1]: BotHard}
+	as.agg.state.Hands[as.players[1]] = []CardType{CardDefuse, CardTacoCat, CardSkip}
+
+	as.playFavor(as.players[1])
+
+	cmd, err := BotMove(&as.agg.state)
+	as.NoError(err)
+	as.Equal(&ChooseCard{GameID: as.gameID, PlayerID: as.players[1], Card: CardTacoCat}, cmd)
+}
+
+func (as *AggregateSuite) Test_CreateGame_InvalidBots() {
+	cmd := &CreateGame{
+		GameID:    as.gameID,
+		LobbyID:   uuid.Must(uuid.NewV7()),
+		PlayerIDs: as.players,
+		Bots:      map[uuid.UUID]BotDifficulty{uuid.Must(uuid.NewV7()): BotEasy},
+	}
+	as.Error(cmd.Validate())
+
+	cmd.Bots = map[uuid.UUID]BotDifficulty{as.players[0]: BotDifficulty("GODLIKE")}
+	as.Error(cmd.Validate())
+
+	cmd.Bots = map[uuid.UUID]BotDifficulty{as.players[0]: BotEasy}
+	as.NoError(cmd.Validate())
+}
@@ -0,0 +1,79 @@
+package game
+
+// CardType identifies the kind of a card. Cards of the same type are interchangeable,
+// so hands and piles only need to hold card types.
+type CardType string
+
+const (
+	CardExplodingKitten    CardType = "EXPLODING_KITTEN"
+	CardDefuse             CardType = "DEFUSE"
+	CardNope               CardType = "NOPE"
+	CardAttack             CardType = "ATTACK"
+	CardSkip               CardType = "SKIP"
+	CardFavor              CardType = "FAVOR"
+	CardShuffle            CardType = "SHUFFLE"
+	CardSeeTheFuture       CardType = "SEE_THE_FUTURE"
+	CardTacoCat            CardType = "TACOCAT"
+	CardHairyPotatoCat     CardType = "HAIRY_POTATO_CAT"
+	CardCattermelon        CardType = "CATTERMELON"
+	CardBeardCat           CardType = "BEARD_CAT"
+	CardRainbowRalphingCat CardType = "RAINBOW_RALPHING_CAT"
+
+	// Imploding Kittens expansion.
+	CardImplodingKitten   CardType = "IMPLODING_KITTEN"
+	CardReverse           CardType = "REVERSE"
+	CardDrawFromTheBottom CardType = "DRAW_FROM_THE_BOTTOM"
+	CardAlterTheFuture    CardType = "ALTER_THE_FUTURE"
+	CardTargetedAttack    CardType = "TARGETED_ATTACK"
+	CardFeralCat          CardType = "FERAL_CAT"
+
+	// Streaking Kittens expansion.
+	CardStreakingKitten   CardType = "STREAKING_KITTEN"
+	CardSuperSkip         CardType = "SUPER_SKIP"
+	CardSeeTheFuture5     CardType = "SEE_THE_FUTURE_5"
+	CardAlterTheFuture5   CardType = "ALTER_THE_FUTURE_5"
+	CardSwapTopAndBottom  CardType = "SWAP_TOP_AND_BOTTOM"
+	CardGarbageCollection CardType = "GARBAGE_COLLECTION"
+	CardCatomicBomb       CardType = "CATOMIC_BOMB"
+	CardMark              CardType = "MARK"
+	CardCurseOfTheCatButt CardType = "CURSE_OF_THE_CAT_BUTT"
+)
+
+func (c CardType) String() string {
+	return string(c)
+}
+
+// CardCount is the number of copies of a card type in a catalog.
+type CardCount struct {
+	Card  CardType `json:"card"`
+	Count int      `json:"count"`
+}
+
+// baseCatalog is the card list of the original Exploding Kittens deck.
+// Its order is part of the deck building algorithm and must not be changed,
+// otherwise recorded seeds would no longer rebuild the same decks.
+var baseCatalog = []CardCount{
+	{Card: CardExplodingKitten, Count: 4},
+	{Card: CardDefuse, Count: 6},
+	{Card: CardNope, Count: 5},
+	{Card: CardAttack, Count: 4},
+	{Card: CardSkip, Count: 4},
+	{Card: CardFavor, Count: 4},
+	{Card: CardShuffle, Count: 4},
+	{Card: CardSeeTheFuture, Count: 5},
+	{Card: CardTacoCat, Count: 4},
+	{Card: CardHairyPotatoCat, Count: 4},
+	{Card: CardCattermelon, Count: 4},
+	{Card: CardBeardCat, Count: 4},
+	{Card: CardRainbowRalphingCat, Count: 4},
+}
+
+// IsCatCard reports whether the card has no action of its own and can only be played in combos.
+func IsCatCard(card CardType) bool {
+	switch card {
+	case CardTacoCat, CardHairyPotatoCat, CardCattermelon, CardBeardCat, CardRainbowRalphingCat, CardFeralCat:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package game
+
+// ComboType identifies a combination of cards played together.
+type ComboType string
+
+const (
+	// ComboPair steals a random card from the target.
+	ComboPair ComboType = "PAIR"
+	// ComboThreeOfAKind names a card to take from the target.
+	ComboThreeOfAKind ComboType = "THREE_OF_A_KIND"
+	// ComboFiveDifferent picks any card from the discard pile.
+	ComboFiveDifferent ComboType = "FIVE_DIFFERENT"
+)
+
+func (c ComboType) String() string {
+	return string(c)
+}
+
+// ComboRule is the rule of a combo. Like card rules, each combo lives in its own rule_combo_*.go file.
+type ComboRule interface {
+	// Matches reports whether the cards form this combo.
+	Matches(cards []CardType) bool
+	// Validate checks that the combo can be played right now.
+	Validate(state *Game, cmd *PlayCombo) error
+	// Resolve appends the events produced by the effect of the combo.
+	Resolve(e *emitter, play *CardPlayed) error
+}
+
+var (
+	comboRules = make(map[ComboType]ComboRule)
+	// comboOrder keeps the registration order so matching does not depend on map iteration.
+	comboOrder = make([]ComboType, 0)
+)
+
+// registerComboRule registers the rule of a combo.
+func registerComboRule(combo ComboType, rule ComboRule) {
+	if _, ok := comboRules[combo]; ok {
+		panic("combo rule already registered: " + combo.String())
+	}
+
+	comboRules[combo] = rule
+	comboOrder = append(comboOrder, combo)
+}
+
+// comboRule returns the rule of a combo.
+func comboRule(combo ComboType) (ComboRule, bool) {
+	rule, ok := comboRules[combo]
+	return rule, ok
+}
+
+// matchCombo returns the combo formed by the cards.
+func matchCombo(cards []CardType) (ComboType, ComboRule, bool) {
+	for _, combo := range comboOrder {
+		if rule := comboRules[combo]; rule.Matches(cards) {
+			return combo, rule, true
+		}
+	}
+
+	return "", nil, false
+}
+
+// wildcards maps a card to the check of the cards it can stand for in a combo.
+var wildcards = make(map[CardType]func(card CardType) bool)
+
+// registerWildcard registers a card that can stand for other cards in a combo.
+func registerWildcard(card CardType, standsFor func(card CardType) bool) {
+	if _, ok := wildcards[card]; ok {
+		panic("wildcard already registered: " + card.String())
+	}
+
+	wildcards[card] = standsFor
+}
+
+// sameCards reports whether there are exactly count cards, all of the same type
+// once the wildcards stand for the other cards.
+func sameCards(cards []CardType, count int) bool {
+	if len(cards) != count {
+		return false
+	}
+
+	// The first card that is not a wildcard decides what the wildcards stand for.
+	want := cards[0]
+	for _, card := range cards {
+		if _, ok := wildcards[card]; !ok {
+			want = card
+			break
+		}
+	}
+
+	for _, card := range cards {
+		if card == want {
+			continue
+		}
+
+		if standsFor, ok := wildcards[card]; ok && standsFor(want) {
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,487 @@
+package game
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+)
+
+func init() {
+	eventing.RegisterCommand[CreateGame, *CreateGame]()
+	eventing.RegisterCommand[DrawCard, *DrawCard]()
+	eventing.RegisterCommand[PlayCard, *PlayCard]()
+	eventing.RegisterCommand[ResolveAction, *ResolveAction]()
+	eventing.RegisterCommand[DefuseKitten, *DefuseKitten]()
+	eventing.RegisterCommand[PlayCombo, *PlayCombo]()
+	eventing.RegisterCommand[ChooseCard, *ChooseCard]()
+	eventing.RegisterCommand[ExpireInteraction, *ExpireInteraction]()
+	eventing.RegisterCommand[AlterFuture, *AlterFuture]()
+	eventing.RegisterCommand[ExpireTurn, *ExpireTurn]()
+	eventing.RegisterCommand[DisconnectPlayer, *DisconnectPlayer]()
+	eventing.RegisterCommand[ReconnectPlayer, *ReconnectPlayer]()
+	eventing.RegisterCommand[ExpireDisconnect, *ExpireDisconnect]()
+}
+
+const (
+	CreateGameCommand        = common.CommandType("game:create")
+	DrawCardCommand          = common.CommandType("game:draw_card")
+	PlayCardCommand          = common.CommandType("game:play_card")
+	ResolveActionCommand     = common.CommandType("game:resolve_action")
+	DefuseKittenCommand      = common.CommandType("game:defuse_kitten")
+	PlayComboCommand         = common.CommandType("game:play_combo")
+	ChooseCardCommand        = common.CommandType("game:choose_card")
+	ExpireInteractionCommand = common.CommandType("game:expire_interaction")
+	AlterFutureCommand       = common.CommandType("game:alter_future")
+	ExpireTurnCommand        = common.CommandType("game:expire_turn")
+	DisconnectPlayerCommand  = common.CommandType("game:disconnect_player")
+	ReconnectPlayerCommand   = common.CommandType("game:reconnect_player")
+	ExpireDisconnectCommand  = common.CommandType("game:expire_disconnect")
+)
+
+var AllCommands = []common.CommandType{
+	CreateGameCommand,
+	DrawCardCommand,
+	PlayCardCommand,
+	ResolveActionCommand,
+	DefuseKittenCommand,
+	PlayComboCommand,
+	ChooseCardCommand,
+	ExpireInteractionCommand,
+	AlterFutureCommand,
+	ExpireTurnCommand,
+	DisconnectPlayerCommand,
+	ReconnectPlayerCommand,
+	ExpireDisconnectCommand,
+}
+
+// Static type check that the eventing.Command interface is implemented.
+var _ = eventing.Command(&CreateGame{})
+var _ = eventing.Command(&DrawCard{})
+var _ = eventing.Command(&PlayCard{})
+var _ = eventing.Command(&ResolveAction{})
+var _ = eventing.Command(&DefuseKitten{})
+var _ = eventing.Command(&PlayCombo{})
+var _ = eventing.Command(&ChooseCard{})
+var _ = eventing.Command(&ExpireInteraction{})
+var _ = eventing.Command(&AlterFuture{})
+var _ = eventing.Command(&ExpireTurn{})
+var _ = eventing.Command(&DisconnectPlayer{})
+var _ = eventing.Command(&ReconnectPlayer{})
+var _ = eventing.Command(&ExpireDisconnect{})
+
+// Versioned pins a command to the version of the game it was decided from, the game rejects it once
+// another event was applied. Commands without a version are accepted in any state.
+type Versioned struct {
+	ExpectedVersion uint64 `json:"expected_version,omitempty"`
+}
+
+func (v *Versioned) GetExpectedVersion() uint64 { return v.ExpectedVersion }
+
+func (v *Versioned) SetExpectedVersion(version uint64) { v.ExpectedVersion = version }
+
+// versionedCommand is a command that may be pinned to a version of the game.
+type versionedCommand interface {
+	eventing.Command
+	GetExpectedVersion() uint64
+	SetExpectedVersion(version uint64)
+}
+
+type CreateGame struct {
+	GameID    uuid.UUID   `json:"game_id"`
+	LobbyID   uuid.UUID   `json:"lobby_id"`
+	PlayerIDs []uuid.UUID `json:"player_ids"`
+	// DeckSeed is optional, a random seed is generated when it is empty.
+	DeckSeed []byte `json:"deck_seed,omitempty"`
+	// Expansions are the optional card sets added to the base deck.
+	Expansions []Expansion `json:"expansions,omitempty"`
+	// AFKTimeouts is optional, DefaultAFKTimeouts is used when it is zero.
+	AFKTimeouts int `json:"afk_timeouts,omitempty"`
+	// Bots maps the players played by the server to their difficulty.
+	Bots map[uuid.UUID]BotDifficulty `json:"bots,omitempty"`
+	// Ranked games change the ratings of the players, unless a bot takes part.
+	Ranked bool `json:"ranked,omitempty"`
+	// Rules are the house rules of the game, DefaultReactionWindow and DefaultTurnTimeout are used
+	// when their timers are zero.
+	Rules GameRules `json:"rules,omitempty"`
+}
+
+func (c *CreateGame) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *CreateGame) AggregateID() string { return c.GameID.String() }
+
+func (c *CreateGame) CommandType() common.CommandType { return CreateGameCommand }
+
+func (c *CreateGame) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	if c.LobbyID == uuid.Nil {
+		return &common.CommandFieldError{Field: "lobby_id", Details: "empty field"}
+	}
+
+	if len(c.PlayerIDs) == 0 {
+		return &common.CommandFieldError{Field: "player_ids", Details: "empty field"}
+	}
+
+	for _, expansion := range c.Expansions {
+		if !expansion.IsValid() {
+			return &common.CommandFieldError{Field: "expansions", Details: "unknown expansion"}
+		}
+	}
+
+	if len(c.PlayerIDs) < MinPlayers || len(c.PlayerIDs) > MaxPlayersFor(c.Expansions) {
+		return &common.CommandFieldError{Field: "player_ids", Details: "invalid number of players"}
+	}
+
+	for _, playerID := range c.PlayerIDs {
+		if playerID == uuid.Nil {
+			return &common.CommandFieldError{Field: "player_ids", Details: "contains an empty player id"}
+		}
+	}
+
+	if len(c.DeckSeed) != 0 && len(c.DeckSeed) != DeckSeedSize {
+		return &common.CommandFieldError{Field: "deck_seed", Details: "invalid seed size"}
+	}
+
+	if c.AFKTimeouts < 0 {
+		return &common.CommandFieldError{Field: "afk_timeouts", Details: "negative count"}
+	}
+
+	if err := c.Rules.ValidateFor(len(c.PlayerIDs), c.Expansions); err != nil {
+		return err
+	}
+
+	for botID, difficulty := range c.Bots {
+		if !slices.Contains(c.PlayerIDs, botID) {
+			return &common.CommandFieldError{Field: "bots", Details: "bot is not a player"}
+		}
+
+		if !difficulty.IsValid() {
+			return &common.CommandFieldError{Field: "bots", Details: "unknown difficulty"}
+		}
+	}
+
+	return nil
+}
+
+type DrawCard struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	Versioned
+}
+
+func (c *DrawCard) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *DrawCard) AggregateID() string { return c.GameID.String() }
+
+func (c *DrawCard) CommandType() common.CommandType { return DrawCardCommand }
+
+func (c *DrawCard) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	if c.PlayerID == uuid.Nil {
+		return &common.CommandFieldError{Field: "player_id", Details: "empty field"}
+	}
+
+	return nil
+}
+
+type PlayCard struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	Card     CardType  `json:"card"`
+	// TargetPlayerID is required by the cards aimed at another player, such as Favor.
+	TargetPlayerID uuid.UUID `json:"target_player_id"`
+	Versioned
+}
+
+func (c *PlayCard) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *PlayCard) AggregateID() string { return c.GameID.String() }
+
+func (c *PlayCard) CommandType() common.CommandType { return PlayCardCommand }
+
+func (c *PlayCard) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	if c.PlayerID == uuid.Nil {
+		return &common.CommandFieldError{Field: "player_id", Details: "empty field"}
+	}
+
+	if c.Card == "" {
+		return &common.CommandFieldError{Field: "card", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// ResolveAction closes the reaction window of the pending action once its deadline has passed.
+type ResolveAction struct {
+	GameID uuid.UUID `json:"game_id"`
+}
+
+func (c *ResolveAction) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *ResolveAction) AggregateID() string { return c.GameID.String() }
+
+func (c *ResolveAction) CommandType() common.CommandType { return ResolveActionCommand }
+
+func (c *ResolveAction) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// DefuseKitten plays a Defuse on the drawn Exploding Kitten and puts the kitten back in the draw pile.
+type DefuseKitten struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Position is the number of cards above the kitten, 0 puts it on top of the draw pile.
+	Position int `json:"position"`
+	Versioned
+}
+
+func (c *DefuseKitten) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *DefuseKitten) AggregateID() string { return c.GameID.String() }
+
+func (c *DefuseKitten) CommandType() common.CommandType { return DefuseKittenCommand }
+
+func (c *DefuseKitten) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	if c.PlayerID == uuid.Nil {
+		return &common.CommandFieldError{Field: "player_id", Details: "empty field"}
+	}
+
+	if c.Position < 0 {
+		return &common.CommandFieldError{Field: "position", Details: "negative position"}
+	}
+
+	return nil
+}
+
+// PlayCombo plays several cards together, the combo is found from the cards.
+type PlayCombo struct {
+	GameID         uuid.UUID  `json:"game_id"`
+	PlayerID       uuid.UUID  `json:"player_id"`
+	Cards          []CardType `json:"cards"`
+	TargetPlayerID uuid.UUID  `json:"target_player_id"`
+	// NamedCard is the card asked to the target by a three of a kind.
+	NamedCard CardType `json:"named_card,omitempty"`
+	Versioned
+}
+
+func (c *PlayCombo) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *PlayCombo) AggregateID() string { return c.GameID.String() }
+
+func (c *PlayCombo) CommandType() common.CommandType { return PlayComboCommand }
+
+func (c *PlayCombo) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	if c.PlayerID == uuid.Nil {
+		return &common.CommandFieldError{Field: "player_id", Details: "empty field"}
+	}
+
+	if len(c.Cards) == 0 {
+		return &common.CommandFieldError{Field: "cards", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// ChooseCard answers the pending interaction of the player.
+type ChooseCard struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	Card     CardType  `json:"card"`
+	Versioned
+}
+
+func (c *ChooseCard) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *ChooseCard) AggregateID() string { return c.GameID.String() }
+
+func (c *ChooseCard) CommandType() common.CommandType { return ChooseCardCommand }
+
+func (c *ChooseCard) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	if c.PlayerID == uuid.Nil {
+		return &common.CommandFieldError{Field: "player_id", Details: "empty field"}
+	}
+
+	if c.Card == "" {
+		return &common.CommandFieldError{Field: "card", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// ExpireInteraction chooses a random card for the pending interaction once its deadline has passed.
+type ExpireInteraction struct {
+	GameID uuid.UUID `json:"game_id"`
+}
+
+func (c *ExpireInteraction) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *ExpireInteraction) AggregateID() string { return c.GameID.String() }
+
+func (c *ExpireInteraction) CommandType() common.CommandType { return ExpireInteractionCommand }
+
+func (c *ExpireInteraction) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// AlterFuture answers the pending Alter the Future of the player with the new order of the cards.
+type AlterFuture struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Cards is ordered from the top of the draw pile.
+	Cards []CardType `json:"cards"`
+	Versioned
+}
+
+func (c *AlterFuture) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *AlterFuture) AggregateID() string { return c.GameID.String() }
+
+func (c *AlterFuture) CommandType() common.CommandType { return AlterFutureCommand }
+
+func (c *AlterFuture) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	if c.PlayerID == uuid.Nil {
+		return &common.CommandFieldError{Field: "player_id", Details: "empty field"}
+	}
+
+	if len(c.Cards) == 0 {
+		return &common.CommandFieldError{Field: "cards", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// ExpireTurn plays for the current player once their turn deadline has passed.
+type ExpireTurn struct {
+	GameID uuid.UUID `json:"game_id"`
+}
+
+func (c *ExpireTurn) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *ExpireTurn) AggregateID() string { return c.GameID.String() }
+
+func (c *ExpireTurn) CommandType() common.CommandType { return ExpireTurnCommand }
+
+func (c *ExpireTurn) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// DisconnectPlayer marks a player whose streams dropped as disconnected, the player is played for
+// until they reconnect or the grace period is over.
+type DisconnectPlayer struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Grace is optional, DefaultDisconnectGrace is used when it is zero.
+	Grace time.Duration `json:"grace,omitempty"`
+}
+
+func (c *DisconnectPlayer) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *DisconnectPlayer) AggregateID() string { return c.GameID.String() }
+
+func (c *DisconnectPlayer) CommandType() common.CommandType { return DisconnectPlayerCommand }
+
+func (c *DisconnectPlayer) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	if c.PlayerID == uuid.Nil {
+		return &common.CommandFieldError{Field: "player_id", Details: "empty field"}
+	}
+
+	if c.Grace < 0 {
+		return &common.CommandFieldError{Field: "grace", Details: "negative duration"}
+	}
+
+	return nil
+}
+
+// ReconnectPlayer brings a disconnected player back before the end of the grace period.
+type ReconnectPlayer struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+func (c *ReconnectPlayer) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *ReconnectPlayer) AggregateID() string { return c.GameID.String() }
+
+func (c *ReconnectPlayer) CommandType() common.CommandType { return ReconnectPlayerCommand }
+
+func (c *ReconnectPlayer) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	if c.PlayerID == uuid.Nil {
+		return &common.CommandFieldError{Field: "player_id", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// ExpireDisconnect eliminates a disconnected player once their grace period is over, when the
+// rules eliminate the disconnected players.
+type ExpireDisconnect struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+func (c *ExpireDisconnect) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *ExpireDisconnect) AggregateID() string { return c.GameID.String() }
+
+func (c *ExpireDisconnect) CommandType() common.CommandType { return ExpireDisconnectCommand }
+
+func (c *ExpireDisconnect) Validate() error {
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	if c.PlayerID == uuid.Nil {
+		return &common.CommandFieldError{Field: "player_id", Details: "empty field"}
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package game
+
+import (
+	"crypto/rand"
+	"math/big"
+	mathrand "math/rand/v2"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// DeckSeedSize is the size in bytes of the seed used to shuffle a deck.
+	DeckSeedSize = 32
+	// InitialHandSize is the number of cards dealt to each player on top of their Defuse.
+	InitialHandSize = 7
+	// MinPlayers is the minimum number of players in a game.
+	MinPlayers = 2
+	// MaxPlayers is the maximum number of players the base deck supports, see MaxPlayersFor for expansions.
+	MaxPlayers = 5
+)
+
+// Deck is the result of building the cards of a new game.
+type Deck struct {
+	// DrawPile is ordered from top to bottom.
+	DrawPile []CardType
+	Hands    map[uuid.UUID][]CardType
+}
+
+// NewDeckSeed returns a new random seed for BuildDeck.
+func NewDeckSeed() ([]byte, error) {
+	seed := make([]byte, DeckSeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return seed, nil
+}
+
+// randomIndex returns a random index in [0, n). Random choices made while handling
+// a command are recorded in the events, so they never need to be replayed.
+func randomIndex(n int) (int, error) {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return int(index.Int64()), nil
+}
+
+// BuildDeck deterministically builds the draw pile and the starting hands for the given players.
+// Every player gets one Defuse and InitialHandSize cards, then N-1 kittens and the remaining
+// Defuses are inserted in the draw pile before it is shuffled. The cards of the expansions are
+// shuffled with the base cards, and their kittens replace some of the Exploding Kittens.
+// The same seed, players and expansions always produce the same deck, which allows a game to
+// be audited by replaying its events.
+func BuildDeck(seed []byte, playerIDs []uuid.UUID, expansions ...Expansion) (*Deck, error) {
+	return BuildDeckWithRules(seed, playerIDs, expansions, &GameRules{})
+}
+
+// BuildDeckWithRules builds a deck like BuildDeck, with the cards removed by the house rules left
+// out and as many Defuses as the rules deal to each player.
+func BuildDeckWithRules(seed []byte, playerIDs []uuid.UUID, expansions []Expansion, rules *GameRules) (*Deck, error) {
+	if len(seed) != DeckSeedSize {
+		return nil, ErrInvalidDeckSeed
+	}
+
+	for _, expansion := range expansions {
+		if !expansion.IsValid() {
+			return nil, ErrInvalidExpansion
+		}
+	}
+	expansions = sortExpansions(expansions)
+
+	playerCount := len(playerIDs)
+	if playerCount < MinPlayers || playerCount > MaxPlayersFor(expansions) {
+		return nil, ErrInvalidPlayerCount
+	}
+
+	rng := newDeckRand(seed)
+
+	pile := make([]CardType, 0)
+	for _, entry := range baseCatalog {
+		if entry.Card == CardExplodingKitten || entry.Card == CardDefuse || rules.IsRemoved(entry.Card) {
+			continue
+		}
+
+		for range entry.Count {
+			pile = append(pile, entry.Card)
+		}
+	}
+
+	kittens := make([]CardType, 0)
+	for _, expansion := range expansions {
+		set := expansionSets[expansion]
+		for _, entry := range set.Cards {
+			if entry.Card == CardExplodingKitten || entry.Card == CardDefuse || rules.IsRemoved(entry.Card) {
+				continue
+			}
+
+			for range entry.Count {
+				pile = append(pile, entry.Card)
+			}
+		}
+
+		kittens = append(kittens, set.Kittens...)
+	}
+	shuffleCards(rng, pile)
+
+	if len(pile) < playerCount*InitialHandSize {
+		return nil, ErrNotEnoughCards
+	}
+
+	defuses := rules.GetDefuses()
+	hands := make(map[uuid.UUID][]CardType, playerCount)
+	for _, playerID := range playerIDs {
+		hand := make([]CardType, 0, InitialHandSize+defuses)
+		for range defuses {
+			hand = append(hand, CardDefuse)
+		}
+		hand = append(hand, pile[:InitialHandSize]...)
+		pile = pile[InitialHandSize:]
+
+		hands[playerID] = hand
+	}
+
+	// With two or three players only two of the remaining Defuses go back in the deck.
+	extraDefuses := max(min(catalogCount(CardDefuse, expansions)-playerCount, 2), 0)
+	for range extraDefuses {
+		pile = append(pile, CardDefuse)
+	}
+
+	kittens = kittens[:min(len(kittens), playerCount-1)]
+	for range playerCount - 1 - len(kittens) {
+		pile = append(pile, CardExplodingKitten)
+	}
+	pile = append(pile, kittens...)
+	shuffleCards(rng, pile)
+
+	return &Deck{
+		DrawPile: pile,
+		Hands:    hands,
+	}, nil
+}
+
+// catalogCount returns the number of copies of a card in the catalog and the given expansions.
+func catalogCount(card CardType, expansions []Expansion) int {
+	count := 0
+	for _, entry := range baseCatalog {
+		if entry.Card == card {
+			count += entry.Count
+		}
+	}
+
+	for _, expansion := range expansions {
+		for _, entry := range expansionSets[expansion].Cards {
+			if entry.Card == card {
+				count += entry.Count
+			}
+		}
+	}
+
+	return count
+}
+
+// newDeckRand returns a ChaCha8 CSPRNG seeded with the given seed.
+func newDeckRand(seed []byte) *mathrand.ChaCha8 {
+	var key [DeckSeedSize]byte
+	copy(key[:], seed)
+
+	return mathrand.NewChaCha8(key)
+}
+
+// shuffleCards shuffles the cards in place with a Fisher-Yates shuffle.
+// The shuffle is implemented here rather than using rand.Shuffle so that the
+// resulting order only depends on the ChaCha8 output stream.
+func shuffleCards(rng *mathrand.ChaCha8, cards []CardType) {
+	for i := len(cards) - 1; i > 0; i-- {
+		j := uniformIndex(rng, uint64(i+1))
+		cards[i], cards[j] = cards[j], cards[i]
+	}
+}
+
+// uniformIndex returns an unbiased random number in [0, n) using rejection sampling.
+func uniformIndex(rng *mathrand.ChaCha8, n uint64) int {
+	limit := ^uint64(0) - (^uint64(0) % n)
+	for {
+		v := rng.Uint64()
+		if v < limit {
+			return int(v % n)
+		}
+	}
+}
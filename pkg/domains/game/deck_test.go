@@ -0,0 +1,262 @@
+package game_test
+
+import (
+	"context"
+	goTesting "testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+)
+
+type DeckSuite struct {
+	*testing.Suite
+}
+
+func TestDeckSuite(t *goTesting.T) {
+	ds := &DeckSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, ds)
+}
+
+func (ds *DeckSuite) newPlayers(count int) []uuid.UUID {
+	players := make([]uuid.UUID, 0, count)
+	for range count {
+		players = append(players, uuid.Must(uuid.NewV7()))
+	}
+
+	return players
+}
+
+func (ds *DeckSuite) Test_BuildDeck_SameSeedSameDeck() {
+	seed, err := game.NewDeckSeed()
+	ds.NoError(err)
+
+	players := ds.newPlayers(4)
+
+	first, err := game.BuildDeck(seed, players)
+	ds.NoError(err)
+
+	second, err := game.BuildDeck(seed, players)
+	ds.NoError(err)
+
+	ds.Equal(first, second)
+}
+
+func (ds *DeckSuite) Test_BuildDeck_DealsAndInsertsKittens() {
+	seed, err := game.NewDeckSeed()
+	ds.NoError(err)
+
+	for playerCount := game.MinPlayers; playerCount <= game.MaxPlayers; playerCount++ {
+		players := ds.newPlayers(playerCount)
+
+		deck, err := game.BuildDeck(seed, players)
+		ds.NoError(err)
+
+		for _, playerID := range players {
+			hand := deck.Hands[playerID]
+			ds.Len(hand, game.InitialHandSize+1)
+			ds.Contains(hand, game.CardDefuse)
+			ds.NotContains(hand, game.CardExplodingKitten)
+		}
+
+		kittens := 0
+		for _, card := range deck.DrawPile {
+			if card == game.CardExplodingKitten {
+				kittens++
+			}
+		}
+		ds.Equal(playerCount-1, kittens)
+	}
+}
+
+func (ds *DeckSuite) Test_BuildDeck_InvalidInput() {
+	_, err := game.BuildDeck([]byte("short"), ds.newPlayers(2))
+	ds.ErrorIs(err, game.ErrInvalidDeckSeed)
+
+	seed, err := game.NewDeckSeed()
+	ds.NoError(err)
+
+	_, err = game.BuildDeck(seed, ds.newPlayers(1))
+	ds.ErrorIs(err, game.ErrInvalidPlayerCount)
+}
+
+func (ds *DeckSuite) Test_Projector_RebuildsDeckFromEvents() {
+	ctx := context.Background()
+
+	gameID := uuid.Must(uuid.NewV7())
+	players := ds.newPlayers(3)
+
+	agg := &game.Aggregate{}
+	agg.OnCreate(gameID.String())
+
+	err := agg.HandleCommand(ctx, &game.CreateGame{
+		GameID:    gameID,
+		LobbyID:   uuid.Must(uuid.NewV7()),
+		PlayerIDs: players,
+	})
+	ds.NoError(err)
+
+	events := agg.UncommittedEvents()
+	ds.Len(events, 1)
+
+	data, ok := events[0].Data().(*game.GameCreated)
+	ds.True(ok)
+	ds.Len(data.GetDeckSeed(), game.DeckSeedSize)
+
+	deck, err := game.BuildDeck(data.GetDeckSeed(), players)
+	ds.NoError(err)
+
+	entity, err := game.NewProjector().Project(ctx, events[0], &game.Game{})
+	ds.NoError(err)
+	ds.Equal(deck.DrawPile, entity.GetDrawPile())
+	ds.Equal(deck.Hands, entity.GetHands())
+}
+
+func (ds *DeckSuite) Test_BuildDeck_ImplodingKittensExpansion() {
+	seed, err := game.NewDeckSeed()
+	ds.NoError(err)
+
+	expansions := []game.Expansion{game.ExpansionImplodingKittens}
+	ds.Equal(game.MaxPlayers+1, game.MaxPlayersFor(expansions))
+
+	players := ds.newPlayers(game.MaxPlayersFor(expansions))
+
+	deck, err := game.BuildDeck(seed, players, expansions...)
+	ds.NoError(err)
+
+	for _, playerID := range players {
+		ds.NotContains(deck.Hands[playerID], game.CardImplodingKitten)
+	}
+
+	counts := make(map[game.CardType]int)
+	for _, card := range deck.DrawPile {
+		counts[card]++
+	}
+	ds.Equal(len(players)-2, counts[game.CardExplodingKitten])
+	ds.Equal(1, counts[game.CardImplodingKitten])
+
+	_, err = game.BuildDeck(seed, ds.newPlayers(game.MaxPlayers+1))
+	ds.ErrorIs(err, game.ErrInvalidPlayerCount)
+
+	_, err = game.BuildDeck(seed, players, game.Expansion("UNKNOWN"))
+	ds.ErrorIs(err, game.ErrInvalidExpansion)
+}
+
+func (ds *DeckSuite) Test_BuildDeck_PartyPack() {
+	seed, err := game.NewDeckSeed()
+	ds.NoError(err)
+
+	expansions := []game.Expansion{game.ExpansionPartyPack, game.ExpansionStreakingKittens, game.ExpansionImplodingKittens}
+	ds.Equal(game.MaxPartyPlayers, game.MaxPlayersFor(expansions))
+
+	players := ds.newPlayers(game.MaxPartyPlayers)
+
+	deck, err := game.BuildDeck(seed, players, expansions...)
+	ds.NoError(err)
+
+	counts := make(map[game.CardType]int)
+	for _, card := range deck.DrawPile {
+		counts[card]++
+	}
+	for _, playerID := range players {
+		ds.Contains(deck.Hands[playerID], game.CardDefuse)
+		ds.NotContains(deck.Hands[playerID], game.CardExplodingKitten)
+		ds.NotContains(deck.Hands[playerID], game.CardImplodingKitten)
+	}
+	ds.Equal(len(players)-2, counts[game.CardExplodingKitten])
+	ds.Equal(1, counts[game.CardImplodingKitten])
+
+	_, err = game.BuildDeck(seed, ds.newPlayers(game.MaxPartyPlayers+1), expansions...)
+	ds.ErrorIs(err, game.ErrInvalidPlayerCount)
+}
+
+func (ds *DeckSuite) Test_BuildDeckWithRules_RemovesCardsAndDealsDefuses() {
+	seed, err := game.NewDeckSeed()
+	ds.NoError(err)
+
+	players := ds.newPlayers(4)
+	rules := &game.GameRules{
+		Defuses:      2,
+		RemovedCards: []game.CardType{game.CardNope, game.CardFavor},
+	}
+
+	deck, err := game.BuildDeckWithRules(seed, players, nil, rules)
+	ds.NoError(err)
+
+	for _, playerID := range players {
+		hand := deck.Hands[playerID]
+		ds.Len(hand, game.InitialHandSize+2)
+		ds.NotContains(hand, game.CardNope)
+		ds.NotContains(hand, game.CardFavor)
+	}
+	ds.NotContains(deck.DrawPile, game.CardNope)
+	ds.NotContains(deck.DrawPile, game.CardFavor)
+
+	// Without rules the deck is the official one.
+	official, err := game.BuildDeckWithRules(seed, players, nil, &game.GameRules{})
+	ds.NoError(err)
+
+	expected, err := game.BuildDeck(seed, players)
+	ds.NoError(err)
+	ds.Equal(expected, official)
+}
+
+// rulesMessage stands for the grpc messages carrying house rules.
+type rulesMessage struct {
+	reactionWindow, turnTimeout int64
+	defuses                     int32
+	removedCards                []string
+}
+
+func (m *rulesMessage) GetReactionWindow() int64       { return m.reactionWindow }
+func (m *rulesMessage) GetTurnTimeout() int64          { return m.turnTimeout }
+func (m *rulesMessage) GetDefuses() int32              { return m.defuses }
+func (m *rulesMessage) GetNopeDefuse() bool            { return true }
+func (m *rulesMessage) GetRevealEliminatedHands() bool { return true }
+func (m *rulesMessage) GetRemovedCards() []string      { return m.removedCards }
+func (m *rulesMessage) GetEliminateDisconnected() bool { return false }
+
+func (ds *DeckSuite) Test_GameRules_FromProto() {
+	rules := game.GameRulesFromProto(&rulesMessage{
+		reactionWindow: 2000,
+		turnTimeout:    60000,
+		defuses:        2,
+		removedCards:   []string{" shuffle "},
+	})
+
+	// The durations are in milliseconds and the card names sent by the clients are normalized.
+	ds.Equal(game.GameRules{
+		ReactionWindow:        2 * time.Second,
+		TurnTimeout:           time.Minute,
+		Defuses:               2,
+		NopeDefuse:            true,
+		RevealEliminatedHands: true,
+		RemovedCards:          []game.CardType{game.CardShuffle},
+	}, rules)
+}
+
+func (ds *DeckSuite) Test_GameRules_Validate() {
+	ds.NoError((&game.GameRules{}).Validate())
+	ds.Error((&game.GameRules{Defuses: game.MaxDefuses + 1}).Validate())
+	ds.Error((&game.GameRules{RemovedCards: []game.CardType{game.CardDefuse}}).Validate())
+	ds.Error((&game.GameRules{RemovedCards: []game.CardType{"UNKNOWN"}}).Validate())
+	ds.NoError((&game.GameRules{ReactionWindow: game.MaxReactionWindow, TurnTimeout: game.MaxTurnTimeout}).Validate())
+	ds.Error((&game.GameRules{ReactionWindow: game.MaxReactionWindow + time.Millisecond}).Validate())
+	ds.Error((&game.GameRules{TurnTimeout: game.MaxTurnTimeout + time.Millisecond}).Validate())
+
+	// The expansion cards can only be removed once the expansion is played, the deck must still deal the hands.
+	rules := &game.GameRules{RemovedCards: []game.CardType{game.CardReverse}}
+	ds.NoError(rules.ValidateFor(game.MaxPlayers, []game.Expansion{game.ExpansionImplodingKittens}))
+
+	rules = &game.GameRules{RemovedCards: []game.CardType{
+		game.CardNope, game.CardAttack, game.CardSkip, game.CardFavor, game.CardShuffle, game.CardSeeTheFuture,
+	}}
+	ds.NoError(rules.ValidateFor(game.MinPlayers, nil))
+	ds.Error(rules.ValidateFor(game.MaxPlayers, nil))
+}
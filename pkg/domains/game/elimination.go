@@ -0,0 +1,56 @@
+package game
+
+import (
+	"slices"
+
+	"github.com/gofrs/uuid"
+)
+
+// EliminationCause tells why a player left the game.
+type EliminationCause string
+
+const (
+	EliminationCauseExploded EliminationCause = "EXPLODED"
+	EliminationCauseImploded EliminationCause = "IMPLODED"
+	// EliminationCauseDisconnected is the cause of the players who did not reconnect in time.
+	EliminationCauseDisconnected EliminationCause = "DISCONNECTED"
+)
+
+func (c EliminationCause) String() string {
+	return string(c)
+}
+
+// eliminatePlayer removes a player from the game. The game finishes when a single
+// player is left, otherwise the turn passes on when the eliminated player was playing.
+func eliminatePlayer(e *emitter, playerID uuid.UUID, cause EliminationCause) error {
+	state := e.state
+	wasPlaying := state.CurrentPlayerID == playerID
+	nextPlayerID := state.NextPlayerID(playerID)
+
+	eliminated := &PlayerEliminated{
+		GameID:   state.GameID,
+		PlayerID: playerID,
+		Cause:    cause,
+	}
+	if state.Rules.RevealEliminatedHands {
+		eliminated.Hand = slices.Clone(state.Hands[playerID])
+	}
+
+	if err := e.emit(EventTypePlayerEliminated, eliminated); err != nil {
+		return err
+	}
+
+	if len(state.TurnOrder) == 1 {
+		return e.emit(EventTypeGameFinished, &GameFinished{
+			GameID:   state.GameID,
+			WinnerID: state.TurnOrder[0],
+			LobbyID:  state.LobbyID,
+		})
+	}
+
+	if !wasPlaying {
+		return nil
+	}
+
+	return passTurnsTo(e, nextPlayerID, 1)
+}
@@ -0,0 +1,35 @@
+package game
+
+import "github.com/cockroachdb/errors"
+
+var (
+	ErrGameAlreadyCreated    = errors.New("game already created")
+	ErrGameNotAvailable      = errors.New("game is not available")
+	ErrInvalidDeckSeed       = errors.New("invalid deck seed")
+	ErrInvalidPlayerCount    = errors.New("invalid number of players")
+	ErrPlayerNotInGame       = errors.New("player is not in the game")
+	ErrNotPlayersTurn        = errors.New("it is not the player's turn")
+	ErrCardNotInHand         = errors.New("card is not in the player's hand")
+	ErrCardNotPlayable       = errors.New("card cannot be played")
+	ErrDrawPileEmpty         = errors.New("draw pile is empty")
+	ErrReactionWindowOpen    = errors.New("waiting for the reaction window to close")
+	ErrNoPendingAction       = errors.New("there is no pending action")
+	ErrDeadlineNotReached    = errors.New("deadline not reached")
+	ErrDefusePending         = errors.New("waiting for the player to defuse the exploding kitten")
+	ErrNoPendingDefuse       = errors.New("there is no exploding kitten to defuse")
+	ErrInvalidPosition       = errors.New("invalid draw pile position")
+	ErrGameFinished          = errors.New("game is finished")
+	ErrGameNotFinished       = errors.New("game is not finished")
+	ErrInvalidCombo          = errors.New("cards do not form a valid combo")
+	ErrInvalidTarget         = errors.New("invalid target player")
+	ErrInteractionPending    = errors.New("waiting for a player to choose a card")
+	ErrNoPendingInteraction  = errors.New("there is no card to choose for the player")
+	ErrInvalidChoice         = errors.New("card cannot be chosen")
+	ErrInvalidExpansion      = errors.New("unknown expansion")
+	ErrInvalidArrangement    = errors.New("cards are not an order of the top of the draw pile")
+	ErrPlayerDisconnected    = errors.New("player is disconnected")
+	ErrPlayerNotDisconnected = errors.New("player is not disconnected")
+	ErrDisconnectKept        = errors.New("the rules keep disconnected players in the game")
+	ErrNotEnoughCards        = errors.New("not enough cards to deal the hands")
+	ErrOutdatedCommand       = errors.New("command was decided from an outdated game state")
+)
@@ -0,0 +1,761 @@
+package game
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+)
+
+// registerEvents registers the event types for the game domain
+func registerEvents(subjFunc eventing.SubjectFunc, subjRootFunc eventing.SubjectFunc, subjTokenPos int, tokensFunc eventing.TokensFunc) {
+	args := make([]eventing.EventRegistrationOption, 0)
+	args = append(args, eventing.WithRegisterSubjectRootFunc(subjRootFunc))
+	args = append(args, eventing.WithRegisterSubjectFunc(subjFunc))
+	args = append(args, eventing.WithRegisterTokensFunc(tokensFunc))
+	args = append(args, eventing.WithRegisterSubjectTokenPosition(subjTokenPos))
+
+	eventing.RegisterEventData[GameCreated](EventTypeGameCreated, args...)
+	eventing.RegisterEventData[CardDrawn](EventTypeCardDrawn, args...)
+	eventing.RegisterEventData[CardPlayed](EventTypeCardPlayed, args...)
+	eventing.RegisterEventData[TurnAdvanced](EventTypeTurnAdvanced, args...)
+	eventing.RegisterEventData[ReactionWindowOpened](EventTypeReactionWindowOpened, args...)
+	eventing.RegisterEventData[ActionNoped](EventTypeActionNoped, args...)
+	eventing.RegisterEventData[ReactionWindowClosed](EventTypeReactionWindowClosed, args...)
+	eventing.RegisterEventData[ExplodingKittenDrawn](EventTypeExplodingKittenDrawn, args...)
+	eventing.RegisterEventData[KittenDefused](EventTypeKittenDefused, args...)
+	eventing.RegisterEventData[PlayerEliminated](EventTypePlayerEliminated, args...)
+	eventing.RegisterEventData[GameFinished](EventTypeGameFinished, args...)
+	eventing.RegisterEventData[InteractionRequested](EventTypeInteractionRequested, args...)
+	eventing.RegisterEventData[CardTransferred](EventTypeCardTransferred, args...)
+	eventing.RegisterEventData[CardTakenFromDiscard](EventTypeCardTakenFromDiscard, args...)
+	eventing.RegisterEventData[FutureSeen](EventTypeFutureSeen, args...)
+	eventing.RegisterEventData[TurnOrderReversed](EventTypeTurnOrderReversed, args...)
+	eventing.RegisterEventData[ImplodingKittenDrawn](EventTypeImplodingKittenDrawn, args...)
+	eventing.RegisterEventData[ImplodingKittenPlaced](EventTypeImplodingKittenPlaced, args...)
+	eventing.RegisterEventData[FutureAltered](EventTypeFutureAltered, args...)
+	eventing.RegisterEventData[TopAndBottomSwapped](EventTypeTopAndBottomSwapped, args...)
+	eventing.RegisterEventData[KittensMovedToTop](EventTypeKittensMovedToTop, args...)
+	eventing.RegisterEventData[CardMarked](EventTypeCardMarked, args...)
+	eventing.RegisterEventData[PlayerCursed](EventTypePlayerCursed, args...)
+	eventing.RegisterEventData[CardCollected](EventTypeCardCollected, args...)
+	eventing.RegisterEventData[DrawPileShuffled](EventTypeDrawPileShuffled, args...)
+	eventing.RegisterEventData[TurnTimedOut](EventTypeTurnTimedOut, args...)
+	eventing.RegisterEventData[PlayerReturned](EventTypePlayerReturned, args...)
+	eventing.RegisterEventData[PlayerDisconnected](EventTypePlayerDisconnected, args...)
+	eventing.RegisterEventData[PlayerReconnected](EventTypePlayerReconnected, args...)
+	eventing.RegisterEventData[DefuseNoped](EventTypeDefuseNoped, args...)
+}
+
+// EventTypeGameCreated is the event type for when a game is created
+var EventTypeGameCreated = (&GameCreated{}).EventType()
+
+// EventTypeCardDrawn is the event type for when a player draws a card
+var EventTypeCardDrawn = (&CardDrawn{}).EventType()
+
+// EventTypeCardPlayed is the event type for when a player plays a card
+var EventTypeCardPlayed = (&CardPlayed{}).EventType()
+
+// EventTypeTurnAdvanced is the event type for when the turn moves on
+var EventTypeTurnAdvanced = (&TurnAdvanced{}).EventType()
+
+// EventTypeReactionWindowOpened is the event type for when an action card waits for reactions
+var EventTypeReactionWindowOpened = (&ReactionWindowOpened{}).EventType()
+
+// EventTypeActionNoped is the event type for when a player answers the pending action with a Nope
+var EventTypeActionNoped = (&ActionNoped{}).EventType()
+
+// EventTypeReactionWindowClosed is the event type for when the pending action is resolved or cancelled
+var EventTypeReactionWindowClosed = (&ReactionWindowClosed{}).EventType()
+
+// EventTypeExplodingKittenDrawn is the event type for when a player draws an Exploding Kitten
+var EventTypeExplodingKittenDrawn = (&ExplodingKittenDrawn{}).EventType()
+
+// EventTypeKittenDefused is the event type for when a player defuses an Exploding Kitten
+var EventTypeKittenDefused = (&KittenDefused{}).EventType()
+
+// EventTypePlayerEliminated is the event type for when a player is out of the game
+var EventTypePlayerEliminated = (&PlayerEliminated{}).EventType()
+
+// EventTypeGameFinished is the event type for when a single player is left
+var EventTypeGameFinished = (&GameFinished{}).EventType()
+
+// EventTypeInteractionRequested is the event type for when a player has to choose a card
+var EventTypeInteractionRequested = (&InteractionRequested{}).EventType()
+
+// EventTypeCardTransferred is the event type for when a card moves from one hand to another
+var EventTypeCardTransferred = (&CardTransferred{}).EventType()
+
+// EventTypeCardTakenFromDiscard is the event type for when a player takes a card from the discard pile
+var EventTypeCardTakenFromDiscard = (&CardTakenFromDiscard{}).EventType()
+
+// EventTypeFutureSeen is the event type for when a player looks at the top of the draw pile
+var EventTypeFutureSeen = (&FutureSeen{}).EventType()
+
+// EventTypeTurnOrderReversed is the event type for when the direction of play changes
+var EventTypeTurnOrderReversed = (&TurnOrderReversed{}).EventType()
+
+// EventTypeImplodingKittenDrawn is the event type for when a player draws the Imploding Kitten
+var EventTypeImplodingKittenDrawn = (&ImplodingKittenDrawn{}).EventType()
+
+// EventTypeImplodingKittenPlaced is the event type for when a player puts the Imploding Kitten back face up
+var EventTypeImplodingKittenPlaced = (&ImplodingKittenPlaced{}).EventType()
+
+// EventTypeFutureAltered is the event type for when a player rearranges the top of the draw pile
+var EventTypeFutureAltered = (&FutureAltered{}).EventType()
+
+// EventTypeTopAndBottomSwapped is the event type for when the top and bottom cards of the draw pile are swapped
+var EventTypeTopAndBottomSwapped = (&TopAndBottomSwapped{}).EventType()
+
+// EventTypeKittensMovedToTop is the event type for when the Exploding Kittens are moved to the top of the draw pile
+var EventTypeKittensMovedToTop = (&KittensMovedToTop{}).EventType()
+
+// EventTypeCardMarked is the event type for when a card in a hand is turned face up
+var EventTypeCardMarked = (&CardMarked{}).EventType()
+
+// EventTypePlayerCursed is the event type for when a player has to play their next turn blind
+var EventTypePlayerCursed = (&PlayerCursed{}).EventType()
+
+// EventTypeCardCollected is the event type for when a player puts a card of their hand in the draw pile
+var EventTypeCardCollected = (&CardCollected{}).EventType()
+
+// EventTypeDrawPileShuffled is the event type for when the draw pile is shuffled
+var EventTypeDrawPileShuffled = (&DrawPileShuffled{}).EventType()
+
+// EventTypeTurnTimedOut is the event type for when a player runs out of time and is played for
+var EventTypeTurnTimedOut = (&TurnTimedOut{}).EventType()
+
+// EventTypePlayerReturned is the event type for when a player acts again after timing out
+var EventTypePlayerReturned = (&PlayerReturned{}).EventType()
+
+// EventTypePlayerDisconnected is the event type for when the streams of a player dropped
+var EventTypePlayerDisconnected = (&PlayerDisconnected{}).EventType()
+
+// EventTypePlayerReconnected is the event type for when a disconnected player is back before the end of the grace period
+var EventTypePlayerReconnected = (&PlayerReconnected{}).EventType()
+
+// EventTypeDefuseNoped is the event type for when a Defuse is Noped under the house rules
+var EventTypeDefuseNoped = (&DefuseNoped{}).EventType()
+
+var AllEventTypes = []common.EventType{
+	EventTypeGameCreated,
+	EventTypeCardDrawn,
+	EventTypeCardPlayed,
+	EventTypeTurnAdvanced,
+	EventTypeReactionWindowOpened,
+	EventTypeActionNoped,
+	EventTypeReactionWindowClosed,
+	EventTypeExplodingKittenDrawn,
+	EventTypeKittenDefused,
+	EventTypePlayerEliminated,
+	EventTypeGameFinished,
+	EventTypeInteractionRequested,
+	EventTypeCardTransferred,
+	EventTypeCardTakenFromDiscard,
+	EventTypeFutureSeen,
+	EventTypeTurnOrderReversed,
+	EventTypeImplodingKittenDrawn,
+	EventTypeImplodingKittenPlaced,
+	EventTypeFutureAltered,
+	EventTypeTopAndBottomSwapped,
+	EventTypeKittensMovedToTop,
+	EventTypeCardMarked,
+	EventTypePlayerCursed,
+	EventTypeCardCollected,
+	EventTypeDrawPileShuffled,
+	EventTypeTurnTimedOut,
+	EventTypePlayerReturned,
+	EventTypePlayerDisconnected,
+	EventTypePlayerReconnected,
+	EventTypeDefuseNoped,
+}
+
+type GameCreated struct {
+	GameID         uuid.UUID     `json:"game_id"`
+	LobbyID        uuid.UUID     `json:"lobby_id"`
+	PlayerIDs      []uuid.UUID   `json:"player_ids"`
+	DeckSeed       []byte        `json:"deck_seed"`
+	ReactionWindow time.Duration `json:"reaction_window"`
+	// Expansions are the card sets added to the base deck.
+	Expansions []Expansion `json:"expansions,omitempty"`
+	// TurnTimeout is how long a player has to finish a turn, games created without one have no timer.
+	TurnTimeout time.Duration `json:"turn_timeout,omitempty"`
+	// AFKTimeouts is the number of consecutive timeouts after which a player is marked AFK.
+	AFKTimeouts int `json:"afk_timeouts,omitempty"`
+	// Deadline is the end of the first turn.
+	Deadline time.Time `json:"deadline,omitempty"`
+	// Bots maps the players played by the server to their difficulty.
+	Bots map[uuid.UUID]BotDifficulty `json:"bots,omitempty"`
+	// Ranked games change the ratings of the players, unless a bot takes part.
+	Ranked bool `json:"ranked,omitempty"`
+	// Rules are the house rules of the game as they were asked for, ReactionWindow and TurnTimeout
+	// above are the timers in force.
+	Rules GameRules `json:"rules,omitempty"`
+}
+
+func (p *GameCreated) EventType() common.EventType { return "GAME_CREATED" }
+
+func (p *GameCreated) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *GameCreated) GetLobbyID() uuid.UUID { return p.LobbyID }
+
+func (p *GameCreated) GetPlayerIDs() []uuid.UUID { return p.PlayerIDs }
+
+func (p *GameCreated) GetDeckSeed() []byte { return p.DeckSeed }
+
+func (p *GameCreated) GetReactionWindow() time.Duration { return p.ReactionWindow }
+
+func (p *GameCreated) GetExpansions() []Expansion { return p.Expansions }
+
+func (p *GameCreated) GetTurnTimeout() time.Duration { return p.TurnTimeout }
+
+func (p *GameCreated) GetAFKTimeouts() int { return p.AFKTimeouts }
+
+func (p *GameCreated) GetDeadline() time.Time { return p.Deadline }
+
+func (p *GameCreated) GetBots() map[uuid.UUID]BotDifficulty { return p.Bots }
+
+func (p *GameCreated) GetRanked() bool { return p.Ranked }
+
+func (p *GameCreated) GetRules() *GameRules { return &p.Rules }
+
+type CardDrawn struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	Card     CardType  `json:"card"`
+	// FromBottom is set when the card was taken from the bottom of the draw pile.
+	FromBottom bool `json:"from_bottom,omitempty"`
+}
+
+func (p *CardDrawn) EventType() common.EventType { return "CARD_DRAWN" }
+
+func (p *CardDrawn) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *CardDrawn) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *CardDrawn) GetCard() CardType { return p.Card }
+
+func (p *CardDrawn) GetFromBottom() bool { return p.FromBottom }
+
+// CardPlayed is either a single card, or a combo of several cards when Combo is set.
+type CardPlayed struct {
+	GameID         uuid.UUID  `json:"game_id"`
+	PlayerID       uuid.UUID  `json:"player_id"`
+	Card           CardType   `json:"card,omitempty"`
+	Combo          ComboType  `json:"combo,omitempty"`
+	Cards          []CardType `json:"cards,omitempty"`
+	TargetPlayerID uuid.UUID  `json:"target_player_id"`
+	NamedCard      CardType   `json:"named_card,omitempty"`
+	// Position is where a Defuse waiting for its reaction window puts the kitten back.
+	Position int `json:"position,omitempty"`
+}
+
+func (p *CardPlayed) EventType() common.EventType { return "CARD_PLAYED" }
+
+func (p *CardPlayed) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *CardPlayed) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *CardPlayed) GetCard() CardType { return p.Card }
+
+func (p *CardPlayed) GetCombo() ComboType { return p.Combo }
+
+func (p *CardPlayed) GetCards() []CardType { return p.Cards }
+
+func (p *CardPlayed) GetTargetPlayerID() uuid.UUID { return p.TargetPlayerID }
+
+func (p *CardPlayed) GetNamedCard() CardType { return p.NamedCard }
+
+func (p *CardPlayed) GetPosition() int { return p.Position }
+
+// PlayedCards returns all the cards that left the hand of the player.
+func (p *CardPlayed) PlayedCards() []CardType {
+	if p.Combo != "" {
+		return p.Cards
+	}
+
+	return []CardType{p.Card}
+}
+
+type TurnAdvanced struct {
+	GameID         uuid.UUID `json:"game_id"`
+	PlayerID       uuid.UUID `json:"player_id"`
+	TurnsRemaining int       `json:"turns_remaining"`
+	// Deadline is the end of the new turn, zero when the game has no turn timer.
+	Deadline time.Time `json:"deadline,omitempty"`
+}
+
+func (p *TurnAdvanced) EventType() common.EventType { return "TURN_ADVANCED" }
+
+func (p *TurnAdvanced) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *TurnAdvanced) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *TurnAdvanced) GetTurnsRemaining() int { return p.TurnsRemaining }
+
+func (p *TurnAdvanced) GetDeadline() time.Time { return p.Deadline }
+
+// ReactionWindowOpened carries the deadline and the duration of the window so clients can show a countdown.
+type ReactionWindowOpened struct {
+	GameID   uuid.UUID     `json:"game_id"`
+	Play     CardPlayed    `json:"play"`
+	Deadline time.Time     `json:"deadline"`
+	Duration time.Duration `json:"duration"`
+}
+
+func (p *ReactionWindowOpened) EventType() common.EventType { return "REACTION_WINDOW_OPENED" }
+
+func (p *ReactionWindowOpened) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *ReactionWindowOpened) GetPlay() *CardPlayed { return &p.Play }
+
+func (p *ReactionWindowOpened) GetDeadline() time.Time { return p.Deadline }
+
+func (p *ReactionWindowOpened) GetDuration() time.Duration { return p.Duration }
+
+// ActionNoped restarts the reaction window, so the Nope can itself be Noped.
+type ActionNoped struct {
+	GameID    uuid.UUID     `json:"game_id"`
+	PlayerID  uuid.UUID     `json:"player_id"`
+	NopeCount int           `json:"nope_count"`
+	Deadline  time.Time     `json:"deadline"`
+	Duration  time.Duration `json:"duration"`
+}
+
+func (p *ActionNoped) EventType() common.EventType { return "ACTION_NOPED" }
+
+func (p *ActionNoped) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *ActionNoped) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *ActionNoped) GetNopeCount() int { return p.NopeCount }
+
+func (p *ActionNoped) GetDeadline() time.Time { return p.Deadline }
+
+func (p *ActionNoped) GetDuration() time.Duration { return p.Duration }
+
+type ReactionWindowClosed struct {
+	GameID    uuid.UUID `json:"game_id"`
+	PlayerID  uuid.UUID `json:"player_id"`
+	Card      CardType  `json:"card,omitempty"`
+	Combo     ComboType `json:"combo,omitempty"`
+	NopeCount int       `json:"nope_count"`
+	// Cancelled is set when the action was Noped an odd number of times and has no effect.
+	Cancelled bool `json:"cancelled"`
+	// TurnDeadline and DefuseDeadline are the deadlines of the turn and of the pending defuse moved
+	// forward by the time the window was open, zero when there was none.
+	TurnDeadline   time.Time `json:"turn_deadline,omitempty"`
+	DefuseDeadline time.Time `json:"defuse_deadline,omitempty"`
+}
+
+func (p *ReactionWindowClosed) EventType() common.EventType { return "REACTION_WINDOW_CLOSED" }
+
+func (p *ReactionWindowClosed) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *ReactionWindowClosed) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *ReactionWindowClosed) GetCard() CardType { return p.Card }
+
+func (p *ReactionWindowClosed) GetCombo() ComboType { return p.Combo }
+
+func (p *ReactionWindowClosed) GetNopeCount() int { return p.NopeCount }
+
+func (p *ReactionWindowClosed) GetCancelled() bool { return p.Cancelled }
+
+func (p *ReactionWindowClosed) GetTurnDeadline() time.Time { return p.TurnDeadline }
+
+func (p *ReactionWindowClosed) GetDefuseDeadline() time.Time { return p.DefuseDeadline }
+
+type ExplodingKittenDrawn struct {
+	GameID    uuid.UUID `json:"game_id"`
+	PlayerID  uuid.UUID `json:"player_id"`
+	HasDefuse bool      `json:"has_defuse"`
+	// Streaking is set when a Streaking Kitten lets the player keep the kitten in their hand.
+	Streaking bool `json:"streaking,omitempty"`
+	// Deadline is when the kitten is put back at a random position for the player.
+	Deadline time.Time `json:"deadline,omitempty"`
+}
+
+func (p *ExplodingKittenDrawn) EventType() common.EventType { return "EXPLODING_KITTEN_DRAWN" }
+
+func (p *ExplodingKittenDrawn) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *ExplodingKittenDrawn) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *ExplodingKittenDrawn) GetHasDefuse() bool { return p.HasDefuse }
+
+func (p *ExplodingKittenDrawn) GetStreaking() bool { return p.Streaking }
+
+func (p *ExplodingKittenDrawn) GetDeadline() time.Time { return p.Deadline }
+
+// KittenDefused holds the secret position of the kitten in the draw pile,
+// it must never be sent to the other players.
+type KittenDefused struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	Position int       `json:"position"`
+	// Random is set when the Defuse was played automatically and the kitten put back at a random position.
+	Random bool `json:"random,omitempty"`
+}
+
+func (p *KittenDefused) EventType() common.EventType { return "KITTEN_DEFUSED" }
+
+func (p *KittenDefused) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *KittenDefused) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *KittenDefused) GetPosition() int { return p.Position }
+
+func (p *KittenDefused) GetRandom() bool { return p.Random }
+
+type PlayerEliminated struct {
+	GameID   uuid.UUID        `json:"game_id"`
+	PlayerID uuid.UUID        `json:"player_id"`
+	Cause    EliminationCause `json:"cause"`
+	// Hand is what the player held, only set when the rules reveal the hands of the eliminated players.
+	Hand []CardType `json:"hand,omitempty"`
+}
+
+func (p *PlayerEliminated) EventType() common.EventType { return "PLAYER_ELIMINATED" }
+
+func (p *PlayerEliminated) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *PlayerEliminated) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *PlayerEliminated) GetCause() EliminationCause { return p.Cause }
+
+func (p *PlayerEliminated) GetHand() []CardType { return p.Hand }
+
+type GameFinished struct {
+	GameID   uuid.UUID `json:"game_id"`
+	WinnerID uuid.UUID `json:"winner_id"`
+	// LobbyID is the lobby the game was started from, it waits for the rematch votes.
+	LobbyID uuid.UUID `json:"lobby_id,omitempty"`
+}
+
+func (p *GameFinished) EventType() common.EventType { return "GAME_FINISHED" }
+
+func (p *GameFinished) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *GameFinished) GetLobbyID() uuid.UUID { return p.LobbyID }
+
+func (p *GameFinished) GetWinnerID() uuid.UUID { return p.WinnerID }
+
+// InteractionRequested asks the chooser for a card, the choice is made at random once the deadline has passed.
+type InteractionRequested struct {
+	GameID         uuid.UUID       `json:"game_id"`
+	Kind           InteractionKind `json:"kind"`
+	PlayerID       uuid.UUID       `json:"player_id"`
+	TargetPlayerID uuid.UUID       `json:"target_player_id"`
+	ChooserID      uuid.UUID       `json:"chooser_id"`
+	// Count is the number of cards from the top of the draw pile the interaction is about, if any.
+	Count    int           `json:"count,omitempty"`
+	Deadline time.Time     `json:"deadline"`
+	Duration time.Duration `json:"duration"`
+}
+
+func (p *InteractionRequested) EventType() common.EventType { return "INTERACTION_REQUESTED" }
+
+func (p *InteractionRequested) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *InteractionRequested) GetKind() InteractionKind { return p.Kind }
+
+func (p *InteractionRequested) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *InteractionRequested) GetTargetPlayerID() uuid.UUID { return p.TargetPlayerID }
+
+func (p *InteractionRequested) GetChooserID() uuid.UUID { return p.ChooserID }
+
+func (p *InteractionRequested) GetDeadline() time.Time { return p.Deadline }
+
+func (p *InteractionRequested) GetCount() int { return p.Count }
+
+func (p *InteractionRequested) GetDuration() time.Duration { return p.Duration }
+
+// CardTransferred is private to both players, others only learn that a card changed hands.
+type CardTransferred struct {
+	GameID       uuid.UUID `json:"game_id"`
+	FromPlayerID uuid.UUID `json:"from_player_id"`
+	ToPlayerID   uuid.UUID `json:"to_player_id"`
+	Card         CardType  `json:"card"`
+	// Random is set when the card was not chosen by a player.
+	Random bool `json:"random"`
+}
+
+func (p *CardTransferred) EventType() common.EventType { return "CARD_TRANSFERRED" }
+
+func (p *CardTransferred) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *CardTransferred) GetFromPlayerID() uuid.UUID { return p.FromPlayerID }
+
+func (p *CardTransferred) GetToPlayerID() uuid.UUID { return p.ToPlayerID }
+
+func (p *CardTransferred) GetCard() CardType { return p.Card }
+
+func (p *CardTransferred) GetRandom() bool { return p.Random }
+
+type CardTakenFromDiscard struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	Card     CardType  `json:"card"`
+	// Random is set when the card was not chosen by the player.
+	Random bool `json:"random"`
+}
+
+func (p *CardTakenFromDiscard) EventType() common.EventType { return "CARD_TAKEN_FROM_DISCARD" }
+
+func (p *CardTakenFromDiscard) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *CardTakenFromDiscard) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *CardTakenFromDiscard) GetCard() CardType { return p.Card }
+
+func (p *CardTakenFromDiscard) GetRandom() bool { return p.Random }
+
+// FutureSeen is private to the player, it reveals the top of the draw pile.
+type FutureSeen struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Cards is ordered from the top of the draw pile.
+	Cards []CardType `json:"cards"`
+}
+
+func (p *FutureSeen) EventType() common.EventType { return "FUTURE_SEEN" }
+
+func (p *FutureSeen) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *FutureSeen) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *FutureSeen) GetCards() []CardType { return p.Cards }
+
+type TurnOrderReversed struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Reversed is the new direction of play, set when it goes counterclockwise.
+	Reversed bool `json:"reversed"`
+}
+
+func (p *TurnOrderReversed) EventType() common.EventType { return "TURN_ORDER_REVERSED" }
+
+func (p *TurnOrderReversed) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *TurnOrderReversed) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *TurnOrderReversed) GetReversed() bool { return p.Reversed }
+
+type ImplodingKittenDrawn struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// FaceUp is set when the kitten was already face up, which eliminates the player.
+	FaceUp bool `json:"face_up"`
+	// Deadline is when the kitten is put back at a random position for the player.
+	Deadline time.Time `json:"deadline,omitempty"`
+}
+
+func (p *ImplodingKittenDrawn) EventType() common.EventType { return "IMPLODING_KITTEN_DRAWN" }
+
+func (p *ImplodingKittenDrawn) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *ImplodingKittenDrawn) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *ImplodingKittenDrawn) GetDeadline() time.Time { return p.Deadline }
+
+func (p *ImplodingKittenDrawn) GetFaceUp() bool { return p.FaceUp }
+
+type ImplodingKittenPlaced struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Position is the number of cards above the kitten, it is public since the kitten is face up.
+	Position int `json:"position"`
+}
+
+func (p *ImplodingKittenPlaced) EventType() common.EventType { return "IMPLODING_KITTEN_PLACED" }
+
+func (p *ImplodingKittenPlaced) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *ImplodingKittenPlaced) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *ImplodingKittenPlaced) GetPosition() int { return p.Position }
+
+// FutureAltered is private to the player, it holds the new order of the top of the draw pile.
+type FutureAltered struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Cards is ordered from the top of the draw pile.
+	Cards []CardType `json:"cards"`
+	// TimedOut is set when the player did not answer and the order was kept.
+	TimedOut bool `json:"timed_out"`
+}
+
+func (p *FutureAltered) EventType() common.EventType { return "FUTURE_ALTERED" }
+
+func (p *FutureAltered) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *FutureAltered) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *FutureAltered) GetCards() []CardType { return p.Cards }
+
+func (p *FutureAltered) GetTimedOut() bool { return p.TimedOut }
+
+type TopAndBottomSwapped struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+func (p *TopAndBottomSwapped) EventType() common.EventType { return "TOP_AND_BOTTOM_SWAPPED" }
+
+func (p *TopAndBottomSwapped) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *TopAndBottomSwapped) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+type KittensMovedToTop struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Count is the number of Exploding Kittens now on top of the draw pile.
+	Count int `json:"count"`
+}
+
+func (p *KittensMovedToTop) EventType() common.EventType { return "KITTENS_MOVED_TO_TOP" }
+
+func (p *KittensMovedToTop) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *KittensMovedToTop) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *KittensMovedToTop) GetCount() int { return p.Count }
+
+type CardMarked struct {
+	GameID         uuid.UUID `json:"game_id"`
+	PlayerID       uuid.UUID `json:"player_id"`
+	TargetPlayerID uuid.UUID `json:"target_player_id"`
+	// Card is public, it stays face up in the hand of the target until it leaves it.
+	Card CardType `json:"card"`
+}
+
+func (p *CardMarked) EventType() common.EventType { return "CARD_MARKED" }
+
+func (p *CardMarked) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *CardMarked) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *CardMarked) GetTargetPlayerID() uuid.UUID { return p.TargetPlayerID }
+
+func (p *CardMarked) GetCard() CardType { return p.Card }
+
+type PlayerCursed struct {
+	GameID         uuid.UUID `json:"game_id"`
+	PlayerID       uuid.UUID `json:"player_id"`
+	TargetPlayerID uuid.UUID `json:"target_player_id"`
+}
+
+func (p *PlayerCursed) EventType() common.EventType { return "PLAYER_CURSED" }
+
+func (p *PlayerCursed) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *PlayerCursed) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *PlayerCursed) GetTargetPlayerID() uuid.UUID { return p.TargetPlayerID }
+
+// CardCollected is private to the player, the card is shuffled in the draw pile.
+type CardCollected struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	Card     CardType  `json:"card"`
+	Random   bool      `json:"random"`
+}
+
+func (p *CardCollected) EventType() common.EventType { return "CARD_COLLECTED" }
+
+func (p *CardCollected) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *CardCollected) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *CardCollected) GetCard() CardType { return p.Card }
+
+func (p *CardCollected) GetRandom() bool { return p.Random }
+
+// DrawPileShuffled holds the secret seed of the shuffle, it must never be sent to the players.
+type DrawPileShuffled struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	Seed     []byte    `json:"seed"`
+}
+
+func (p *DrawPileShuffled) EventType() common.EventType { return "DRAW_PILE_SHUFFLED" }
+
+func (p *DrawPileShuffled) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *DrawPileShuffled) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *DrawPileShuffled) GetSeed() []byte { return p.Seed }
+
+// TurnTimedOut is followed by the events of the move made for the player.
+type TurnTimedOut struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Timeouts is the number of consecutive timeouts of the player.
+	Timeouts int `json:"timeouts"`
+	// AFK is set once the player timed out too many times in a row and is played for from then on.
+	AFK bool `json:"afk"`
+}
+
+func (p *TurnTimedOut) EventType() common.EventType { return "TURN_TIMED_OUT" }
+
+func (p *TurnTimedOut) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *TurnTimedOut) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *TurnTimedOut) GetTimeouts() int { return p.Timeouts }
+
+func (p *TurnTimedOut) GetAFK() bool { return p.AFK }
+
+type PlayerReturned struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+func (p *PlayerReturned) EventType() common.EventType { return "PLAYER_RETURNED" }
+
+func (p *PlayerReturned) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *PlayerReturned) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+// PlayerDisconnected starts the grace period of a player, who is played for until they reconnect.
+type PlayerDisconnected struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	// Deadline is when the player is eliminated unless they reconnect.
+	Deadline time.Time `json:"deadline"`
+}
+
+func (p *PlayerDisconnected) EventType() common.EventType { return "PLAYER_DISCONNECTED" }
+
+func (p *PlayerDisconnected) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *PlayerDisconnected) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+func (p *PlayerDisconnected) GetDeadline() time.Time { return p.Deadline }
+
+type PlayerReconnected struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+func (p *PlayerReconnected) EventType() common.EventType { return "PLAYER_RECONNECTED" }
+
+func (p *PlayerReconnected) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *PlayerReconnected) GetPlayerID() uuid.UUID { return p.PlayerID }
+
+// DefuseNoped discards a Defuse cancelled by a Nope, the kitten is still waiting to be defused.
+type DefuseNoped struct {
+	GameID   uuid.UUID `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+func (p *DefuseNoped) EventType() common.EventType { return "DEFUSE_NOPED" }
+
+func (p *DefuseNoped) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *DefuseNoped) GetPlayerID() uuid.UUID { return p.PlayerID }
@@ -0,0 +1,70 @@
+package game
+
+import "slices"
+
+// Expansion identifies an optional card set added to the base deck.
+type Expansion string
+
+const (
+	ExpansionImplodingKittens Expansion = "IMPLODING_KITTENS"
+	ExpansionStreakingKittens Expansion = "STREAKING_KITTENS"
+	ExpansionPartyPack        Expansion = "PARTY_PACK"
+)
+
+// MaxPartyPlayers is the maximum number of players of a game, whatever its expansions.
+const MaxPartyPlayers = 10
+
+func (e Expansion) String() string {
+	return string(e)
+}
+
+// ExpansionSet is what an expansion adds to the base game. Each expansion lives in
+// its own expansion_*.go file and registers itself, next to the rules of its cards.
+type ExpansionSet struct {
+	// Cards are shuffled with the base cards before the hands are dealt. Defuses are
+	// added to the Defuses of the base deck and Exploding Kittens are ignored, since
+	// the number of kittens only depends on the number of players.
+	Cards []CardCount
+	// Kittens replace as many Exploding Kittens when the kittens are inserted after dealing.
+	Kittens []CardType
+	// ExtraPlayers is the number of players the expansion adds to the maximum.
+	ExtraPlayers int
+}
+
+var expansionSets = make(map[Expansion]ExpansionSet)
+
+// registerExpansion registers the card set of an expansion.
+func registerExpansion(expansion Expansion, set ExpansionSet) {
+	if _, ok := expansionSets[expansion]; ok {
+		panic("expansion already registered: " + expansion.String())
+	}
+
+	expansionSets[expansion] = set
+}
+
+// IsValid reports whether the expansion is known.
+func (e Expansion) IsValid() bool {
+	_, ok := expansionSets[e]
+	return ok
+}
+
+// MaxPlayersFor returns the maximum number of players of a game with the given expansions.
+func MaxPlayersFor(expansions []Expansion) int {
+	maxPlayers := MaxPlayers
+	for _, expansion := range sortExpansions(expansions) {
+		maxPlayers += expansionSets[expansion].ExtraPlayers
+	}
+
+	return min(maxPlayers, MaxPartyPlayers)
+}
+
+// sortExpansions returns the known expansions once each, sorted by name. The order is part
+// of the deck building algorithm, so the same set of expansions always builds the same deck.
+func sortExpansions(expansions []Expansion) []Expansion {
+	result := slices.DeleteFunc(slices.Clone(expansions), func(expansion Expansion) bool {
+		return !expansion.IsValid()
+	})
+	slices.Sort(result)
+
+	return slices.Compact(result)
+}
@@ -0,0 +1,15 @@
+package game
+
+func init() {
+	registerExpansion(ExpansionImplodingKittens, ExpansionSet{
+		Cards: []CardCount{
+			{Card: CardReverse, Count: 4},
+			{Card: CardDrawFromTheBottom, Count: 4},
+			{Card: CardFeralCat, Count: 4},
+			{Card: CardAlterTheFuture, Count: 4},
+			{Card: CardTargetedAttack, Count: 3},
+		},
+		Kittens:      []CardType{CardImplodingKitten},
+		ExtraPlayers: 1,
+	})
+}
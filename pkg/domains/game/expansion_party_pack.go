@@ -0,0 +1,18 @@
+package game
+
+func init() {
+	// The Party Pack merges a second base deck in, Defuses included, for up to MaxPartyPlayers players.
+	cards := make([]CardCount, 0, len(baseCatalog))
+	for _, entry := range baseCatalog {
+		if entry.Card == CardExplodingKitten {
+			continue
+		}
+
+		cards = append(cards, entry)
+	}
+
+	registerExpansion(ExpansionPartyPack, ExpansionSet{
+		Cards:        cards,
+		ExtraPlayers: MaxPartyPlayers - MaxPlayers,
+	})
+}
@@ -0,0 +1,19 @@
+package game
+
+func init() {
+	registerExpansion(ExpansionStreakingKittens, ExpansionSet{
+		Cards: []CardCount{
+			{Card: CardStreakingKitten, Count: 1},
+			{Card: CardSuperSkip, Count: 1},
+			{Card: CardSeeTheFuture5, Count: 1},
+			{Card: CardAlterTheFuture5, Count: 1},
+			{Card: CardSwapTopAndBottom, Count: 3},
+			{Card: CardGarbageCollection, Count: 1},
+			{Card: CardCatomicBomb, Count: 1},
+			{Card: CardMark, Count: 3},
+			{Card: CardCurseOfTheCatButt, Count: 2},
+		},
+		// The expansion comes with one more Exploding Kitten.
+		ExtraPlayers: 1,
+	})
+}
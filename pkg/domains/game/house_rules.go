@@ -0,0 +1,182 @@
+package game
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+)
+
+const (
+	// DefaultDefuses is the number of Defuses dealt to each player when the rules do not change it.
+	DefaultDefuses = 1
+	// MaxDefuses is the maximum number of Defuses the rules can deal to each player.
+	MaxDefuses = 3
+	// MaxReactionWindow is the longest reaction window the rules can set.
+	MaxReactionWindow = 30 * time.Second
+	// MaxTurnTimeout is the longest turn timeout the rules can set.
+	MaxTurnTimeout = 10 * time.Minute
+)
+
+// GameRules are the house rules a game is played with, the zero value plays by the official rules.
+type GameRules struct {
+	// ReactionWindow is how long players can answer an action card with a Nope, the server default
+	// is used when it is zero.
+	ReactionWindow time.Duration `json:"reaction_window,omitempty"`
+	// TurnTimeout is how long a player has to finish a turn, the server default is used when it is zero.
+	TurnTimeout time.Duration `json:"turn_timeout,omitempty"`
+	// Defuses is the number of Defuses dealt to each player, DefaultDefuses is used when it is zero.
+	// The Defuses put back in the draw pile do not change.
+	Defuses int `json:"defuses,omitempty"`
+	// NopeDefuse lets the players Nope a Defuse, the player then explodes unless they hold another one.
+	NopeDefuse bool `json:"nope_defuse,omitempty"`
+	// RevealEliminatedHands turns the hand of the eliminated players face up for everyone.
+	RevealEliminatedHands bool `json:"reveal_eliminated_hands,omitempty"`
+	// RemovedCards are the cards taken out of the deck before it is dealt.
+	RemovedCards []CardType `json:"removed_cards,omitempty"`
+	// EliminateDisconnected eliminates the players still disconnected at the end of their grace
+	// period, they are otherwise played for until they reconnect.
+	EliminateDisconnected bool `json:"eliminate_disconnected,omitempty"`
+}
+
+func (r *GameRules) GetReactionWindow() time.Duration { return r.ReactionWindow }
+
+func (r *GameRules) GetTurnTimeout() time.Duration { return r.TurnTimeout }
+
+// GetDefuses returns the number of Defuses dealt to each player.
+func (r *GameRules) GetDefuses() int {
+	if r.Defuses == 0 {
+		return DefaultDefuses
+	}
+
+	return r.Defuses
+}
+
+func (r *GameRules) GetNopeDefuse() bool { return r.NopeDefuse }
+
+func (r *GameRules) GetRevealEliminatedHands() bool { return r.RevealEliminatedHands }
+
+func (r *GameRules) GetRemovedCards() []CardType { return r.RemovedCards }
+
+func (r *GameRules) GetEliminateDisconnected() bool { return r.EliminateDisconnected }
+
+// IsRemoved reports whether the card was taken out of the deck.
+func (r *GameRules) IsRemoved(card CardType) bool {
+	return slices.Contains(r.RemovedCards, card)
+}
+
+// Validate checks the rules on their own, ValidateFor also checks that the deck can still be dealt.
+func (r *GameRules) Validate() error {
+	if r.ReactionWindow < 0 {
+		return &common.CommandFieldError{Field: "rules.reaction_window", Details: "negative duration"}
+	}
+
+	if r.ReactionWindow > MaxReactionWindow {
+		return &common.CommandFieldError{Field: "rules.reaction_window", Details: "duration too long"}
+	}
+
+	if r.TurnTimeout < 0 {
+		return &common.CommandFieldError{Field: "rules.turn_timeout", Details: "negative duration"}
+	}
+
+	if r.TurnTimeout > MaxTurnTimeout {
+		return &common.CommandFieldError{Field: "rules.turn_timeout", Details: "duration too long"}
+	}
+
+	if r.Defuses < 0 || r.Defuses > MaxDefuses {
+		return &common.CommandFieldError{Field: "rules.defuses", Details: "invalid number of defuses"}
+	}
+
+	for _, card := range r.RemovedCards {
+		if !IsRemovable(card) {
+			return &common.CommandFieldError{Field: "rules.removed_cards", Details: "card cannot be removed"}
+		}
+	}
+
+	return nil
+}
+
+// ValidateFor checks the rules for a game of the given number of players and expansions.
+func (r *GameRules) ValidateFor(playerCount int, expansions []Expansion) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	if dealtCards(r, expansions) < playerCount*InitialHandSize {
+		return &common.CommandFieldError{Field: "rules.removed_cards", Details: "not enough cards left to deal"}
+	}
+
+	return nil
+}
+
+// RulesMessage is a grpc message carrying house rules, the clientserver and the gameserver both
+// have one. Its durations are in milliseconds. Turning the rules back into a message is left to
+// the services, the domain does not know their protos.
+type RulesMessage interface {
+	GetReactionWindow() int64
+	GetTurnTimeout() int64
+	GetDefuses() int32
+	GetNopeDefuse() bool
+	GetRevealEliminatedHands() bool
+	GetRemovedCards() []string
+	GetEliminateDisconnected() bool
+}
+
+// GameRulesFromProto converts the house rules of a grpc message, the card names are normalized.
+func GameRulesFromProto(rules RulesMessage) GameRules {
+	removedCards := make([]CardType, 0, len(rules.GetRemovedCards()))
+	for _, card := range rules.GetRemovedCards() {
+		removedCards = append(removedCards, CardType(strings.ToUpper(strings.TrimSpace(card))))
+	}
+
+	return GameRules{
+		ReactionWindow:        time.Duration(rules.GetReactionWindow()) * time.Millisecond,
+		TurnTimeout:           time.Duration(rules.GetTurnTimeout()) * time.Millisecond,
+		Defuses:               int(rules.GetDefuses()),
+		NopeDefuse:            rules.GetNopeDefuse(),
+		RevealEliminatedHands: rules.GetRevealEliminatedHands(),
+		RemovedCards:          removedCards,
+		EliminateDisconnected: rules.GetEliminateDisconnected(),
+	}
+}
+
+// IsRemovable reports whether the rules can take the card out of the deck. The kittens and the
+// Defuses are never removed, the number of Defuses is set on its own.
+func IsRemovable(card CardType) bool {
+	if card == CardExplodingKitten || card == CardDefuse {
+		return false
+	}
+
+	if slices.ContainsFunc(baseCatalog, func(entry CardCount) bool { return entry.Card == card }) {
+		return true
+	}
+
+	for _, set := range expansionSets {
+		if slices.ContainsFunc(set.Cards, func(entry CardCount) bool { return entry.Card == card }) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dealtCards returns the number of cards the hands are dealt from.
+func dealtCards(rules *GameRules, expansions []Expansion) int {
+	count := 0
+	for _, entry := range baseCatalog {
+		if entry.Card != CardExplodingKitten && entry.Card != CardDefuse && !rules.IsRemoved(entry.Card) {
+			count += entry.Count
+		}
+	}
+
+	for _, expansion := range expansions {
+		for _, entry := range expansionSets[expansion].Cards {
+			if entry.Card != CardExplodingKitten && entry.Card != CardDefuse && !rules.IsRemoved(entry.Card) {
+				count += entry.Count
+			}
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,190 @@
+package game
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// InteractionTimeout is how long a player has to answer an interaction before a random card is
+// chosen, or before the cards are left in their current order.
+const InteractionTimeout = 15 * time.Second
+
+// InteractionKind identifies the choice a player has to make to finish the effect of a card.
+type InteractionKind string
+
+const (
+	// InteractionFavor asks the target of a Favor for a card of their hand.
+	InteractionFavor InteractionKind = "FAVOR"
+	// InteractionDiscardPick asks the player for a card of the discard pile.
+	InteractionDiscardPick InteractionKind = "DISCARD_PICK"
+	// InteractionAlterTheFuture asks the player for a new order of the top of the draw pile.
+	InteractionAlterTheFuture InteractionKind = "ALTER_THE_FUTURE"
+	// InteractionGarbageCollection asks each player in turn for a card of their hand to put back in the draw pile.
+	InteractionGarbageCollection InteractionKind = "GARBAGE_COLLECTION"
+)
+
+func (k InteractionKind) String() string {
+	return string(k)
+}
+
+// InteractionRule is the rule of a choice made by a player.
+type InteractionRule interface {
+	// Options returns the cards the chooser can pick from.
+	Options(state *Game, pending *PendingInteraction) []CardType
+	// Resolve appends the events produced by the chosen card, random is set when the chooser timed out.
+	Resolve(e *emitter, pending *PendingInteraction, card CardType, random bool) error
+}
+
+// ArrangeRule is the rule of a choice answered with an order of cards rather than a single card.
+type ArrangeRule interface {
+	// Options returns the cards to put in order, in their current order.
+	Options(state *Game, pending *PendingInteraction) []CardType
+	// Arrange appends the events produced by the new order, timedOut is set when the chooser
+	// did not answer and the cards are kept in their current order.
+	Arrange(e *emitter, pending *PendingInteraction, cards []CardType, timedOut bool) error
+}
+
+var (
+	interactionRules = make(map[InteractionKind]InteractionRule)
+	arrangeRules     = make(map[InteractionKind]ArrangeRule)
+)
+
+// registerInteractionRule registers the rule of an interaction.
+func registerInteractionRule(kind InteractionKind, rule InteractionRule) {
+	if isRegisteredInteraction(kind) {
+		panic("interaction rule already registered: " + kind.String())
+	}
+
+	interactionRules[kind] = rule
+}
+
+// registerArrangeRule registers the rule of an interaction answered with an order of cards.
+func registerArrangeRule(kind InteractionKind, rule ArrangeRule) {
+	if isRegisteredInteraction(kind) {
+		panic("interaction rule already registered: " + kind.String())
+	}
+
+	arrangeRules[kind] = rule
+}
+
+func isRegisteredInteraction(kind InteractionKind) bool {
+	_, choice := interactionRules[kind]
+	_, arrange := arrangeRules[kind]
+
+	return choice || arrange
+}
+
+// interactionOptions returns the cards of the pending interaction, whatever the kind of its rule.
+func interactionOptions(state *Game, pending *PendingInteraction) ([]CardType, bool) {
+	if rule, ok := interactionRules[pending.Kind]; ok {
+		return rule.Options(state, pending), true
+	}
+
+	if rule, ok := arrangeRules[pending.Kind]; ok {
+		return rule.Options(state, pending), true
+	}
+
+	return nil, false
+}
+
+// openInteraction waits for the chooser to pick a card, unless there is nothing to choose from.
+func openInteraction(e *emitter, pending *PendingInteraction) error {
+	options, ok := interactionOptions(e.state, pending)
+	if !ok {
+		return ErrNoPendingInteraction
+	}
+
+	if len(options) == 0 {
+		return nil
+	}
+
+	now := TimeNow()
+
+	return e.emit(EventTypeInteractionRequested, &InteractionRequested{
+		GameID:         e.state.GameID,
+		Kind:           pending.Kind,
+		PlayerID:       pending.PlayerID,
+		TargetPlayerID: pending.TargetPlayerID,
+		ChooserID:      pending.ChooserID,
+		Count:          pending.Count,
+		Deadline:       now.Add(InteractionTimeout),
+		Duration:       InteractionTimeout,
+	})
+}
+
+// validateChoice checks that the card is one of the options of the pending interaction.
+func validateChoice(state *Game, playerID uuid.UUID, card CardType) error {
+	pending := state.PendingInteraction
+	if pending == nil || pending.ChooserID != playerID {
+		return ErrNoPendingInteraction
+	}
+
+	rule, ok := interactionRules[pending.Kind]
+	if !ok {
+		return ErrNoPendingInteraction
+	}
+
+	if !slices.Contains(rule.Options(state, pending), card) {
+		return ErrInvalidChoice
+	}
+
+	return nil
+}
+
+// validateArrangement checks that the cards are an order of the options of the pending interaction.
+func validateArrangement(state *Game, playerID uuid.UUID, cards []CardType) error {
+	pending := state.PendingInteraction
+	if pending == nil || pending.ChooserID != playerID {
+		return ErrNoPendingInteraction
+	}
+
+	rule, ok := arrangeRules[pending.Kind]
+	if !ok {
+		return ErrNoPendingInteraction
+	}
+
+	options := slices.Clone(rule.Options(state, pending))
+	arranged := slices.Clone(cards)
+	slices.Sort(options)
+	slices.Sort(arranged)
+	if !slices.Equal(options, arranged) {
+		return ErrInvalidArrangement
+	}
+
+	return nil
+}
+
+// answerArrangement resolves the pending interaction with the new order of the cards.
+func answerArrangement(e *emitter, cards []CardType) error {
+	pending := *e.state.PendingInteraction
+
+	return arrangeRules[pending.Kind].Arrange(e, &pending, cards, false)
+}
+
+// answerInteraction resolves the pending interaction with the chosen card.
+func answerInteraction(e *emitter, card CardType) error {
+	pending := *e.state.PendingInteraction
+
+	return interactionRules[pending.Kind].Resolve(e, &pending, card, false)
+}
+
+// expireInteraction resolves the pending interaction once the chooser timed out, with a random
+// card or with the cards left in their current order.
+func expireInteraction(e *emitter) error {
+	pending := *e.state.PendingInteraction
+	if rule, ok := arrangeRules[pending.Kind]; ok {
+		return rule.Arrange(e, &pending, rule.Options(e.state, &pending), true)
+	}
+
+	rule := interactionRules[pending.Kind]
+
+	options := rule.Options(e.state, &pending)
+	index, err := randomIndex(len(options))
+	if err != nil {
+		return err
+	}
+
+	return rule.Resolve(e, &pending, options[index], true)
+}
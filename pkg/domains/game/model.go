@@ -0,0 +1,395 @@
+package game
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+)
+
+type Game struct {
+	GameID    uuid.UUID   `json:"game_id"`
+	LobbyID   uuid.UUID   `json:"lobby_id"`
+	PlayerIDs []uuid.UUID `json:"player_ids"`
+	DeckSeed  []byte      `json:"deck_seed"`
+	// Expansions are the card sets added to the base deck.
+	Expansions []Expansion `json:"expansions,omitempty"`
+	// DrawPile is ordered from top to bottom.
+	DrawPile []CardType `json:"draw_pile"`
+	// DiscardPile is ordered from bottom to top.
+	DiscardPile []CardType               `json:"discard_pile"`
+	Hands       map[uuid.UUID][]CardType `json:"hands"`
+	// TurnOrder holds the players still in the game, in clockwise order.
+	TurnOrder []uuid.UUID `json:"turn_order"`
+	// Reversed is set while the turns go counterclockwise.
+	Reversed        bool          `json:"reversed,omitempty"`
+	CurrentPlayerID uuid.UUID     `json:"current_player_id"`
+	TurnsRemaining  int           `json:"turns_remaining"`
+	ReactionWindow  time.Duration `json:"reaction_window"`
+	// TurnTimeout is how long a player has to finish a turn, zero for the games created without a turn timer.
+	TurnTimeout time.Duration `json:"turn_timeout,omitempty"`
+	AFKTimeouts int           `json:"afk_timeouts,omitempty"`
+	// TurnDeadline is when the current turn is played for the player.
+	TurnDeadline time.Time `json:"turn_deadline,omitempty"`
+	// Timeouts holds the number of consecutive timeouts of each player.
+	Timeouts map[uuid.UUID]int `json:"timeouts,omitempty"`
+	// Bots maps the players played by the server to their difficulty.
+	Bots map[uuid.UUID]BotDifficulty `json:"bots,omitempty"`
+	// Ranked games change the ratings of the players, unless a bot takes part.
+	Ranked bool `json:"ranked,omitempty"`
+	// Rules are the house rules the game is played with, ReactionWindow and TurnTimeout above are the
+	// ones in force.
+	Rules GameRules `json:"rules,omitempty"`
+	// AFKPlayerIDs are the players played for as soon as it is their turn, until they act again.
+	AFKPlayerIDs []uuid.UUID `json:"afk_player_ids,omitempty"`
+	// Disconnected maps the players whose streams dropped to the end of their grace period,
+	// they are played for until they reconnect, or eliminated at the end of it when the rules say so.
+	Disconnected       map[uuid.UUID]time.Time `json:"disconnected,omitempty"`
+	PendingAction      *PendingAction          `json:"pending_action,omitempty"`
+	PendingDefuse      *PendingDefuse          `json:"pending_defuse,omitempty"`
+	PendingInteraction *PendingInteraction     `json:"pending_interaction,omitempty"`
+	// ImplodingKittenFaceUp is set once the Imploding Kitten was put back face up, everyone can see where it is.
+	ImplodingKittenFaceUp bool `json:"imploding_kitten_face_up,omitempty"`
+	// MarkedCards holds the cards turned face up in the hand of each player, everyone can see them.
+	MarkedCards map[uuid.UUID][]CardType `json:"marked_cards,omitempty"`
+	// CursedPlayerIDs are the players who play their next turn without seeing their hand.
+	CursedPlayerIDs []uuid.UUID `json:"cursed_player_ids,omitempty"`
+	// Reveals holds the latest private information of each player, only the player may see it.
+	Reveals map[uuid.UUID]*Reveal `json:"reveals,omitempty"`
+	// RevealedHands holds the hand each eliminated player held when the rules reveal them.
+	RevealedHands map[uuid.UUID][]CardType `json:"revealed_hands,omitempty"`
+	// EliminatedPlayerIDs is ordered by elimination, the first player out comes first.
+	EliminatedPlayerIDs []uuid.UUID `json:"eliminated_player_ids"`
+	Finished            bool        `json:"finished"`
+	WinnerID            uuid.UUID   `json:"winner_id"`
+	// Version is the version of the last event applied to the game.
+	Version   uint64    `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PendingAction is a played action card waiting for its reaction window to close.
+type PendingAction struct {
+	Play      CardPlayed `json:"play"`
+	NopeCount int        `json:"nope_count"`
+	Deadline  time.Time  `json:"deadline"`
+	// OpenedAt is when the window opened, the turn is paused from then on.
+	OpenedAt time.Time `json:"opened_at,omitempty"`
+}
+
+// PendingDefuse is a kitten waiting to be put back in the draw pile.
+type PendingDefuse struct {
+	PlayerID uuid.UUID `json:"player_id"`
+	// Card is the kitten to put back, its draw rule decides how it is put back.
+	Card CardType `json:"card"`
+	// Deadline is when the kitten is put back at a random position, zero without a turn timer.
+	Deadline time.Time `json:"deadline,omitempty"`
+}
+
+// PendingInteraction is a card choice the game waits on to finish the effect of a card.
+type PendingInteraction struct {
+	Kind InteractionKind `json:"kind"`
+	// PlayerID is the player who played the card.
+	PlayerID       uuid.UUID `json:"player_id"`
+	TargetPlayerID uuid.UUID `json:"target_player_id"`
+	// ChooserID is the player who has to choose the card.
+	ChooserID uuid.UUID `json:"chooser_id"`
+	// Count is the number of cards from the top of the draw pile the interaction is about, or the
+	// number of players left to ask after the chooser for the interactions going around the table.
+	Count    int       `json:"count,omitempty"`
+	Deadline time.Time `json:"deadline"`
+}
+
+// RevealKind identifies the private information a player was given.
+type RevealKind string
+
+const (
+	// RevealFuture shows the top cards of the draw pile.
+	RevealFuture RevealKind = "FUTURE"
+	// RevealCardReceived shows the card taken from another player.
+	RevealCardReceived RevealKind = "CARD_RECEIVED"
+	// RevealCardGiven shows the card given to another player.
+	RevealCardGiven RevealKind = "CARD_GIVEN"
+)
+
+// Reveal is private information given to a single player. It is kept until the player
+// draws a card, which is when a See the Future stops being accurate.
+type Reveal struct {
+	Kind  RevealKind `json:"kind"`
+	Cards []CardType `json:"cards"`
+	// OtherPlayerID is the other player of a card transfer.
+	OtherPlayerID uuid.UUID `json:"other_player_id,omitempty"`
+	// DrawPileSize and DiscardPileSize are the sizes of the piles when the future was seen, they tell
+	// whether the draw pile may have changed since.
+	DrawPileSize    int `json:"draw_pile_size,omitempty"`
+	DiscardPileSize int `json:"discard_pile_size,omitempty"`
+}
+
+var _ = common.Entity(&Game{})
+
+func (t *Game) EntityID() string {
+	return t.GameID.String()
+}
+
+func (t *Game) GetGameID() uuid.UUID {
+	return t.GameID
+}
+
+func (t *Game) GetLobbyID() uuid.UUID {
+	return t.LobbyID
+}
+
+func (t *Game) GetPlayerIDs() []uuid.UUID {
+	return t.PlayerIDs
+}
+
+func (t *Game) GetDeckSeed() []byte {
+	return t.DeckSeed
+}
+
+func (t *Game) GetExpansions() []Expansion {
+	return t.Expansions
+}
+
+func (t *Game) GetDrawPile() []CardType {
+	return t.DrawPile
+}
+
+func (t *Game) GetDiscardPile() []CardType {
+	return t.DiscardPile
+}
+
+func (t *Game) GetHands() map[uuid.UUID][]CardType {
+	return t.Hands
+}
+
+func (t *Game) GetHand(playerID uuid.UUID) []CardType {
+	return t.Hands[playerID]
+}
+
+func (t *Game) GetTurnOrder() []uuid.UUID {
+	return t.TurnOrder
+}
+
+func (t *Game) GetReversed() bool {
+	return t.Reversed
+}
+
+func (t *Game) GetCurrentPlayerID() uuid.UUID {
+	return t.CurrentPlayerID
+}
+
+func (t *Game) GetTurnsRemaining() int {
+	return t.TurnsRemaining
+}
+
+func (t *Game) GetReactionWindow() time.Duration {
+	return t.ReactionWindow
+}
+
+func (t *Game) GetPendingAction() *PendingAction {
+	return t.PendingAction
+}
+
+func (t *Game) GetPendingDefuse() *PendingDefuse {
+	return t.PendingDefuse
+}
+
+func (t *Game) GetPendingInteraction() *PendingInteraction {
+	return t.PendingInteraction
+}
+
+func (t *Game) GetImplodingKittenFaceUp() bool {
+	return t.ImplodingKittenFaceUp
+}
+
+func (t *Game) GetMarkedCards(playerID uuid.UUID) []CardType {
+	return t.MarkedCards[playerID]
+}
+
+func (t *Game) GetBots() map[uuid.UUID]BotDifficulty {
+	return t.Bots
+}
+
+func (t *Game) GetRanked() bool {
+	return t.Ranked
+}
+
+func (t *Game) GetRules() *GameRules {
+	return &t.Rules
+}
+
+func (t *Game) GetCursedPlayerIDs() []uuid.UUID {
+	return t.CursedPlayerIDs
+}
+
+// GetReveal returns the private information of the player, if any.
+func (t *Game) GetReveal(playerID uuid.UUID) *Reveal {
+	return t.Reveals[playerID]
+}
+
+func (t *Game) GetEliminatedPlayerIDs() []uuid.UUID {
+	return t.EliminatedPlayerIDs
+}
+
+func (t *Game) GetFinished() bool {
+	return t.Finished
+}
+
+func (t *Game) GetWinnerID() uuid.UUID {
+	return t.WinnerID
+}
+
+func (t *Game) GetCreatedAt() time.Time {
+	return t.CreatedAt
+}
+
+func (t *Game) GetUpdatedAt() time.Time {
+	return t.UpdatedAt
+}
+
+// IsPlayer reports whether the user is still playing the game.
+func (t *Game) IsPlayer(playerID uuid.UUID) bool {
+	return slices.Contains(t.TurnOrder, playerID)
+}
+
+// HasCard reports whether the player holds at least one card of the given type.
+func (t *Game) HasCard(playerID uuid.UUID, card CardType) bool {
+	return slices.Contains(t.Hands[playerID], card)
+}
+
+// CountCard returns the number of cards of the given type the player holds.
+func (t *Game) CountCard(playerID uuid.UUID, card CardType) int {
+	return countCards(t.Hands[playerID], card)
+}
+
+func countCards(cards []CardType, card CardType) int {
+	count := 0
+	for _, held := range cards {
+		if held == card {
+			count++
+		}
+	}
+
+	return count
+}
+
+// UnmarkedCards returns the cards of the hand of the player that are not face up.
+func (t *Game) UnmarkedCards(playerID uuid.UUID) []CardType {
+	unmarked := slices.Clone(t.Hands[playerID])
+	for _, card := range t.MarkedCards[playerID] {
+		if index := slices.Index(unmarked, card); index >= 0 {
+			unmarked = slices.Delete(unmarked, index, index+1)
+		}
+	}
+
+	return unmarked
+}
+
+// IsBot reports whether the player is played by the server.
+func (t *Game) IsBot(playerID uuid.UUID) bool {
+	_, ok := t.Bots[playerID]
+	return ok
+}
+
+// IsAFK reports whether the player is played for as soon as it is their turn.
+func (t *Game) IsAFK(playerID uuid.UUID) bool {
+	return slices.Contains(t.AFKPlayerIDs, playerID)
+}
+
+// IsDisconnected reports whether the streams of the player dropped and the player did not reconnect yet.
+func (t *Game) IsDisconnected(playerID uuid.UUID) bool {
+	_, ok := t.Disconnected[playerID]
+	return ok
+}
+
+// IsCursed reports whether the player plays their next turn without seeing their hand.
+func (t *Game) IsCursed(playerID uuid.UUID) bool {
+	return slices.Contains(t.CursedPlayerIDs, playerID)
+}
+
+// HasCards reports whether the player holds all the cards, counting duplicates.
+func (t *Game) HasCards(playerID uuid.UUID, cards []CardType) bool {
+	hand := slices.Clone(t.Hands[playerID])
+	for _, card := range cards {
+		index := slices.Index(hand, card)
+		if index < 0 {
+			return false
+		}
+		hand = slices.Delete(hand, index, index+1)
+	}
+
+	return true
+}
+
+// NextPlayerID returns the player seated after the given player in the direction of play.
+func (t *Game) NextPlayerID(playerID uuid.UUID) uuid.UUID {
+	index := slices.Index(t.TurnOrder, playerID)
+	if index < 0 {
+		return uuid.Nil
+	}
+
+	step := 1
+	if t.Reversed {
+		step = len(t.TurnOrder) - 1
+	}
+
+	return t.TurnOrder[(index+step)%len(t.TurnOrder)]
+}
+
+// turnDeadline returns when the current player is played for, the placement of a drawn kitten
+// having its own deadline.
+func (t *Game) turnDeadline() time.Time {
+	if t.PendingDefuse != nil {
+		return t.PendingDefuse.Deadline
+	}
+
+	return t.TurnDeadline
+}
+
+// Deadlines returns the commands the game is waiting on, ordered by time.
+func (t *Game) Deadlines() []Deadline {
+	deadlines := make([]Deadline, 0)
+
+	if t.PendingAction != nil {
+		deadlines = append(deadlines, Deadline{
+			At:      t.PendingAction.Deadline,
+			Command: &ResolveAction{GameID: t.GameID},
+		})
+	}
+
+	if t.PendingInteraction != nil {
+		deadlines = append(deadlines, Deadline{
+			At:      t.PendingInteraction.Deadline,
+			Command: &ExpireInteraction{GameID: t.GameID},
+		})
+	}
+
+	// The turn timer waits for the reaction windows and card choices to be over.
+	if at := t.turnDeadline(); !t.Finished && t.PendingAction == nil && t.PendingInteraction == nil && !at.IsZero() {
+		deadlines = append(deadlines, Deadline{
+			At:      at,
+			Command: &ExpireTurn{GameID: t.GameID},
+		})
+	}
+
+	// A disconnected player leaves the game in between moves, never in the middle of one.
+	if t.Rules.EliminateDisconnected && !t.Finished && t.PendingAction == nil && t.PendingInteraction == nil && t.PendingDefuse == nil {
+		for _, playerID := range t.TurnOrder {
+			if at, ok := t.Disconnected[playerID]; ok {
+				deadlines = append(deadlines, Deadline{
+					At:      at,
+					Command: &ExpireDisconnect{GameID: t.GameID, PlayerID: playerID},
+				})
+			}
+		}
+	}
+
+	slices.SortStableFunc(deadlines, func(a, b Deadline) int {
+		return a.At.Compare(b.At)
+	})
+
+	return deadlines
+}
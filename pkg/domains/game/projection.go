@@ -0,0 +1,921 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+)
+
+var ErrEventDataTypeMismatch = errors.New("event data type mismatch")
+
+type AllEventsProjector interface {
+	HandleGameCreated(ctx context.Context, event common.Event, data *GameCreated, entity *Game) (*Game, error)
+	HandleCardDrawn(ctx context.Context, event common.Event, data *CardDrawn, entity *Game) (*Game, error)
+	HandleCardPlayed(ctx context.Context, event common.Event, data *CardPlayed, entity *Game) (*Game, error)
+	HandleTurnAdvanced(ctx context.Context, event common.Event, data *TurnAdvanced, entity *Game) (*Game, error)
+	HandleReactionWindowOpened(ctx context.Context, event common.Event, data *ReactionWindowOpened, entity *Game) (*Game, error)
+	HandleActionNoped(ctx context.Context, event common.Event, data *ActionNoped, entity *Game) (*Game, error)
+	HandleReactionWindowClosed(ctx context.Context, event common.Event, data *ReactionWindowClosed, entity *Game) (*Game, error)
+	HandleExplodingKittenDrawn(ctx context.Context, event common.Event, data *ExplodingKittenDrawn, entity *Game) (*Game, error)
+	HandleKittenDefused(ctx context.Context, event common.Event, data *KittenDefused, entity *Game) (*Game, error)
+	HandlePlayerEliminated(ctx context.Context, event common.Event, data *PlayerEliminated, entity *Game) (*Game, error)
+	HandleGameFinished(ctx context.Context, event common.Event, data *GameFinished, entity *Game) (*Game, error)
+	HandleInteractionRequested(ctx context.Context, event common.Event, data *InteractionRequested, entity *Game) (*Game, error)
+	HandleCardTransferred(ctx context.Context, event common.Event, data *CardTransferred, entity *Game) (*Game, error)
+	HandleCardTakenFromDiscard(ctx context.Context, event common.Event, data *CardTakenFromDiscard, entity *Game) (*Game, error)
+	HandleFutureSeen(ctx context.Context, event common.Event, data *FutureSeen, entity *Game) (*Game, error)
+	HandleTurnOrderReversed(ctx context.Context, event common.Event, data *TurnOrderReversed, entity *Game) (*Game, error)
+	HandleImplodingKittenDrawn(ctx context.Context, event common.Event, data *ImplodingKittenDrawn, entity *Game) (*Game, error)
+	HandleImplodingKittenPlaced(ctx context.Context, event common.Event, data *ImplodingKittenPlaced, entity *Game) (*Game, error)
+	HandleFutureAltered(ctx context.Context, event common.Event, data *FutureAltered, entity *Game) (*Game, error)
+	HandleTopAndBottomSwapped(ctx context.Context, event common.Event, data *TopAndBottomSwapped, entity *Game) (*Game, error)
+	HandleKittensMovedToTop(ctx context.Context, event common.Event, data *KittensMovedToTop, entity *Game) (*Game, error)
+	HandleCardMarked(ctx context.Context, event common.Event, data *CardMarked, entity *Game) (*Game, error)
+	HandlePlayerCursed(ctx context.Context, event common.Event, data *PlayerCursed, entity *Game) (*Game, error)
+	HandleCardCollected(ctx context.Context, event common.Event, data *CardCollected, entity *Game) (*Game, error)
+	HandleDrawPileShuffled(ctx context.Context, event common.Event, data *DrawPileShuffled, entity *Game) (*Game, error)
+	HandleTurnTimedOut(ctx context.Context, event common.Event, data *TurnTimedOut, entity *Game) (*Game, error)
+	HandlePlayerReturned(ctx context.Context, event common.Event, data *PlayerReturned, entity *Game) (*Game, error)
+	HandlePlayerDisconnected(ctx context.Context, event common.Event, data *PlayerDisconnected, entity *Game) (*Game, error)
+	HandlePlayerReconnected(ctx context.Context, event common.Event, data *PlayerReconnected, entity *Game) (*Game, error)
+	HandleDefuseNoped(ctx context.Context, event common.Event, data *DefuseNoped, entity *Game) (*Game, error)
+}
+
+type eventsProjector interface {
+	handleGameCreated(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleCardDrawn(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleCardPlayed(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleTurnAdvanced(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleReactionWindowOpened(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleActionNoped(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleReactionWindowClosed(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleExplodingKittenDrawn(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleKittenDefused(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handlePlayerEliminated(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleGameFinished(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleInteractionRequested(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleCardTransferred(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleCardTakenFromDiscard(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleFutureSeen(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleTurnOrderReversed(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleImplodingKittenDrawn(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleImplodingKittenPlaced(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleFutureAltered(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleTopAndBottomSwapped(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleKittensMovedToTop(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleCardMarked(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handlePlayerCursed(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleCardCollected(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleDrawPileShuffled(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleTurnTimedOut(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handlePlayerReturned(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handlePlayerDisconnected(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handlePlayerReconnected(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+	handleDefuseNoped(ctx context.Context, event common.Event, entity *Game) (*Game, error)
+}
+
+// GameProjector is an event handler for Projections in the Game domain.
+type GameProjector struct {
+	handler any
+}
+
+var _ eventsProjector = (*GameProjector)(nil)
+
+// AfterHandler is the interface that wraps the AfterHandleEvent method.
+type AfterHandler interface {
+	AfterHandleEvent(ctx context.Context, event common.Event, data any) error
+}
+
+// BeforeHandler is the interface that wraps the BeforeHandleEvent method.
+type BeforeHandler interface {
+	BeforeHandleEvent(ctx context.Context, event common.Event, data any) error
+}
+
+// AfterEntityHandler is the interface that wraps the AfterHandleEvent method.
+type AfterEntityHandler interface {
+	AfterHandleEvent(ctx context.Context, event common.Event, data any, entity *Game) (*Game, error)
+}
+
+// BeforeEntityHandler is the interface that wraps the BeforeHandleEvent method.
+type BeforeEntityHandler interface {
+	BeforeHandleEvent(ctx context.Context, event common.Event, data any, entity *Game) (*Game, error)
+}
+
+// NewGameProjection creates a new GameProjector instance with the supplied handler.
+// Handlers that implement any of the Handle* methods will have those methods called when the corresponding event
+// is received.
+// Handlers that implement the BeforeHandleEvent method will have that method called before the event is processed.
+// Handlers that implement the AfterHandleEvent method will have that method called after the event is processed.
+// Handlers that wish to handle all events for the domain should implement the AllEventsHandler interface.
+func NewGameProjection(handler any) *GameProjector {
+	return &GameProjector{
+		handler: handler,
+	}
+}
+
+// Project projects an event onto an entity.
+func (p *GameProjector) Project(ctx context.Context, event common.Event, entity *Game) (updateEntity *Game, err error) {
+	if strings.EqualFold(string(event.AggregateType()), string(AggregateType)) {
+
+		if handler, ok := p.handler.(BeforeHandler); ok {
+			if err := handler.BeforeHandleEvent(ctx, event, event.Data()); err != nil {
+				return nil, err
+			}
+		}
+
+		if handler, ok := p.handler.(BeforeEntityHandler); ok {
+			t, err := handler.BeforeHandleEvent(ctx, event, event.Data(), entity)
+			if err != nil {
+				return nil, err
+			}
+			entity = t
+		}
+
+		t, err := p.handleGameEvent(ctx, event, entity)
+		if err != nil {
+			return nil, err
+		}
+		entity = t
+
+		if handler, ok := p.handler.(AfterHandler); ok {
+			if err := handler.AfterHandleEvent(ctx, event, event.Data()); err != nil {
+				return nil, err
+			}
+		}
+
+		if handler, ok := p.handler.(AfterEntityHandler); ok {
+			t, err := handler.AfterHandleEvent(ctx, event, event.Data(), entity)
+			if err != nil {
+				return nil, err
+			}
+			entity = t
+		}
+	}
+
+	return entity, nil
+}
+
+// HandleEvent processes the supplied event and implements the eventing.EventHandler interface.
+func (p *GameProjector) HandleEvent(ctx context.Context, event common.Event) error {
+	if !strings.EqualFold(string(event.AggregateType()), string(AggregateType)) {
+		return nil
+	}
+
+	if handler, ok := p.handler.(BeforeHandler); ok {
+		if err := handler.BeforeHandleEvent(ctx, event, event.Data()); err != nil {
+			return err
+		}
+	}
+
+	if _, err := p.handleGameEvent(ctx, event, nil); err != nil {
+		return err
+	}
+
+	if handler, ok := p.handler.(AfterHandler); ok {
+		return handler.AfterHandleEvent(ctx, event, event.Data())
+	}
+
+	return nil
+}
+
+// handleGameEvent handles game events.
+func (p *GameProjector) handleGameEvent(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	var eventHandler func(context.Context, common.Event, *Game) (*Game, error)
+
+	switch event.EventType() {
+	case EventTypeGameCreated:
+		eventHandler = p.handleGameCreated
+	case EventTypeCardDrawn:
+		eventHandler = p.handleCardDrawn
+	case EventTypeCardPlayed:
+		eventHandler = p.handleCardPlayed
+	case EventTypeTurnAdvanced:
+		eventHandler = p.handleTurnAdvanced
+	case EventTypeReactionWindowOpened:
+		eventHandler = p.handleReactionWindowOpened
+	case EventTypeActionNoped:
+		eventHandler = p.handleActionNoped
+	case EventTypeReactionWindowClosed:
+		eventHandler = p.handleReactionWindowClosed
+	case EventTypeExplodingKittenDrawn:
+		eventHandler = p.handleExplodingKittenDrawn
+	case EventTypeKittenDefused:
+		eventHandler = p.handleKittenDefused
+	case EventTypePlayerEliminated:
+		eventHandler = p.handlePlayerEliminated
+	case EventTypeGameFinished:
+		eventHandler = p.handleGameFinished
+	case EventTypeInteractionRequested:
+		eventHandler = p.handleInteractionRequested
+	case EventTypeCardTransferred:
+		eventHandler = p.handleCardTransferred
+	case EventTypeCardTakenFromDiscard:
+		eventHandler = p.handleCardTakenFromDiscard
+	case EventTypeFutureSeen:
+		eventHandler = p.handleFutureSeen
+	case EventTypeTurnOrderReversed:
+		eventHandler = p.handleTurnOrderReversed
+	case EventTypeImplodingKittenDrawn:
+		eventHandler = p.handleImplodingKittenDrawn
+	case EventTypeImplodingKittenPlaced:
+		eventHandler = p.handleImplodingKittenPlaced
+	case EventTypeFutureAltered:
+		eventHandler = p.handleFutureAltered
+	case EventTypeTopAndBottomSwapped:
+		eventHandler = p.handleTopAndBottomSwapped
+	case EventTypeKittensMovedToTop:
+		eventHandler = p.handleKittensMovedToTop
+	case EventTypeCardMarked:
+		eventHandler = p.handleCardMarked
+	case EventTypePlayerCursed:
+		eventHandler = p.handlePlayerCursed
+	case EventTypeCardCollected:
+		eventHandler = p.handleCardCollected
+	case EventTypeDrawPileShuffled:
+		eventHandler = p.handleDrawPileShuffled
+	case EventTypeTurnTimedOut:
+		eventHandler = p.handleTurnTimedOut
+	case EventTypePlayerReturned:
+		eventHandler = p.handlePlayerReturned
+	case EventTypePlayerDisconnected:
+		eventHandler = p.handlePlayerDisconnected
+	case EventTypePlayerReconnected:
+		eventHandler = p.handlePlayerReconnected
+	case EventTypeDefuseNoped:
+		eventHandler = p.handleDefuseNoped
+	default:
+		if unregistered, ok := event.(common.UnregisteredEvent); !ok || !unregistered.Unregistered() {
+			return nil, fmt.Errorf("unknown event type: %s", event.EventType())
+		}
+	}
+
+	if entity, err := eventHandler(ctx, event, entity); err != nil {
+		return nil, err
+	} else {
+		return entity, nil
+	}
+}
+
+// handleGameCreated handles game created events.
+func (p *GameProjector) handleGameCreated(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*GameCreated)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleGameCreated"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleGameCreated(ctx context.Context, event common.Event, data *GameCreated, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleGameCreated(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleGameCreated(ctx context.Context, event common.Event, data *GameCreated) error
+	}); ok {
+		return entity, handler.HandleGameCreated(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleCardDrawn handles card drawn events.
+func (p *GameProjector) handleCardDrawn(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*CardDrawn)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleCardDrawn"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardDrawn(ctx context.Context, event common.Event, data *CardDrawn, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleCardDrawn(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardDrawn(ctx context.Context, event common.Event, data *CardDrawn) error
+	}); ok {
+		return entity, handler.HandleCardDrawn(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleCardPlayed handles card played events.
+func (p *GameProjector) handleCardPlayed(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*CardPlayed)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleCardPlayed"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardPlayed(ctx context.Context, event common.Event, data *CardPlayed, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleCardPlayed(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardPlayed(ctx context.Context, event common.Event, data *CardPlayed) error
+	}); ok {
+		return entity, handler.HandleCardPlayed(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleTurnAdvanced handles turn advanced events.
+func (p *GameProjector) handleTurnAdvanced(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*TurnAdvanced)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleTurnAdvanced"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleTurnAdvanced(ctx context.Context, event common.Event, data *TurnAdvanced, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleTurnAdvanced(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleTurnAdvanced(ctx context.Context, event common.Event, data *TurnAdvanced) error
+	}); ok {
+		return entity, handler.HandleTurnAdvanced(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleReactionWindowOpened handles reaction window opened events.
+func (p *GameProjector) handleReactionWindowOpened(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*ReactionWindowOpened)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleReactionWindowOpened"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleReactionWindowOpened(ctx context.Context, event common.Event, data *ReactionWindowOpened, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleReactionWindowOpened(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleReactionWindowOpened(ctx context.Context, event common.Event, data *ReactionWindowOpened) error
+	}); ok {
+		return entity, handler.HandleReactionWindowOpened(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleActionNoped handles action noped events.
+func (p *GameProjector) handleActionNoped(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*ActionNoped)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleActionNoped"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleActionNoped(ctx context.Context, event common.Event, data *ActionNoped, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleActionNoped(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleActionNoped(ctx context.Context, event common.Event, data *ActionNoped) error
+	}); ok {
+		return entity, handler.HandleActionNoped(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleReactionWindowClosed handles reaction window closed events.
+func (p *GameProjector) handleReactionWindowClosed(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*ReactionWindowClosed)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleReactionWindowClosed"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleReactionWindowClosed(ctx context.Context, event common.Event, data *ReactionWindowClosed, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleReactionWindowClosed(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleReactionWindowClosed(ctx context.Context, event common.Event, data *ReactionWindowClosed) error
+	}); ok {
+		return entity, handler.HandleReactionWindowClosed(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleExplodingKittenDrawn handles exploding kitten drawn events.
+func (p *GameProjector) handleExplodingKittenDrawn(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*ExplodingKittenDrawn)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleExplodingKittenDrawn"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleExplodingKittenDrawn(ctx context.Context, event common.Event, data *ExplodingKittenDrawn, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleExplodingKittenDrawn(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleExplodingKittenDrawn(ctx context.Context, event common.Event, data *ExplodingKittenDrawn) error
+	}); ok {
+		return entity, handler.HandleExplodingKittenDrawn(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleKittenDefused handles kitten defused events.
+func (p *GameProjector) handleKittenDefused(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*KittenDefused)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleKittenDefused"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleKittenDefused(ctx context.Context, event common.Event, data *KittenDefused, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleKittenDefused(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleKittenDefused(ctx context.Context, event common.Event, data *KittenDefused) error
+	}); ok {
+		return entity, handler.HandleKittenDefused(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handlePlayerEliminated handles player eliminated events.
+func (p *GameProjector) handlePlayerEliminated(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*PlayerEliminated)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handlePlayerEliminated"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandlePlayerEliminated(ctx context.Context, event common.Event, data *PlayerEliminated, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandlePlayerEliminated(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandlePlayerEliminated(ctx context.Context, event common.Event, data *PlayerEliminated) error
+	}); ok {
+		return entity, handler.HandlePlayerEliminated(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleGameFinished handles game finished events.
+func (p *GameProjector) handleGameFinished(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*GameFinished)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleGameFinished"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleGameFinished(ctx context.Context, event common.Event, data *GameFinished, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleGameFinished(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleGameFinished(ctx context.Context, event common.Event, data *GameFinished) error
+	}); ok {
+		return entity, handler.HandleGameFinished(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleInteractionRequested handles interaction requested events.
+func (p *GameProjector) handleInteractionRequested(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*InteractionRequested)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleInteractionRequested"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleInteractionRequested(ctx context.Context, event common.Event, data *InteractionRequested, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleInteractionRequested(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleInteractionRequested(ctx context.Context, event common.Event, data *InteractionRequested) error
+	}); ok {
+		return entity, handler.HandleInteractionRequested(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleCardTransferred handles card transferred events.
+func (p *GameProjector) handleCardTransferred(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*CardTransferred)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleCardTransferred"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardTransferred(ctx context.Context, event common.Event, data *CardTransferred, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleCardTransferred(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardTransferred(ctx context.Context, event common.Event, data *CardTransferred) error
+	}); ok {
+		return entity, handler.HandleCardTransferred(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleCardTakenFromDiscard handles card taken from discard events.
+func (p *GameProjector) handleCardTakenFromDiscard(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*CardTakenFromDiscard)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleCardTakenFromDiscard"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardTakenFromDiscard(ctx context.Context, event common.Event, data *CardTakenFromDiscard, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleCardTakenFromDiscard(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardTakenFromDiscard(ctx context.Context, event common.Event, data *CardTakenFromDiscard) error
+	}); ok {
+		return entity, handler.HandleCardTakenFromDiscard(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleFutureSeen handles future seen events.
+func (p *GameProjector) handleFutureSeen(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*FutureSeen)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleFutureSeen"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleFutureSeen(ctx context.Context, event common.Event, data *FutureSeen, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleFutureSeen(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleFutureSeen(ctx context.Context, event common.Event, data *FutureSeen) error
+	}); ok {
+		return entity, handler.HandleFutureSeen(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleTurnOrderReversed handles turn order reversed events.
+func (p *GameProjector) handleTurnOrderReversed(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*TurnOrderReversed)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleTurnOrderReversed"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleTurnOrderReversed(ctx context.Context, event common.Event, data *TurnOrderReversed, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleTurnOrderReversed(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleTurnOrderReversed(ctx context.Context, event common.Event, data *TurnOrderReversed) error
+	}); ok {
+		return entity, handler.HandleTurnOrderReversed(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleImplodingKittenDrawn handles imploding kitten drawn events.
+func (p *GameProjector) handleImplodingKittenDrawn(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*ImplodingKittenDrawn)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleImplodingKittenDrawn"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleImplodingKittenDrawn(ctx context.Context, event common.Event, data *ImplodingKittenDrawn, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleImplodingKittenDrawn(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleImplodingKittenDrawn(ctx context.Context, event common.Event, data *ImplodingKittenDrawn) error
+	}); ok {
+		return entity, handler.HandleImplodingKittenDrawn(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleImplodingKittenPlaced handles imploding kitten placed events.
+func (p *GameProjector) handleImplodingKittenPlaced(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*ImplodingKittenPlaced)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleImplodingKittenPlaced"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleImplodingKittenPlaced(ctx context.Context, event common.Event, data *ImplodingKittenPlaced, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleImplodingKittenPlaced(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleImplodingKittenPlaced(ctx context.Context, event common.Event, data *ImplodingKittenPlaced) error
+	}); ok {
+		return entity, handler.HandleImplodingKittenPlaced(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleFutureAltered handles future altered events.
+func (p *GameProjector) handleFutureAltered(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*FutureAltered)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleFutureAltered"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleFutureAltered(ctx context.Context, event common.Event, data *FutureAltered, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleFutureAltered(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleFutureAltered(ctx context.Context, event common.Event, data *FutureAltered) error
+	}); ok {
+		return entity, handler.HandleFutureAltered(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleTopAndBottomSwapped handles top and bottom swapped events.
+func (p *GameProjector) handleTopAndBottomSwapped(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*TopAndBottomSwapped)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleTopAndBottomSwapped"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleTopAndBottomSwapped(ctx context.Context, event common.Event, data *TopAndBottomSwapped, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleTopAndBottomSwapped(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleTopAndBottomSwapped(ctx context.Context, event common.Event, data *TopAndBottomSwapped) error
+	}); ok {
+		return entity, handler.HandleTopAndBottomSwapped(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleKittensMovedToTop handles kittens moved to top events.
+func (p *GameProjector) handleKittensMovedToTop(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*KittensMovedToTop)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleKittensMovedToTop"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleKittensMovedToTop(ctx context.Context, event common.Event, data *KittensMovedToTop, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleKittensMovedToTop(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleKittensMovedToTop(ctx context.Context, event common.Event, data *KittensMovedToTop) error
+	}); ok {
+		return entity, handler.HandleKittensMovedToTop(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleCardMarked handles card marked events.
+func (p *GameProjector) handleCardMarked(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*CardMarked)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleCardMarked"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardMarked(ctx context.Context, event common.Event, data *CardMarked, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleCardMarked(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardMarked(ctx context.Context, event common.Event, data *CardMarked) error
+	}); ok {
+		return entity, handler.HandleCardMarked(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handlePlayerCursed handles player cursed events.
+func (p *GameProjector) handlePlayerCursed(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*PlayerCursed)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handlePlayerCursed"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandlePlayerCursed(ctx context.Context, event common.Event, data *PlayerCursed, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandlePlayerCursed(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandlePlayerCursed(ctx context.Context, event common.Event, data *PlayerCursed) error
+	}); ok {
+		return entity, handler.HandlePlayerCursed(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleCardCollected handles card collected events.
+func (p *GameProjector) handleCardCollected(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*CardCollected)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleCardCollected"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardCollected(ctx context.Context, event common.Event, data *CardCollected, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleCardCollected(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleCardCollected(ctx context.Context, event common.Event, data *CardCollected) error
+	}); ok {
+		return entity, handler.HandleCardCollected(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleDrawPileShuffled handles draw pile shuffled events.
+func (p *GameProjector) handleDrawPileShuffled(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*DrawPileShuffled)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleDrawPileShuffled"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleDrawPileShuffled(ctx context.Context, event common.Event, data *DrawPileShuffled, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleDrawPileShuffled(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleDrawPileShuffled(ctx context.Context, event common.Event, data *DrawPileShuffled) error
+	}); ok {
+		return entity, handler.HandleDrawPileShuffled(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleTurnTimedOut handles turn timed out events.
+func (p *GameProjector) handleTurnTimedOut(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*TurnTimedOut)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleTurnTimedOut"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleTurnTimedOut(ctx context.Context, event common.Event, data *TurnTimedOut, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleTurnTimedOut(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleTurnTimedOut(ctx context.Context, event common.Event, data *TurnTimedOut) error
+	}); ok {
+		return entity, handler.HandleTurnTimedOut(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handlePlayerReturned handles player returned events.
+func (p *GameProjector) handlePlayerReturned(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*PlayerReturned)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handlePlayerReturned"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandlePlayerReturned(ctx context.Context, event common.Event, data *PlayerReturned, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandlePlayerReturned(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandlePlayerReturned(ctx context.Context, event common.Event, data *PlayerReturned) error
+	}); ok {
+		return entity, handler.HandlePlayerReturned(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handlePlayerDisconnected handles player disconnected events.
+func (p *GameProjector) handlePlayerDisconnected(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*PlayerDisconnected)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handlePlayerDisconnected"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandlePlayerDisconnected(ctx context.Context, event common.Event, data *PlayerDisconnected, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandlePlayerDisconnected(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandlePlayerDisconnected(ctx context.Context, event common.Event, data *PlayerDisconnected) error
+	}); ok {
+		return entity, handler.HandlePlayerDisconnected(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handlePlayerReconnected handles player reconnected events.
+func (p *GameProjector) handlePlayerReconnected(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*PlayerReconnected)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handlePlayerReconnected"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandlePlayerReconnected(ctx context.Context, event common.Event, data *PlayerReconnected, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandlePlayerReconnected(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandlePlayerReconnected(ctx context.Context, event common.Event, data *PlayerReconnected) error
+	}); ok {
+		return entity, handler.HandlePlayerReconnected(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleDefuseNoped handles defuse noped events.
+func (p *GameProjector) handleDefuseNoped(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	data, ok := event.Data().(*DefuseNoped)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleDefuseNoped"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleDefuseNoped(ctx context.Context, event common.Event, data *DefuseNoped, entity *Game) (*Game, error)
+	}); ok {
+		return handler.HandleDefuseNoped(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleDefuseNoped(ctx context.Context, event common.Event, data *DefuseNoped) error
+	}); ok {
+		return entity, handler.HandleDefuseNoped(ctx, event, data)
+	}
+
+	return entity, nil
+}
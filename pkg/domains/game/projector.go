@@ -0,0 +1,277 @@
+package game
+
+import (
+	"context"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+)
+
+type Projector struct {
+	Projector *GameProjector
+}
+
+func NewProjector() *Projector {
+	p := &Projector{}
+	p.Projector = NewGameProjection(p)
+	return p
+}
+
+var _ AllEventsProjector = (*Projector)(nil)
+var _ AfterEntityHandler = (*Projector)(nil)
+
+func (p *Projector) ProjectorType() common.ProjectorType {
+	return common.ProjectorType(AggregateType.String())
+}
+
+func (p *Projector) AfterHandleEvent(ctx context.Context, event common.Event, data any, entity *Game) (*Game, error) {
+	entity.Version = event.Version()
+	entity.UpdatedAt = timeutil.NowRoundedForGranularity()
+	return entity, nil
+}
+
+func (p *Projector) Project(ctx context.Context, event common.Event, entity *Game) (*Game, error) {
+	return p.Projector.Project(ctx, event, entity)
+}
+
+func (p *Projector) HandleGameCreated(ctx context.Context, event common.Event, data *GameCreated, entity *Game) (*Game, error) {
+	if err := entity.applyGameCreated(data); err != nil {
+		return nil, err
+	}
+
+	entity.CreatedAt = timeutil.NowRoundedForGranularity()
+
+	return entity, nil
+}
+
+func (p *Projector) HandleCardDrawn(ctx context.Context, event common.Event, data *CardDrawn, entity *Game) (*Game, error) {
+	if err := entity.applyCardDrawn(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleCardPlayed(ctx context.Context, event common.Event, data *CardPlayed, entity *Game) (*Game, error) {
+	if err := entity.applyCardPlayed(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleTurnAdvanced(ctx context.Context, event common.Event, data *TurnAdvanced, entity *Game) (*Game, error) {
+	if err := entity.applyTurnAdvanced(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleReactionWindowOpened(ctx context.Context, event common.Event, data *ReactionWindowOpened, entity *Game) (*Game, error) {
+	if err := entity.applyReactionWindowOpened(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleActionNoped(ctx context.Context, event common.Event, data *ActionNoped, entity *Game) (*Game, error) {
+	if err := entity.applyActionNoped(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleReactionWindowClosed(ctx context.Context, event common.Event, data *ReactionWindowClosed, entity *Game) (*Game, error) {
+	if err := entity.applyReactionWindowClosed(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleExplodingKittenDrawn(ctx context.Context, event common.Event, data *ExplodingKittenDrawn, entity *Game) (*Game, error) {
+	if err := entity.applyExplodingKittenDrawn(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleKittenDefused(ctx context.Context, event common.Event, data *KittenDefused, entity *Game) (*Game, error) {
+	if err := entity.applyKittenDefused(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandlePlayerEliminated(ctx context.Context, event common.Event, data *PlayerEliminated, entity *Game) (*Game, error) {
+	if err := entity.applyPlayerEliminated(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleGameFinished(ctx context.Context, event common.Event, data *GameFinished, entity *Game) (*Game, error) {
+	if err := entity.applyGameFinished(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleInteractionRequested(ctx context.Context, event common.Event, data *InteractionRequested, entity *Game) (*Game, error) {
+	if err := entity.applyInteractionRequested(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleCardTransferred(ctx context.Context, event common.Event, data *CardTransferred, entity *Game) (*Game, error) {
+	if err := entity.applyCardTransferred(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleCardTakenFromDiscard(ctx context.Context, event common.Event, data *CardTakenFromDiscard, entity *Game) (*Game, error) {
+	if err := entity.applyCardTakenFromDiscard(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleFutureSeen(ctx context.Context, event common.Event, data *FutureSeen, entity *Game) (*Game, error) {
+	if err := entity.applyFutureSeen(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleTurnOrderReversed(ctx context.Context, event common.Event, data *TurnOrderReversed, entity *Game) (*Game, error) {
+	if err := entity.applyTurnOrderReversed(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleImplodingKittenDrawn(ctx context.Context, event common.Event, data *ImplodingKittenDrawn, entity *Game) (*Game, error) {
+	if err := entity.applyImplodingKittenDrawn(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleImplodingKittenPlaced(ctx context.Context, event common.Event, data *ImplodingKittenPlaced, entity *Game) (*Game, error) {
+	if err := entity.applyImplodingKittenPlaced(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleFutureAltered(ctx context.Context, event common.Event, data *FutureAltered, entity *Game) (*Game, error) {
+	if err := entity.applyFutureAltered(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleTopAndBottomSwapped(ctx context.Context, event common.Event, data *TopAndBottomSwapped, entity *Game) (*Game, error) {
+	if err := entity.applyTopAndBottomSwapped(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleKittensMovedToTop(ctx context.Context, event common.Event, data *KittensMovedToTop, entity *Game) (*Game, error) {
+	if err := entity.applyKittensMovedToTop(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleCardMarked(ctx context.Context, event common.Event, data *CardMarked, entity *Game) (*Game, error) {
+	if err := entity.applyCardMarked(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandlePlayerCursed(ctx context.Context, event common.Event, data *PlayerCursed, entity *Game) (*Game, error) {
+	if err := entity.applyPlayerCursed(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleCardCollected(ctx context.Context, event common.Event, data *CardCollected, entity *Game) (*Game, error) {
+	if err := entity.applyCardCollected(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleDrawPileShuffled(ctx context.Context, event common.Event, data *DrawPileShuffled, entity *Game) (*Game, error) {
+	if err := entity.applyDrawPileShuffled(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleTurnTimedOut(ctx context.Context, event common.Event, data *TurnTimedOut, entity *Game) (*Game, error) {
+	if err := entity.applyTurnTimedOut(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandlePlayerReturned(ctx context.Context, event common.Event, data *PlayerReturned, entity *Game) (*Game, error) {
+	if err := entity.applyPlayerReturned(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandlePlayerDisconnected(ctx context.Context, event common.Event, data *PlayerDisconnected, entity *Game) (*Game, error) {
+	if err := entity.applyPlayerDisconnected(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandlePlayerReconnected(ctx context.Context, event common.Event, data *PlayerReconnected, entity *Game) (*Game, error) {
+	if err := entity.applyPlayerReconnected(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (p *Projector) HandleDefuseNoped(ctx context.Context, event common.Event, data *DefuseNoped, entity *Game) (*Game, error) {
+	if err := entity.applyDefuseNoped(data); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
@@ -0,0 +1,99 @@
+package game
+
+import "time"
+
+// DefaultReactionWindow is how long players can answer an action card with a Nope
+// when the game was created without a reaction window.
+const DefaultReactionWindow = 5 * time.Second
+
+// openReactionWindow holds back the effect of a played card until the players had a chance to Nope it.
+func openReactionWindow(e *emitter, play *CardPlayed) error {
+	now := TimeNow()
+
+	return e.emit(EventTypeReactionWindowOpened, &ReactionWindowOpened{
+		GameID:   play.GameID,
+		Play:     *play,
+		Deadline: now.Add(e.state.ReactionWindow),
+		Duration: e.state.ReactionWindow,
+	})
+}
+
+// closeReactionWindow resolves the pending action, unless it was Noped an odd number of times.
+func closeReactionWindow(e *emitter) error {
+	pending := e.state.PendingAction
+	play := pending.Play
+	cancelled := pending.NopeCount%2 == 1
+
+	// The turn was paused while the window was open, the player gets that time back.
+	var turnDeadline, defuseDeadline time.Time
+	if paused := TimeNow().Sub(pending.OpenedAt); !pending.OpenedAt.IsZero() && paused > 0 {
+		if !e.state.TurnDeadline.IsZero() {
+			turnDeadline = e.state.TurnDeadline.Add(paused)
+		}
+		if e.state.PendingDefuse != nil && !e.state.PendingDefuse.Deadline.IsZero() {
+			defuseDeadline = e.state.PendingDefuse.Deadline.Add(paused)
+		}
+	}
+
+	if err := e.emit(EventTypeReactionWindowClosed, &ReactionWindowClosed{
+		GameID:         play.GameID,
+		PlayerID:       play.PlayerID,
+		Card:           play.Card,
+		Combo:          play.Combo,
+		NopeCount:      pending.NopeCount,
+		Cancelled:      cancelled,
+		TurnDeadline:   turnDeadline,
+		DefuseDeadline: defuseDeadline,
+	}); err != nil {
+		return err
+	}
+
+	if play.Card == CardDefuse {
+		return resolveDefuse(e, &play, cancelled)
+	}
+
+	if cancelled {
+		return nil
+	}
+
+	if play.Combo != "" {
+		rule, ok := comboRule(play.Combo)
+		if !ok {
+			return ErrInvalidCombo
+		}
+
+		return rule.Resolve(e, &play)
+	}
+
+	rule, ok := cardRule(play.Card)
+	if !ok {
+		return ErrCardNotPlayable
+	}
+
+	return rule.Resolve(e, &play)
+}
+
+// resolveDefuse puts the kitten back once the Defuse was let through. A Noped Defuse is lost, the
+// player explodes unless they hold another one to defuse the kitten with.
+func resolveDefuse(e *emitter, play *CardPlayed, cancelled bool) error {
+	if !cancelled {
+		if err := placeKitten(e, play.PlayerID, play.Position); err != nil {
+			return err
+		}
+
+		return endTurn(e)
+	}
+
+	if err := e.emit(EventTypeDefuseNoped, &DefuseNoped{
+		GameID:   play.GameID,
+		PlayerID: play.PlayerID,
+	}); err != nil {
+		return err
+	}
+
+	if e.state.HasCard(play.PlayerID, CardDefuse) {
+		return nil
+	}
+
+	return eliminatePlayer(e, play.PlayerID, EliminationCauseExploded)
+}
@@ -0,0 +1,150 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+	pool "github.com/octu0/nats-pool"
+	"github.com/samber/do"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	natsEventBus "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/event_bus/nats"
+)
+
+// ReplayStep is an event of a game as it was shown to the table.
+type ReplayStep struct {
+	EventType common.EventType `json:"event_type"`
+	Timestamp time.Time        `json:"timestamp"`
+	Data      any              `json:"data"`
+	// Hands are the hands of the players once the event is applied, only set for a god view.
+	Hands map[uuid.UUID][]CardType `json:"hands,omitempty"`
+}
+
+// LoadGameEvents reads all the events of a game from the game stream, in order.
+func LoadGameEvents(ctx context.Context, gameID uuid.UUID) ([]common.Event, error) {
+	connPool, err := do.InvokeNamed[*pool.ConnPool](nil, string(constants.ConnectionPool))
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := connPool.Get()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = connPool.Put(conn)
+	}()
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := fmt.Sprintf("%s.%s.%s.%s", constants.GameStream, AggregateType, gameID, gameID)
+	return natsEventBus.LoadJetStream(ctx, js, constants.GameStream, subject, customCodec{})
+}
+
+// Replay projects the events of a finished game in order, returning a step for each of them.
+// The cards only some players saw are left out unless godView is set, which also adds the hands
+// of the players to every step. The seeds of the deck are left out of both.
+func Replay(ctx context.Context, events []common.Event, godView bool) ([]ReplayStep, error) {
+	projector := NewProjector()
+	state := &Game{}
+
+	steps := make([]ReplayStep, 0, len(events))
+	for _, event := range events {
+		var err error
+		if state, err = projector.Project(ctx, event, state); err != nil {
+			return nil, err
+		}
+
+		step := ReplayStep{
+			EventType: event.EventType(),
+			Timestamp: event.Timestamp(),
+			Data:      PublicEventData(event.Data()),
+		}
+		if godView {
+			step.Data = godViewEventData(event.Data())
+			step.Hands = make(map[uuid.UUID][]CardType, len(state.Hands))
+			for playerID, hand := range state.Hands {
+				step.Hands[playerID] = slices.Clone(hand)
+			}
+		}
+		steps = append(steps, step)
+	}
+
+	if !state.Finished {
+		return nil, ErrGameNotFinished
+	}
+
+	return steps, nil
+}
+
+// PublicEventData returns the data of an event as every player at the table saw it,
+// without the cards and the secrets known only to some of them.
+func PublicEventData(data any) any {
+	switch typed := data.(type) {
+	case *GameCreated:
+		public := *typed
+		public.DeckSeed = nil
+		return &public
+	case *CardDrawn:
+		public := *typed
+		public.Card = ""
+		return &public
+	case *ReactionWindowOpened:
+		public := *typed
+		// A Defuse waiting for its reaction window carries where the kitten goes back.
+		public.Play.Position = -1
+		return &public
+	case *KittenDefused:
+		public := *typed
+		// Like the position of a hidden imploding kitten in the views.
+		public.Position = -1
+		return &public
+	case *CardTransferred:
+		public := *typed
+		public.Card = ""
+		return &public
+	case *FutureSeen:
+		public := *typed
+		public.Cards = nil
+		return &public
+	case *FutureAltered:
+		public := *typed
+		public.Cards = nil
+		return &public
+	case *CardCollected:
+		public := *typed
+		public.Card = ""
+		return &public
+	case *DrawPileShuffled:
+		public := *typed
+		public.Seed = nil
+		return &public
+	}
+
+	return data
+}
+
+// godViewEventData returns the data of an event for a god view. The seeds never leave the server,
+// the god view already shows the cards they dealt.
+func godViewEventData(data any) any {
+	switch typed := data.(type) {
+	case *GameCreated:
+		view := *typed
+		view.DeckSeed = nil
+		return &view
+	case *DrawPileShuffled:
+		view := *typed
+		view.Seed = nil
+		return &view
+	}
+
+	return data
+}
@@ -0,0 +1,74 @@
+package game
+
+func (as *AggregateSuite) Test_Replay_HidesPrivateCards() {
+	as.playBotGame(nil, BotHard, BotEasy, BotHard)
+
+	steps, err := Replay(as.ctx, as.events, false)
+	as.NoError(err)
+	as.Len(steps, len(as.events))
+
+	for i, step := range steps {
+		as.Equal(as.events[i].EventType(), step.EventType)
+		as.Equal(as.events[i].Timestamp(), step.Timestamp)
+		as.Nil(step.Hands)
+
+		switch data := step.Data.(type) {
+		case *GameCreated:
+			as.Nil(data.DeckSeed)
+		case *CardDrawn:
+			as.Empty(data.Card)
+		case *FutureSeen:
+			as.Nil(data.Cards)
+		}
+	}
+
+	// The events of the game are left untouched.
+	created, ok := as.events[0].Data().(*GameCreated)
+	as.True(ok)
+	as.Len(created.DeckSeed, DeckSeedSize)
+}
+
+func (as *AggregateSuite) Test_Replay_HidesDefusePosition() {
+	as.createWithRules(GameRules{NopeDefuse: true})
+
+	as.stackKitten()
+	as.NoError(as.draw(as.players[0]))
+	as.NoError(as.handle(&DefuseKitten{GameID: as.gameID, PlayerID: as.players[0], Position: 1}))
+
+	opened, ok := as.events[len(as.events)-1].Data().(*ReactionWindowOpened)
+	as.True(ok)
+
+	public, ok := PublicEventData(opened).(*ReactionWindowOpened)
+	as.True(ok)
+	as.Equal(-1, public.Play.Position)
+	as.Equal(CardDefuse, public.Play.Card)
+
+	// The event of the game is left untouched.
+	as.Equal(1, opened.Play.Position)
+}
+
+func (as *AggregateSuite) Test_Replay_GodViewShowsHands() {
+	as.playBotGame(nil, BotEasy, BotEasy)
+
+	steps, err := Replay(as.ctx, as.events, true)
+	as.NoError(err)
+	as.Len(steps, len(as.events))
+
+	// Everything but the seed of the deck is shown.
+	created, ok := as.events[0].Data().(*GameCreated)
+	as.True(ok)
+	expected := *created
+	expected.DeckSeed = nil
+	as.Equal(&expected, steps[0].Data)
+	as.Len(created.DeckSeed, DeckSeedSize)
+	for playerID, hand := range steps[0].Hands {
+		as.Len(hand, InitialHandSize+1, playerID.String())
+	}
+	as.Equal(as.agg.state.Hands, steps[len(steps)-1].Hands)
+}
+
+func (as *AggregateSuite) Test_Replay_GameNotFinished() {
+	steps, err := Replay(as.ctx, as.events, false)
+	as.ErrorIs(err, ErrGameNotFinished)
+	as.Nil(steps)
+}
@@ -0,0 +1,59 @@
+package game
+
+import "slices"
+
+const (
+	// AlterTheFutureCards is the number of cards rearranged by Alter the Future.
+	AlterTheFutureCards = 3
+	// AlterTheFuture5Cards is the number of cards rearranged by Alter the Future x5.
+	AlterTheFuture5Cards = 5
+)
+
+func init() {
+	registerCardRule(CardAlterTheFuture, alterTheFutureRule{cards: AlterTheFutureCards})
+	registerCardRule(CardAlterTheFuture5, alterTheFutureRule{cards: AlterTheFuture5Cards})
+	registerArrangeRule(InteractionAlterTheFuture, alterTheFutureArrangeRule{})
+}
+
+// alterTheFutureRule privately reveals the top cards of the draw pile to the player,
+// then waits for the player to put them back in any order.
+type alterTheFutureRule struct {
+	cards int
+}
+
+func (alterTheFutureRule) Validate(state *Game, cmd *PlayCard) error {
+	return nil
+}
+
+func (r alterTheFutureRule) Resolve(e *emitter, play *CardPlayed) error {
+	if err := e.emit(EventTypeFutureSeen, &FutureSeen{
+		GameID:   play.GameID,
+		PlayerID: play.PlayerID,
+		Cards:    topCards(e.state, r.cards),
+	}); err != nil {
+		return err
+	}
+
+	return openInteraction(e, &PendingInteraction{
+		Kind:      InteractionAlterTheFuture,
+		PlayerID:  play.PlayerID,
+		ChooserID: play.PlayerID,
+		Count:     r.cards,
+	})
+}
+
+// alterTheFutureArrangeRule puts the top cards of the draw pile back in the order of the player.
+type alterTheFutureArrangeRule struct{}
+
+func (alterTheFutureArrangeRule) Options(state *Game, pending *PendingInteraction) []CardType {
+	return topCards(state, pending.Count)
+}
+
+func (alterTheFutureArrangeRule) Arrange(e *emitter, pending *PendingInteraction, cards []CardType, timedOut bool) error {
+	return e.emit(EventTypeFutureAltered, &FutureAltered{
+		GameID:   e.state.GameID,
+		PlayerID: pending.PlayerID,
+		Cards:    slices.Clone(cards),
+		TimedOut: timedOut,
+	})
+}
@@ -0,0 +1,28 @@
+package game
+
+func init() {
+	registerCardRule(CardAttack, attackRule{})
+}
+
+// attackRule ends all turns of the player without drawing and makes the next
+// player take AttackTurns turns. An attacked player who attacks back passes
+// their remaining turns on top of the new ones.
+type attackRule struct{}
+
+func (attackRule) Validate(state *Game, cmd *PlayCard) error {
+	return nil
+}
+
+func (attackRule) Resolve(e *emitter, play *CardPlayed) error {
+	return passTurns(e, attackTurns(e.state))
+}
+
+// attackTurns returns the number of turns given by an attack, including the turns the attacker still owed.
+func attackTurns(state *Game) int {
+	turns := AttackTurns
+	if state.TurnsRemaining > 1 {
+		turns += state.TurnsRemaining
+	}
+
+	return turns
+}
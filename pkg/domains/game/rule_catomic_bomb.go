@@ -0,0 +1,25 @@
+package game
+
+func init() {
+	registerCardRule(CardCatomicBomb, catomicBombRule{})
+}
+
+// catomicBombRule moves every Exploding Kitten of the draw pile to the top, then ends one turn
+// of the player without drawing. The other cards keep their order.
+type catomicBombRule struct{}
+
+func (catomicBombRule) Validate(state *Game, cmd *PlayCard) error {
+	return nil
+}
+
+func (catomicBombRule) Resolve(e *emitter, play *CardPlayed) error {
+	if err := e.emit(EventTypeKittensMovedToTop, &KittensMovedToTop{
+		GameID:   play.GameID,
+		PlayerID: play.PlayerID,
+		Count:    countCards(e.state.DrawPile, CardExplodingKitten),
+	}); err != nil {
+		return err
+	}
+
+	return endTurn(e)
+}
@@ -0,0 +1,50 @@
+package game
+
+import "slices"
+
+func init() {
+	registerComboRule(ComboFiveDifferent, fiveDifferentComboRule{})
+	registerInteractionRule(InteractionDiscardPick, discardPickInteractionRule{})
+}
+
+// fiveDifferentComboRule lets the player pick any card from the discard pile.
+type fiveDifferentComboRule struct{}
+
+func (fiveDifferentComboRule) Matches(cards []CardType) bool {
+	if len(cards) != 5 {
+		return false
+	}
+
+	unique := slices.Clone(cards)
+	slices.Sort(unique)
+
+	return len(slices.Compact(unique)) == len(cards)
+}
+
+func (fiveDifferentComboRule) Validate(state *Game, cmd *PlayCombo) error {
+	return nil
+}
+
+func (fiveDifferentComboRule) Resolve(e *emitter, play *CardPlayed) error {
+	return openInteraction(e, &PendingInteraction{
+		Kind:      InteractionDiscardPick,
+		PlayerID:  play.PlayerID,
+		ChooserID: play.PlayerID,
+	})
+}
+
+// discardPickInteractionRule moves the chosen card from the discard pile to the hand of the player.
+type discardPickInteractionRule struct{}
+
+func (discardPickInteractionRule) Options(state *Game, pending *PendingInteraction) []CardType {
+	return state.DiscardPile
+}
+
+func (discardPickInteractionRule) Resolve(e *emitter, pending *PendingInteraction, card CardType, random bool) error {
+	return e.emit(EventTypeCardTakenFromDiscard, &CardTakenFromDiscard{
+		GameID:   e.state.GameID,
+		PlayerID: pending.PlayerID,
+		Card:     card,
+		Random:   random,
+	})
+}
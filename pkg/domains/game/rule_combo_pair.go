@@ -0,0 +1,36 @@
+package game
+
+func init() {
+	registerComboRule(ComboPair, pairComboRule{})
+}
+
+// pairComboRule steals a random card from the target player.
+type pairComboRule struct{}
+
+func (pairComboRule) Matches(cards []CardType) bool {
+	return sameCards(cards, 2)
+}
+
+func (pairComboRule) Validate(state *Game, cmd *PlayCombo) error {
+	return validateTarget(state, cmd.PlayerID, cmd.TargetPlayerID)
+}
+
+func (pairComboRule) Resolve(e *emitter, play *CardPlayed) error {
+	hand := e.state.GetHand(play.TargetPlayerID)
+	if len(hand) == 0 {
+		return nil
+	}
+
+	index, err := randomIndex(len(hand))
+	if err != nil {
+		return err
+	}
+
+	return transferCard(e, &CardTransferred{
+		GameID:       play.GameID,
+		FromPlayerID: play.TargetPlayerID,
+		ToPlayerID:   play.PlayerID,
+		Card:         hand[index],
+		Random:       true,
+	})
+}
@@ -0,0 +1,33 @@
+package game
+
+func init() {
+	registerComboRule(ComboThreeOfAKind, threeOfAKindComboRule{})
+}
+
+// threeOfAKindComboRule names a card, the target player gives it if they hold one.
+type threeOfAKindComboRule struct{}
+
+func (threeOfAKindComboRule) Matches(cards []CardType) bool {
+	return sameCards(cards, 3)
+}
+
+func (threeOfAKindComboRule) Validate(state *Game, cmd *PlayCombo) error {
+	if cmd.NamedCard == "" {
+		return ErrInvalidCombo
+	}
+
+	return validateTarget(state, cmd.PlayerID, cmd.TargetPlayerID)
+}
+
+func (threeOfAKindComboRule) Resolve(e *emitter, play *CardPlayed) error {
+	if !e.state.HasCard(play.TargetPlayerID, play.NamedCard) {
+		return nil
+	}
+
+	return transferCard(e, &CardTransferred{
+		GameID:       play.GameID,
+		FromPlayerID: play.TargetPlayerID,
+		ToPlayerID:   play.PlayerID,
+		Card:         play.NamedCard,
+	})
+}
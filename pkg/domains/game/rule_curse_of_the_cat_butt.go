@@ -0,0 +1,25 @@
+package game
+
+func init() {
+	registerCardRule(CardCurseOfTheCatButt, curseOfTheCatButtRule{})
+}
+
+// curseOfTheCatButtRule makes the target play their next turn without seeing their hand.
+type curseOfTheCatButtRule struct{}
+
+func (curseOfTheCatButtRule) Validate(state *Game, cmd *PlayCard) error {
+	return validateTarget(state, cmd.PlayerID, cmd.TargetPlayerID)
+}
+
+func (curseOfTheCatButtRule) Resolve(e *emitter, play *CardPlayed) error {
+	// The target may have been eliminated while the reaction window was open.
+	if !e.state.IsPlayer(play.TargetPlayerID) {
+		return nil
+	}
+
+	return e.emit(EventTypePlayerCursed, &PlayerCursed{
+		GameID:         play.GameID,
+		PlayerID:       play.PlayerID,
+		TargetPlayerID: play.TargetPlayerID,
+	})
+}
@@ -0,0 +1,31 @@
+package game
+
+func init() {
+	registerCardRule(CardDrawFromTheBottom, drawFromTheBottomRule{})
+}
+
+// drawFromTheBottomRule ends the turn by drawing the bottom card of the draw pile,
+// which takes effect like any drawn card.
+type drawFromTheBottomRule struct{}
+
+func (drawFromTheBottomRule) Validate(state *Game, cmd *PlayCard) error {
+	if len(state.DrawPile) == 0 {
+		return ErrDrawPileEmpty
+	}
+
+	return nil
+}
+
+func (drawFromTheBottomRule) Resolve(e *emitter, play *CardPlayed) error {
+	drawn := &CardDrawn{
+		GameID:     play.GameID,
+		PlayerID:   play.PlayerID,
+		Card:       e.state.DrawPile[len(e.state.DrawPile)-1],
+		FromBottom: true,
+	}
+	if err := e.emit(EventTypeCardDrawn, drawn); err != nil {
+		return err
+	}
+
+	return resolveDraw(e, drawn)
+}
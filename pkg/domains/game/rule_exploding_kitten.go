@@ -0,0 +1,51 @@
+package game
+
+import "github.com/gofrs/uuid"
+
+func init() {
+	registerDrawRule(CardExplodingKitten, explodingKittenRule{})
+}
+
+// explodingKittenRule eliminates the player who draws it, unless they hold a Defuse.
+// With a Defuse the game waits for the player to put the kitten back at a secret position.
+// A Streaking Kitten lets the player keep the kitten in their hand instead.
+type explodingKittenRule struct{}
+
+func (explodingKittenRule) OnDraw(e *emitter, drawn *CardDrawn) error {
+	if kittensCovered(e.state, drawn.PlayerID) {
+		if err := e.emit(EventTypeExplodingKittenDrawn, &ExplodingKittenDrawn{
+			GameID:    drawn.GameID,
+			PlayerID:  drawn.PlayerID,
+			Streaking: true,
+		}); err != nil {
+			return err
+		}
+
+		return endTurn(e)
+	}
+
+	hasDefuse := e.state.HasCard(drawn.PlayerID, CardDefuse)
+
+	if err := e.emit(EventTypeExplodingKittenDrawn, &ExplodingKittenDrawn{
+		GameID:    drawn.GameID,
+		PlayerID:  drawn.PlayerID,
+		HasDefuse: hasDefuse,
+		Deadline:  defuseDeadline(e.state, drawn.PlayerID),
+	}); err != nil {
+		return err
+	}
+
+	if hasDefuse {
+		return nil
+	}
+
+	return eliminatePlayer(e, drawn.PlayerID, EliminationCauseExploded)
+}
+
+func (explodingKittenRule) Place(e *emitter, playerID uuid.UUID, position int) error {
+	return e.emit(EventTypeKittenDefused, &KittenDefused{
+		GameID:   e.state.GameID,
+		PlayerID: playerID,
+		Position: position,
+	})
+}
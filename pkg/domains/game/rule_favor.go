@@ -0,0 +1,39 @@
+package game
+
+func init() {
+	registerCardRule(CardFavor, favorRule{})
+	registerInteractionRule(InteractionFavor, favorInteractionRule{})
+}
+
+// favorRule makes the target player choose a card of their hand to give to the player.
+type favorRule struct{}
+
+func (favorRule) Validate(state *Game, cmd *PlayCard) error {
+	return validateTarget(state, cmd.PlayerID, cmd.TargetPlayerID)
+}
+
+func (favorRule) Resolve(e *emitter, play *CardPlayed) error {
+	return openInteraction(e, &PendingInteraction{
+		Kind:           InteractionFavor,
+		PlayerID:       play.PlayerID,
+		TargetPlayerID: play.TargetPlayerID,
+		ChooserID:      play.TargetPlayerID,
+	})
+}
+
+// favorInteractionRule moves the card chosen by the target to the hand of the player.
+type favorInteractionRule struct{}
+
+func (favorInteractionRule) Options(state *Game, pending *PendingInteraction) []CardType {
+	return state.GetHand(pending.TargetPlayerID)
+}
+
+func (favorInteractionRule) Resolve(e *emitter, pending *PendingInteraction, card CardType, random bool) error {
+	return transferCard(e, &CardTransferred{
+		GameID:       e.state.GameID,
+		FromPlayerID: pending.TargetPlayerID,
+		ToPlayerID:   pending.PlayerID,
+		Card:         card,
+		Random:       random,
+	})
+}
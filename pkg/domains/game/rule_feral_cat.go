@@ -0,0 +1,6 @@
+package game
+
+// The Feral Cat has no action of its own, it stands for any cat card in a pair or a three of a kind.
+func init() {
+	registerWildcard(CardFeralCat, IsCatCard)
+}
@@ -0,0 +1,86 @@
+package game
+
+import "github.com/gofrs/uuid"
+
+func init() {
+	registerCardRule(CardGarbageCollection, garbageCollectionRule{})
+	registerInteractionRule(InteractionGarbageCollection, garbageCollectionInteractionRule{})
+}
+
+// garbageCollectionRule makes every player, from the player in the direction of play, put a card
+// of their hand back in the draw pile. The draw pile is shuffled once everyone has chosen.
+type garbageCollectionRule struct{}
+
+func (garbageCollectionRule) Validate(state *Game, cmd *PlayCard) error {
+	return nil
+}
+
+func (garbageCollectionRule) Resolve(e *emitter, play *CardPlayed) error {
+	return collectGarbage(e, play.PlayerID, play.PlayerID, len(e.state.TurnOrder)-1)
+}
+
+// garbageCollectionInteractionRule puts the card chosen by the chooser back in the draw pile,
+// then asks the next player.
+type garbageCollectionInteractionRule struct{}
+
+func (garbageCollectionInteractionRule) Options(state *Game, pending *PendingInteraction) []CardType {
+	return state.GetHand(pending.ChooserID)
+}
+
+func (garbageCollectionInteractionRule) Resolve(e *emitter, pending *PendingInteraction, card CardType, random bool) error {
+	// The next player is known before the chooser may be eliminated by their hand checks.
+	nextChooserID := e.state.NextPlayerID(pending.ChooserID)
+
+	if err := e.emit(EventTypeCardCollected, &CardCollected{
+		GameID:   e.state.GameID,
+		PlayerID: pending.ChooserID,
+		Card:     card,
+		Random:   random,
+	}); err != nil {
+		return err
+	}
+
+	if err := checkHands(e, pending.ChooserID); err != nil {
+		return err
+	}
+
+	if e.state.Finished {
+		return nil
+	}
+
+	return collectGarbage(e, pending.PlayerID, nextChooserID, pending.Count-1)
+}
+
+// collectGarbage asks the chooser for a card, skipping the players with an empty hand. Remaining
+// is the number of players left to ask after the chooser, once it is negative the draw pile is shuffled.
+func collectGarbage(e *emitter, playerID uuid.UUID, chooserID uuid.UUID, remaining int) error {
+	for ; remaining >= 0; remaining-- {
+		nextChooserID := e.state.NextPlayerID(chooserID)
+
+		if err := openInteraction(e, &PendingInteraction{
+			Kind:      InteractionGarbageCollection,
+			PlayerID:  playerID,
+			ChooserID: chooserID,
+			Count:     remaining,
+		}); err != nil {
+			return err
+		}
+
+		if e.state.PendingInteraction != nil {
+			return nil
+		}
+
+		chooserID = nextChooserID
+	}
+
+	seed, err := NewDeckSeed()
+	if err != nil {
+		return err
+	}
+
+	return e.emit(EventTypeDrawPileShuffled, &DrawPileShuffled{
+		GameID:   e.state.GameID,
+		PlayerID: playerID,
+		Seed:     seed,
+	})
+}
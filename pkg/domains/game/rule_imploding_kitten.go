@@ -0,0 +1,38 @@
+package game
+
+import "github.com/gofrs/uuid"
+
+func init() {
+	registerDrawRule(CardImplodingKitten, implodingKittenRule{})
+}
+
+// implodingKittenRule cannot be defused. Drawn face down, the player puts it back face up
+// anywhere in the draw pile. Drawn face up, it eliminates the player.
+type implodingKittenRule struct{}
+
+func (implodingKittenRule) OnDraw(e *emitter, drawn *CardDrawn) error {
+	faceUp := e.state.ImplodingKittenFaceUp
+
+	if err := e.emit(EventTypeImplodingKittenDrawn, &ImplodingKittenDrawn{
+		GameID:   drawn.GameID,
+		PlayerID: drawn.PlayerID,
+		FaceUp:   faceUp,
+		Deadline: defuseDeadline(e.state, drawn.PlayerID),
+	}); err != nil {
+		return err
+	}
+
+	if !faceUp {
+		return nil
+	}
+
+	return eliminatePlayer(e, drawn.PlayerID, EliminationCauseImploded)
+}
+
+func (implodingKittenRule) Place(e *emitter, playerID uuid.UUID, position int) error {
+	return e.emit(EventTypeImplodingKittenPlaced, &ImplodingKittenPlaced{
+		GameID:   e.state.GameID,
+		PlayerID: playerID,
+		Position: position,
+	})
+}
@@ -0,0 +1,36 @@
+package game
+
+func init() {
+	registerCardRule(CardMark, markRule{})
+}
+
+// markRule turns a random card of the target face up: everyone can see it until it leaves their hand.
+type markRule struct{}
+
+func (markRule) Validate(state *Game, cmd *PlayCard) error {
+	return validateTarget(state, cmd.PlayerID, cmd.TargetPlayerID)
+}
+
+func (markRule) Resolve(e *emitter, play *CardPlayed) error {
+	// The target may have been eliminated while the reaction window was open.
+	if !e.state.IsPlayer(play.TargetPlayerID) {
+		return nil
+	}
+
+	unmarked := e.state.UnmarkedCards(play.TargetPlayerID)
+	if len(unmarked) == 0 {
+		return nil
+	}
+
+	index, err := randomIndex(len(unmarked))
+	if err != nil {
+		return err
+	}
+
+	return e.emit(EventTypeCardMarked, &CardMarked{
+		GameID:         play.GameID,
+		PlayerID:       play.PlayerID,
+		TargetPlayerID: play.TargetPlayerID,
+		Card:           unmarked[index],
+	})
+}
@@ -0,0 +1,29 @@
+package game
+
+func init() {
+	registerCardRule(CardNope, nopeRule{})
+}
+
+// nopeRule cancels the pending action. Any player can play it out of turn while a
+// reaction window is open, and each Nope restarts the window so it can be Noped back.
+type nopeRule struct{}
+
+func (nopeRule) isReaction() {}
+
+func (nopeRule) Validate(state *Game, cmd *PlayCard) error {
+	if state.PendingAction == nil {
+		return ErrNoPendingAction
+	}
+
+	return nil
+}
+
+func (nopeRule) Resolve(e *emitter, play *CardPlayed) error {
+	return e.emit(EventTypeActionNoped, &ActionNoped{
+		GameID:    play.GameID,
+		PlayerID:  play.PlayerID,
+		NopeCount: e.state.PendingAction.NopeCount + 1,
+		Deadline:  TimeNow().Add(e.state.ReactionWindow),
+		Duration:  e.state.ReactionWindow,
+	})
+}
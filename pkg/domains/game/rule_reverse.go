@@ -0,0 +1,25 @@
+package game
+
+func init() {
+	registerCardRule(CardReverse, reverseRule{})
+}
+
+// reverseRule flips the direction of play and ends one turn of the player without drawing,
+// so with two players it works like a Skip.
+type reverseRule struct{}
+
+func (reverseRule) Validate(state *Game, cmd *PlayCard) error {
+	return nil
+}
+
+func (reverseRule) Resolve(e *emitter, play *CardPlayed) error {
+	if err := e.emit(EventTypeTurnOrderReversed, &TurnOrderReversed{
+		GameID:   play.GameID,
+		PlayerID: play.PlayerID,
+		Reversed: !e.state.Reversed,
+	}); err != nil {
+		return err
+	}
+
+	return endTurn(e)
+}
@@ -0,0 +1,37 @@
+package game
+
+import "slices"
+
+const (
+	// SeeTheFutureCards is the number of cards revealed by See the Future.
+	SeeTheFutureCards = 3
+	// SeeTheFuture5Cards is the number of cards revealed by See the Future x5.
+	SeeTheFuture5Cards = 5
+)
+
+func init() {
+	registerCardRule(CardSeeTheFuture, seeTheFutureRule{cards: SeeTheFutureCards})
+	registerCardRule(CardSeeTheFuture5, seeTheFutureRule{cards: SeeTheFuture5Cards})
+}
+
+// seeTheFutureRule privately reveals the top cards of the draw pile to the player.
+type seeTheFutureRule struct {
+	cards int
+}
+
+func (seeTheFutureRule) Validate(state *Game, cmd *PlayCard) error {
+	return nil
+}
+
+func (r seeTheFutureRule) Resolve(e *emitter, play *CardPlayed) error {
+	return e.emit(EventTypeFutureSeen, &FutureSeen{
+		GameID:   play.GameID,
+		PlayerID: play.PlayerID,
+		Cards:    topCards(e.state, r.cards),
+	})
+}
+
+// topCards returns a copy of the top cards of the draw pile, from the top.
+func topCards(state *Game, count int) []CardType {
+	return slices.Clone(state.DrawPile[:min(count, len(state.DrawPile))])
+}
@@ -0,0 +1,16 @@
+package game
+
+func init() {
+	registerCardRule(CardSkip, skipRule{})
+}
+
+// skipRule ends one turn of the player without drawing a card.
+type skipRule struct{}
+
+func (skipRule) Validate(state *Game, cmd *PlayCard) error {
+	return nil
+}
+
+func (skipRule) Resolve(e *emitter, play *CardPlayed) error {
+	return endTurn(e)
+}
@@ -0,0 +1,40 @@
+package game
+
+import "github.com/gofrs/uuid"
+
+func init() {
+	registerHandCheck(checkStreakingKittens)
+}
+
+// kittensCovered reports whether each Exploding Kitten in the hand of the player is held
+// thanks to a Streaking Kitten.
+func kittensCovered(state *Game, playerID uuid.UUID) bool {
+	return state.CountCard(playerID, CardExplodingKitten) <= state.CountCard(playerID, CardStreakingKitten)
+}
+
+// checkStreakingKittens makes a player who lost a Streaking Kitten deal with the Exploding Kitten
+// it was covering: a Defuse is played automatically and the kitten put back at a random position,
+// without a Defuse the player explodes.
+func checkStreakingKittens(e *emitter, playerID uuid.UUID) error {
+	for !kittensCovered(e.state, playerID) {
+		if !e.state.HasCard(playerID, CardDefuse) {
+			return eliminatePlayer(e, playerID, EliminationCauseExploded)
+		}
+
+		position, err := randomIndex(len(e.state.DrawPile) + 1)
+		if err != nil {
+			return err
+		}
+
+		if err := e.emit(EventTypeKittenDefused, &KittenDefused{
+			GameID:   e.state.GameID,
+			PlayerID: playerID,
+			Position: position,
+			Random:   true,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package game
+
+func init() {
+	registerCardRule(CardSuperSkip, superSkipRule{})
+}
+
+// superSkipRule ends all the turns the player owes without drawing a card.
+type superSkipRule struct{}
+
+func (superSkipRule) Validate(state *Game, cmd *PlayCard) error {
+	return nil
+}
+
+func (superSkipRule) Resolve(e *emitter, play *CardPlayed) error {
+	return passTurns(e, 1)
+}
@@ -0,0 +1,23 @@
+package game
+
+func init() {
+	registerCardRule(CardSwapTopAndBottom, swapTopAndBottomRule{})
+}
+
+// swapTopAndBottomRule swaps the top and bottom cards of the draw pile, without ending the turn.
+type swapTopAndBottomRule struct{}
+
+func (swapTopAndBottomRule) Validate(state *Game, cmd *PlayCard) error {
+	return nil
+}
+
+func (swapTopAndBottomRule) Resolve(e *emitter, play *CardPlayed) error {
+	if len(e.state.DrawPile) < 2 {
+		return nil
+	}
+
+	return e.emit(EventTypeTopAndBottomSwapped, &TopAndBottomSwapped{
+		GameID:   play.GameID,
+		PlayerID: play.PlayerID,
+	})
+}
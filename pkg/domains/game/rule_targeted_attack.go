@@ -0,0 +1,21 @@
+package game
+
+func init() {
+	registerCardRule(CardTargetedAttack, targetedAttackRule{})
+}
+
+// targetedAttackRule is an Attack aimed at any player, play continues from the target.
+type targetedAttackRule struct{}
+
+func (targetedAttackRule) Validate(state *Game, cmd *PlayCard) error {
+	return validateTarget(state, cmd.PlayerID, cmd.TargetPlayerID)
+}
+
+func (targetedAttackRule) Resolve(e *emitter, play *CardPlayed) error {
+	// The target may have been eliminated while the reaction window was open.
+	if !e.state.IsPlayer(play.TargetPlayerID) {
+		return passTurns(e, attackTurns(e.state))
+	}
+
+	return passTurnsTo(e, play.TargetPlayerID, attackTurns(e.state))
+}
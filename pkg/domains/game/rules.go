@@ -0,0 +1,167 @@
+package game
+
+import (
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+)
+
+// CardRule is the rule of a single playable card. Each card lives in its own
+// rule_*.go file and registers itself, so adding a card never touches the aggregate.
+type CardRule interface {
+	// Validate checks that the card can be played right now.
+	Validate(state *Game, cmd *PlayCard) error
+	// Resolve appends the events produced by the effect of the card.
+	Resolve(e *emitter, play *CardPlayed) error
+}
+
+// reactionRule is implemented by the rules of cards that answer the pending action,
+// they can be played out of turn and take effect immediately.
+type reactionRule interface {
+	CardRule
+	isReaction()
+}
+
+var cardRules = make(map[CardType]CardRule)
+
+// registerCardRule registers the rule of a playable card.
+func registerCardRule(card CardType, rule CardRule) {
+	if _, ok := cardRules[card]; ok {
+		panic("card rule already registered: " + card.String())
+	}
+
+	cardRules[card] = rule
+}
+
+// cardRule returns the rule of a card, if the card can be played on its own.
+func cardRule(card CardType) (CardRule, bool) {
+	rule, ok := cardRules[card]
+	return rule, ok
+}
+
+// isReactionCard reports whether the card answers the pending action instead of opening a reaction window.
+func isReactionCard(card CardType) bool {
+	rule, ok := cardRule(card)
+	if !ok {
+		return false
+	}
+
+	_, ok = rule.(reactionRule)
+	return ok
+}
+
+// DrawRule is the rule of a card that takes effect as soon as it is drawn.
+type DrawRule interface {
+	// OnDraw appends the events produced by drawing the card, it replaces the end of the turn.
+	OnDraw(e *emitter, drawn *CardDrawn) error
+}
+
+var drawRules = make(map[CardType]DrawRule)
+
+// registerDrawRule registers the rule of a card with an effect on draw.
+func registerDrawRule(card CardType, rule DrawRule) {
+	if _, ok := drawRules[card]; ok {
+		panic("draw rule already registered: " + card.String())
+	}
+
+	drawRules[card] = rule
+}
+
+// placeRule is implemented by the draw rules of kittens the player puts back in the draw pile.
+type placeRule interface {
+	DrawRule
+	// Place appends the events putting the kitten back, position is the number of cards above it.
+	Place(e *emitter, playerID uuid.UUID, position int) error
+}
+
+// placeKitten puts the pending kitten back in the draw pile.
+func placeKitten(e *emitter, playerID uuid.UUID, position int) error {
+	rule, ok := drawRules[e.state.PendingDefuse.Card].(placeRule)
+	if !ok {
+		return ErrNoPendingDefuse
+	}
+
+	return rule.Place(e, playerID, position)
+}
+
+// drawCard makes the player draw the top card of the draw pile.
+func drawCard(e *emitter, playerID uuid.UUID) error {
+	drawn := &CardDrawn{
+		GameID:   e.state.GameID,
+		PlayerID: playerID,
+		Card:     e.state.DrawPile[0],
+	}
+	if err := e.emit(EventTypeCardDrawn, drawn); err != nil {
+		return err
+	}
+
+	return resolveDraw(e, drawn)
+}
+
+// resolveDraw applies the effect of the card the player just drew, which by default ends the turn.
+func resolveDraw(e *emitter, drawn *CardDrawn) error {
+	if rule, ok := drawRules[drawn.Card]; ok {
+		return rule.OnDraw(e, drawn)
+	}
+
+	return endTurn(e)
+}
+
+// HandCheck is a rule enforced on the hand of a player each time a card leaves or joins it
+// out of a draw, such as a Streaking Kitten no longer covering an Exploding Kitten.
+type HandCheck func(e *emitter, playerID uuid.UUID) error
+
+var handChecks = make([]HandCheck, 0)
+
+// registerHandCheck registers a rule enforced on the hands of the players.
+func registerHandCheck(check HandCheck) {
+	handChecks = append(handChecks, check)
+}
+
+// checkHands enforces the hand checks on the given players, while the game goes on.
+func checkHands(e *emitter, playerIDs ...uuid.UUID) error {
+	for _, playerID := range playerIDs {
+		for _, check := range handChecks {
+			if e.state.Finished || !e.state.IsPlayer(playerID) {
+				break
+			}
+
+			if err := check(e, playerID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// transferCard moves a card from one hand to another, then checks both hands.
+func transferCard(e *emitter, transfer *CardTransferred) error {
+	if err := e.emit(EventTypeCardTransferred, transfer); err != nil {
+		return err
+	}
+
+	return checkHands(e, transfer.FromPlayerID, transfer.ToPlayerID)
+}
+
+// emitter appends events to the aggregate and applies them to a working copy of
+// the state, so a command producing several events can base each on the previous ones.
+type emitter struct {
+	aggregate *Aggregate
+	state     *Game
+}
+
+func (e *emitter) emit(eventType common.EventType, data any) error {
+	e.aggregate.AppendEvent(eventType, data, TimeNow())
+
+	return e.state.apply(eventType, data)
+}
+
+// validateTarget checks that the target is another player still in the game.
+func validateTarget(state *Game, playerID uuid.UUID, targetPlayerID uuid.UUID) error {
+	if targetPlayerID == uuid.Nil || targetPlayerID == playerID || !state.IsPlayer(targetPlayerID) {
+		return ErrInvalidTarget
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package game
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+)
+
+// deadlineGrace is added to every timer so the command reaches the aggregate after the deadline has passed.
+const deadlineGrace = 10 * time.Millisecond
+
+// Deadline is a command a game expects to be issued once a point in time is reached.
+type Deadline struct {
+	At      time.Time
+	Command eventing.Command
+}
+
+// Scheduler issues the deadline commands of games, such as closing a reaction window,
+// and the moves of the bots playing them.
+// Deadlines are part of the game state, so a restarted scheduler picks them up again
+// from the repository, for the games the index of active games holds. Running several schedulers is safe: the aggregate rejects the
+// deadline commands that were already handled, and the bot moves decided from a
+// version of the game another scheduler already moved on from.
+type Scheduler struct {
+	repo    eventing.ReadRepo[Game, *Game]
+	handler eventing.CommandHandler
+	active  ActiveGames
+
+	mu      sync.Mutex
+	timers  map[uuid.UUID]*clock.Timer
+	indexed map[uuid.UUID]bool
+}
+
+func NewScheduler(repo eventing.ReadRepo[Game, *Game], handler eventing.CommandHandler, active ActiveGames) *Scheduler {
+	return &Scheduler{
+		repo:    repo,
+		handler: handler,
+		active:  active,
+		timers:  make(map[uuid.UUID]*clock.Timer),
+		indexed: make(map[uuid.UUID]bool),
+	}
+}
+
+// Start schedules the deadlines of the active games, the finished ones are never loaded.
+func (s *Scheduler) Start(ctx context.Context) error {
+	gameIDs, err := s.active.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, gameID := range gameIDs {
+		game, err := s.repo.Find(ctx, gameID.String())
+		if err != nil {
+			log.Global().ErrorContext(ctx, "failed to load active game", zap.String("game_id", gameID.String()), zap.Error(err))
+			continue
+		}
+
+		s.schedule(ctx, game)
+		s.index(ctx, game)
+	}
+
+	go func() {
+		<-ctx.Done()
+		s.Stop()
+	}()
+
+	return nil
+}
+
+// Refresh reschedules the deadlines of a game, it should be called whenever the game changes.
+func (s *Scheduler) Refresh(ctx context.Context, gameID uuid.UUID) error {
+	game, err := s.repo.Find(ctx, gameID.String())
+	if err != nil {
+		return err
+	}
+
+	s.schedule(ctx, game)
+	s.index(ctx, game)
+
+	return nil
+}
+
+// Stop cancels all pending timers.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for gameID, timer := range s.timers {
+		timer.Stop()
+		delete(s.timers, gameID)
+	}
+}
+
+// schedule replaces the timer of a game with one firing at its next deadline, or at the next bot move.
+func (s *Scheduler) schedule(ctx context.Context, game *Game) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	gameID := game.GetGameID()
+	if timer, ok := s.timers[gameID]; ok {
+		timer.Stop()
+		delete(s.timers, gameID)
+	}
+
+	deadlines := game.Deadlines()
+
+	// The bots play through the same commands as the other players, once they thought about their move.
+	move, err := botDeadline(game)
+	if err != nil {
+		log.Global().ErrorContext(ctx, "failed to decide bot move", zap.String("game_id", gameID.String()), zap.Error(err))
+	}
+	if move != nil && (len(deadlines) == 0 || move.At.Before(deadlines[0].At)) {
+		deadlines = append([]Deadline{*move}, deadlines...)
+	}
+
+	if len(deadlines) == 0 {
+		return
+	}
+
+	next := deadlines[0]
+	s.timers[gameID] = timeutil.Clock.AfterFunc(next.At.Sub(timeutil.Clock.Now())+deadlineGrace, func() {
+		s.fire(ctx, gameID, next)
+	})
+}
+
+// index adds a game to the active games the first time the scheduler sees it, and removes it once
+// it is finished.
+func (s *Scheduler) index(ctx context.Context, game *Game) {
+	gameID := game.GetGameID()
+
+	finished := game.GetFinished()
+
+	s.mu.Lock()
+	indexed := s.indexed[gameID]
+	s.mu.Unlock()
+
+	if indexed && !finished {
+		return
+	}
+
+	var err error
+	if finished {
+		err = s.active.Remove(ctx, gameID)
+	} else {
+		err = s.active.Add(ctx, gameID)
+	}
+	if err != nil {
+		log.Global().ErrorContext(ctx, "failed to index active game", zap.String("game_id", gameID.String()), zap.Error(err))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if finished {
+		// The game is not seen again once it is finished.
+		delete(s.indexed, gameID)
+		return
+	}
+
+	s.indexed[gameID] = true
+}
+
+// fire issues a deadline command, then reschedules the game in case the command was rejected.
+func (s *Scheduler) fire(ctx context.Context, gameID uuid.UUID, deadline Deadline) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	if err := s.handler.HandleCommand(ctx, deadline.Command); err != nil && !isStaleDeadline(err) {
+		log.Global().ErrorContext(ctx, "failed to handle game deadline", zap.String("game_id", gameID.String()), zap.String("command", deadline.Command.CommandType().String()), zap.Error(err))
+	}
+
+	if err := s.Refresh(ctx, gameID); err != nil {
+		log.Global().ErrorContext(ctx, "failed to refresh game deadlines", zap.String("game_id", gameID.String()), zap.Error(err))
+	}
+}
+
+// isStaleDeadline reports whether a deadline command was rejected because it was already handled,
+// or because the deadline moved in the meantime.
+func isStaleDeadline(err error) bool {
+	return errors.Is(err, ErrNoPendingAction) ||
+		errors.Is(err, ErrNoPendingInteraction) ||
+		errors.Is(err, ErrDeadlineNotReached) ||
+		errors.Is(err, ErrReactionWindowOpen) ||
+		errors.Is(err, ErrInteractionPending) ||
+		errors.Is(err, ErrDefusePending) ||
+		errors.Is(err, ErrPlayerNotDisconnected) ||
+		errors.Is(err, ErrDisconnectKept) ||
+		errors.Is(err, ErrGameFinished) ||
+		errors.Is(err, ErrOutdatedCommand)
+}
@@ -0,0 +1,262 @@
+package game
+
+import (
+	"context"
+	"sync"
+	goTesting "testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+)
+
+type SchedulerSuite struct {
+	*testing.Suite
+	ctx    context.Context
+	cancel context.CancelFunc
+	game   *stubGame
+	active *stubActiveGames
+}
+
+func TestSchedulerSuite(t *goTesting.T) {
+	ss := &SchedulerSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, ss)
+}
+
+func (ss *SchedulerSuite) SetupTest() {
+	timeutil.MockClock()
+
+	ss.ctx, ss.cancel = context.WithCancel(context.Background())
+
+	gameID := uuid.Must(uuid.NewV7())
+	ss.game = &stubGame{
+		agg:     &Aggregate{},
+		handled: make(chan eventing.Command, 10),
+	}
+	ss.game.agg.OnCreate(gameID.String())
+
+	ss.NoError(ss.game.HandleCommand(ss.ctx, &CreateGame{
+		GameID:    gameID,
+		LobbyID:   uuid.Must(uuid.NewV7()),
+		PlayerIDs: []uuid.UUID{uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())},
+	}))
+	<-ss.game.handled
+
+	ss.active = &stubActiveGames{gameIDs: map[uuid.UUID]bool{gameID: true}}
+}
+
+func (ss *SchedulerSuite) TearDownTest() {
+	ss.cancel()
+}
+
+// stubGame stands in for both the command bus and the repository of a single game.
+type stubGame struct {
+	mu      sync.Mutex
+	agg     *Aggregate
+	handled chan eventing.Command
+}
+
+func (g *stubGame) HandleCommand(ctx context.Context, cmd eventing.Command) error {
+	_, err := g.HandleCommandEx(ctx, cmd)
+	return err
+}
+
+func (g *stubGame) HandleCommandEx(ctx context.Context, cmd eventing.Command) ([]common.Event, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.agg.HandleCommand(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	events := g.agg.UncommittedEvents()
+	for _, event := range events {
+		if err := g.agg.ApplyEvent(ctx, event); err != nil {
+			return nil, err
+		}
+		g.agg.SetAggregateVersion(event.Version())
+	}
+	g.agg.ClearUncommittedEvents()
+	g.handled <- cmd
+
+	return events, nil
+}
+
+func (g *stubGame) InnerRepo(context.Context) eventing.ReadRepo[Game, *Game] {
+	return nil
+}
+
+func (g *stubGame) Find(ctx context.Context, id string) (*Game, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.agg.state.clone()
+}
+
+func (g *stubGame) FindAll(ctx context.Context) ([]*Game, error) {
+	game, err := g.Find(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Game{game}, nil
+}
+
+func (g *stubGame) Close() error {
+	return nil
+}
+
+// stubActiveGames is an in-memory index of the active games.
+type stubActiveGames struct {
+	mu      sync.Mutex
+	gameIDs map[uuid.UUID]bool
+}
+
+func (g *stubActiveGames) Add(ctx context.Context, gameID uuid.UUID) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.gameIDs[gameID] = true
+	return nil
+}
+
+func (g *stubActiveGames) Remove(ctx context.Context, gameID uuid.UUID) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.gameIDs, gameID)
+	return nil
+}
+
+func (g *stubActiveGames) List(ctx context.Context) ([]uuid.UUID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return lo.Keys(g.gameIDs), nil
+}
+
+func (g *stubActiveGames) has(gameID uuid.UUID) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.gameIDs[gameID]
+}
+
+func (ss *SchedulerSuite) waitCommand() eventing.Command {
+	select {
+	case cmd := <-ss.game.handled:
+		return cmd
+	case <-time.After(time.Second):
+		ss.FailNow("no command was issued")
+		return nil
+	}
+}
+
+func (ss *SchedulerSuite) Test_Scheduler_ExpiresTurnAfterRestart() {
+	// The card drawn for the player only ends the turn.
+	ss.game.agg.state.DrawPile = append([]CardType{CardTacoCat}, ss.game.agg.state.DrawPile...)
+
+	scheduler := NewScheduler(ss.game, ss.game, ss.active)
+	ss.NoError(scheduler.Start(ss.ctx))
+
+	// The process goes down halfway through the turn.
+	timeutil.MockedClock.Add(DefaultTurnTimeout / 2)
+	scheduler.Stop()
+
+	restarted := NewScheduler(ss.game, ss.game, ss.active)
+	ss.NoError(restarted.Start(ss.ctx))
+
+	timeutil.MockedClock.Add(DefaultTurnTimeout/2 + deadlineGrace)
+
+	ss.Equal(ExpireTurnCommand, ss.waitCommand().CommandType())
+
+	state, err := ss.game.Find(ss.ctx, "")
+	ss.NoError(err)
+	ss.Equal(1, state.Timeouts[state.PlayerIDs[0]])
+	ss.Equal(state.PlayerIDs[1], state.CurrentPlayerID)
+}
+
+func (ss *SchedulerSuite) Test_Scheduler_PlaysBotMove() {
+	state := &ss.game.agg.state
+	botID := state.PlayerIDs[0]
+	state.Bots = map[uuid.UUID]BotDifficulty{botID: BotHard}
+	state.DrawPile = append([]CardType{CardTacoCat}, state.DrawPile...)
+	version := state.Version
+
+	scheduler := NewScheduler(ss.game, ss.game, ss.active)
+	ss.NoError(scheduler.Start(ss.ctx))
+
+	timeutil.MockedClock.Add(BotThinkTime + deadlineGrace)
+
+	ss.Equal(&DrawCard{GameID: state.GameID, PlayerID: botID, Versioned: Versioned{ExpectedVersion: version}}, ss.waitCommand())
+}
+
+func (ss *SchedulerSuite) Test_Scheduler_PlaysBotMoveOnce() {
+	state := &ss.game.agg.state
+	botID := state.PlayerIDs[0]
+	state.Bots = map[uuid.UUID]BotDifficulty{botID: BotHard}
+	state.DrawPile = append([]CardType{CardTacoCat}, state.DrawPile...)
+	// The bot was attacked, a second draw would be legal.
+	state.TurnsRemaining = 2
+	hand := len(state.Hands[botID])
+
+	// Every replica of the gameserver schedules the same move.
+	for range 2 {
+		scheduler := NewScheduler(ss.game, ss.game, ss.active)
+		ss.NoError(scheduler.Start(ss.ctx))
+	}
+
+	timeutil.MockedClock.Add(BotThinkTime + deadlineGrace)
+
+	ss.Equal(DrawCardCommand, ss.waitCommand().CommandType())
+	select {
+	case cmd := <-ss.game.handled:
+		ss.Failf("the move was played twice", "%s", cmd.CommandType())
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	game, err := ss.game.Find(ss.ctx, "")
+	ss.NoError(err)
+	ss.Len(game.Hands[botID], hand+1)
+}
+
+func (ss *SchedulerSuite) Test_Scheduler_OnlyStartsActiveGames() {
+	gameID := ss.game.agg.state.GameID
+	ss.NoError(ss.active.Remove(ss.ctx, gameID))
+
+	scheduler := NewScheduler(ss.game, ss.game, ss.active)
+	ss.NoError(scheduler.Start(ss.ctx))
+
+	timeutil.MockedClock.Add(DefaultTurnTimeout + deadlineGrace)
+
+	select {
+	case cmd := <-ss.game.handled:
+		ss.Failf("a game missing from the index was scheduled", "%s", cmd.CommandType())
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// The game is indexed again as soon as it changes.
+	ss.NoError(scheduler.Refresh(ss.ctx, gameID))
+	ss.True(ss.active.has(gameID))
+}
+
+func (ss *SchedulerSuite) Test_Scheduler_RemovesFinishedGames() {
+	gameID := ss.game.agg.state.GameID
+
+	scheduler := NewScheduler(ss.game, ss.game, ss.active)
+	ss.NoError(scheduler.Start(ss.ctx))
+	ss.True(ss.active.has(gameID))
+
+	ss.game.agg.state.Finished = true
+	ss.NoError(scheduler.Refresh(ss.ctx, gameID))
+	ss.False(ss.active.has(gameID))
+}
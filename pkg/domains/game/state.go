@@ -0,0 +1,557 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+)
+
+// The apply* methods mutate the game state for an event. They are shared by the
+// aggregate and the projector so both always agree on the state of a game.
+
+// apply mutates the game state for the given event data.
+func (t *Game) apply(eventType common.EventType, data any) error {
+	var err error
+
+	switch data := data.(type) {
+	case *GameCreated:
+		err = t.applyGameCreated(data)
+	case *CardDrawn:
+		err = t.applyCardDrawn(data)
+	case *CardPlayed:
+		err = t.applyCardPlayed(data)
+	case *TurnAdvanced:
+		err = t.applyTurnAdvanced(data)
+	case *ReactionWindowOpened:
+		err = t.applyReactionWindowOpened(data)
+	case *ActionNoped:
+		err = t.applyActionNoped(data)
+	case *ReactionWindowClosed:
+		err = t.applyReactionWindowClosed(data)
+	case *ExplodingKittenDrawn:
+		err = t.applyExplodingKittenDrawn(data)
+	case *KittenDefused:
+		err = t.applyKittenDefused(data)
+	case *PlayerEliminated:
+		err = t.applyPlayerEliminated(data)
+	case *GameFinished:
+		err = t.applyGameFinished(data)
+	case *InteractionRequested:
+		err = t.applyInteractionRequested(data)
+	case *CardTransferred:
+		err = t.applyCardTransferred(data)
+	case *CardTakenFromDiscard:
+		err = t.applyCardTakenFromDiscard(data)
+	case *FutureSeen:
+		err = t.applyFutureSeen(data)
+	case *TurnOrderReversed:
+		err = t.applyTurnOrderReversed(data)
+	case *ImplodingKittenDrawn:
+		err = t.applyImplodingKittenDrawn(data)
+	case *ImplodingKittenPlaced:
+		err = t.applyImplodingKittenPlaced(data)
+	case *FutureAltered:
+		err = t.applyFutureAltered(data)
+	case *TopAndBottomSwapped:
+		err = t.applyTopAndBottomSwapped(data)
+	case *KittensMovedToTop:
+		err = t.applyKittensMovedToTop(data)
+	case *CardMarked:
+		err = t.applyCardMarked(data)
+	case *PlayerCursed:
+		err = t.applyPlayerCursed(data)
+	case *CardCollected:
+		err = t.applyCardCollected(data)
+	case *DrawPileShuffled:
+		err = t.applyDrawPileShuffled(data)
+	case *TurnTimedOut:
+		err = t.applyTurnTimedOut(data)
+	case *PlayerReturned:
+		err = t.applyPlayerReturned(data)
+	case *PlayerDisconnected:
+		err = t.applyPlayerDisconnected(data)
+	case *PlayerReconnected:
+		err = t.applyPlayerReconnected(data)
+	case *DefuseNoped:
+		err = t.applyDefuseNoped(data)
+	default:
+		err = fmt.Errorf("could not apply event: %s", eventType)
+	}
+
+	return errors.WithStack(err)
+}
+
+// clone returns a deep copy of the game state.
+func (t *Game) clone() (*Game, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := &Game{}
+	if err := json.Unmarshal(bytes, result); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return result, nil
+}
+
+func (t *Game) applyGameCreated(data *GameCreated) error {
+	deck, err := BuildDeckWithRules(data.GetDeckSeed(), data.GetPlayerIDs(), data.GetExpansions(), data.GetRules())
+	if err != nil {
+		return err
+	}
+
+	t.GameID = data.GetGameID()
+	t.LobbyID = data.GetLobbyID()
+	t.PlayerIDs = data.GetPlayerIDs()
+	t.DeckSeed = data.GetDeckSeed()
+	t.Expansions = data.GetExpansions()
+	t.DrawPile = deck.DrawPile
+	t.DiscardPile = []CardType{}
+	t.Hands = deck.Hands
+	t.TurnOrder = slices.Clone(data.GetPlayerIDs())
+	t.CurrentPlayerID = data.GetPlayerIDs()[0]
+	t.TurnsRemaining = 1
+	t.ReactionWindow = data.GetReactionWindow()
+	t.TurnTimeout = data.GetTurnTimeout()
+	t.AFKTimeouts = data.GetAFKTimeouts()
+	t.TurnDeadline = data.GetDeadline()
+	t.Bots = data.GetBots()
+	t.Ranked = data.GetRanked()
+	t.Rules = *data.GetRules()
+	t.EliminatedPlayerIDs = []uuid.UUID{}
+
+	return nil
+}
+
+func (t *Game) applyCardDrawn(data *CardDrawn) error {
+	if len(t.DrawPile) == 0 {
+		return ErrDrawPileEmpty
+	}
+
+	if data.GetFromBottom() {
+		t.DrawPile = t.DrawPile[:len(t.DrawPile)-1]
+	} else {
+		t.DrawPile = t.DrawPile[1:]
+	}
+	t.Hands[data.GetPlayerID()] = append(t.Hands[data.GetPlayerID()], data.GetCard())
+	delete(t.Reveals, data.GetPlayerID())
+
+	return nil
+}
+
+func (t *Game) applyCardPlayed(data *CardPlayed) error {
+	for _, card := range data.PlayedCards() {
+		if err := t.removeFromHand(data.GetPlayerID(), card); err != nil {
+			return err
+		}
+
+		t.DiscardPile = append(t.DiscardPile, card)
+	}
+
+	return nil
+}
+
+func (t *Game) applyTurnAdvanced(data *TurnAdvanced) error {
+	// A curse lasts until the end of the turn of the cursed player.
+	t.CursedPlayerIDs = slices.DeleteFunc(slices.Clone(t.CursedPlayerIDs), func(id uuid.UUID) bool {
+		return id == t.CurrentPlayerID
+	})
+
+	t.CurrentPlayerID = data.GetPlayerID()
+	t.TurnsRemaining = data.GetTurnsRemaining()
+	t.TurnDeadline = data.GetDeadline()
+
+	return nil
+}
+
+func (t *Game) applyReactionWindowOpened(data *ReactionWindowOpened) error {
+	t.PendingAction = &PendingAction{
+		Play:     *data.GetPlay(),
+		Deadline: data.GetDeadline(),
+		OpenedAt: data.GetDeadline().Add(-data.GetDuration()),
+	}
+
+	return nil
+}
+
+func (t *Game) applyActionNoped(data *ActionNoped) error {
+	if t.PendingAction == nil {
+		return ErrNoPendingAction
+	}
+
+	t.PendingAction.NopeCount = data.GetNopeCount()
+	t.PendingAction.Deadline = data.GetDeadline()
+
+	return nil
+}
+
+func (t *Game) applyReactionWindowClosed(data *ReactionWindowClosed) error {
+	t.PendingAction = nil
+
+	if deadline := data.GetTurnDeadline(); !deadline.IsZero() {
+		t.TurnDeadline = deadline
+	}
+	if deadline := data.GetDefuseDeadline(); !deadline.IsZero() && t.PendingDefuse != nil {
+		t.PendingDefuse.Deadline = deadline
+	}
+
+	return nil
+}
+
+func (t *Game) applyExplodingKittenDrawn(data *ExplodingKittenDrawn) error {
+	if data.GetStreaking() {
+		return nil
+	}
+
+	t.PendingDefuse = &PendingDefuse{
+		PlayerID: data.GetPlayerID(),
+		Card:     CardExplodingKitten,
+		Deadline: data.GetDeadline(),
+	}
+
+	return nil
+}
+
+func (t *Game) applyKittenDefused(data *KittenDefused) error {
+	if err := t.removeFromHand(data.GetPlayerID(), CardDefuse); err != nil {
+		return err
+	}
+
+	if err := t.removeFromHand(data.GetPlayerID(), CardExplodingKitten); err != nil {
+		return err
+	}
+
+	position := data.GetPosition()
+	if position < 0 || position > len(t.DrawPile) {
+		return ErrInvalidPosition
+	}
+
+	t.DiscardPile = append(t.DiscardPile, CardDefuse)
+	t.DrawPile = slices.Insert(slices.Clone(t.DrawPile), position, CardExplodingKitten)
+	t.PendingDefuse = nil
+
+	return nil
+}
+
+func (t *Game) applyPlayerEliminated(data *PlayerEliminated) error {
+	playerID := data.GetPlayerID()
+
+	t.TurnOrder = slices.DeleteFunc(slices.Clone(t.TurnOrder), func(id uuid.UUID) bool {
+		return id == playerID
+	})
+	t.EliminatedPlayerIDs = append(t.EliminatedPlayerIDs, playerID)
+	if hand := data.GetHand(); hand != nil {
+		if t.RevealedHands == nil {
+			t.RevealedHands = make(map[uuid.UUID][]CardType)
+		}
+		t.RevealedHands[playerID] = hand
+	}
+	delete(t.Hands, playerID)
+	delete(t.Reveals, playerID)
+	delete(t.MarkedCards, playerID)
+	delete(t.Timeouts, playerID)
+	delete(t.Disconnected, playerID)
+	t.AFKPlayerIDs = slices.DeleteFunc(slices.Clone(t.AFKPlayerIDs), func(id uuid.UUID) bool {
+		return id == playerID
+	})
+	t.CursedPlayerIDs = slices.DeleteFunc(slices.Clone(t.CursedPlayerIDs), func(id uuid.UUID) bool {
+		return id == playerID
+	})
+
+	if t.PendingDefuse != nil && t.PendingDefuse.PlayerID == playerID {
+		t.PendingDefuse = nil
+	}
+
+	return nil
+}
+
+func (t *Game) applyGameFinished(data *GameFinished) error {
+	t.Finished = true
+	t.WinnerID = data.GetWinnerID()
+	t.PendingAction = nil
+	t.PendingDefuse = nil
+	t.PendingInteraction = nil
+
+	return nil
+}
+
+func (t *Game) applyInteractionRequested(data *InteractionRequested) error {
+	t.PendingInteraction = &PendingInteraction{
+		Kind:           data.GetKind(),
+		PlayerID:       data.GetPlayerID(),
+		TargetPlayerID: data.GetTargetPlayerID(),
+		ChooserID:      data.GetChooserID(),
+		Count:          data.GetCount(),
+		Deadline:       data.GetDeadline(),
+	}
+
+	return nil
+}
+
+func (t *Game) applyCardTransferred(data *CardTransferred) error {
+	if err := t.removeFromHand(data.GetFromPlayerID(), data.GetCard()); err != nil {
+		return err
+	}
+
+	t.Hands[data.GetToPlayerID()] = append(t.Hands[data.GetToPlayerID()], data.GetCard())
+	t.PendingInteraction = nil
+
+	t.reveal(data.GetToPlayerID(), &Reveal{
+		Kind:          RevealCardReceived,
+		Cards:         []CardType{data.GetCard()},
+		OtherPlayerID: data.GetFromPlayerID(),
+	})
+	t.reveal(data.GetFromPlayerID(), &Reveal{
+		Kind:          RevealCardGiven,
+		Cards:         []CardType{data.GetCard()},
+		OtherPlayerID: data.GetToPlayerID(),
+	})
+
+	return nil
+}
+
+func (t *Game) applyCardTakenFromDiscard(data *CardTakenFromDiscard) error {
+	// The topmost copy of the card is taken.
+	index := -1
+	for i, card := range slices.Backward(t.DiscardPile) {
+		if card == data.GetCard() {
+			index = i
+			break
+		}
+	}
+
+	if index < 0 {
+		return ErrInvalidChoice
+	}
+
+	t.DiscardPile = slices.Delete(slices.Clone(t.DiscardPile), index, index+1)
+	t.Hands[data.GetPlayerID()] = append(t.Hands[data.GetPlayerID()], data.GetCard())
+	t.PendingInteraction = nil
+
+	return nil
+}
+
+func (t *Game) applyFutureSeen(data *FutureSeen) error {
+	t.reveal(data.GetPlayerID(), &Reveal{
+		Kind:            RevealFuture,
+		Cards:           data.GetCards(),
+		DrawPileSize:    len(t.DrawPile),
+		DiscardPileSize: len(t.DiscardPile),
+	})
+
+	return nil
+}
+
+func (t *Game) applyTurnOrderReversed(data *TurnOrderReversed) error {
+	t.Reversed = data.GetReversed()
+
+	return nil
+}
+
+func (t *Game) applyImplodingKittenDrawn(data *ImplodingKittenDrawn) error {
+	if data.GetFaceUp() {
+		// The kitten leaves the game with the player it eliminates.
+		t.ImplodingKittenFaceUp = false
+		return nil
+	}
+
+	t.PendingDefuse = &PendingDefuse{
+		PlayerID: data.GetPlayerID(),
+		Card:     CardImplodingKitten,
+		Deadline: data.GetDeadline(),
+	}
+
+	return nil
+}
+
+func (t *Game) applyImplodingKittenPlaced(data *ImplodingKittenPlaced) error {
+	if err := t.removeFromHand(data.GetPlayerID(), CardImplodingKitten); err != nil {
+		return err
+	}
+
+	position := data.GetPosition()
+	if position < 0 || position > len(t.DrawPile) {
+		return ErrInvalidPosition
+	}
+
+	t.DrawPile = slices.Insert(slices.Clone(t.DrawPile), position, CardImplodingKitten)
+	t.ImplodingKittenFaceUp = true
+	t.PendingDefuse = nil
+
+	return nil
+}
+
+func (t *Game) applyFutureAltered(data *FutureAltered) error {
+	cards := data.GetCards()
+	if len(cards) > len(t.DrawPile) {
+		return ErrInvalidArrangement
+	}
+
+	t.DrawPile = slices.Concat(cards, t.DrawPile[len(cards):])
+	t.PendingInteraction = nil
+
+	t.reveal(data.GetPlayerID(), &Reveal{
+		Kind:            RevealFuture,
+		Cards:           slices.Clone(cards),
+		DrawPileSize:    len(t.DrawPile),
+		DiscardPileSize: len(t.DiscardPile),
+	})
+
+	return nil
+}
+
+func (t *Game) applyTopAndBottomSwapped(data *TopAndBottomSwapped) error {
+	if len(t.DrawPile) == 0 {
+		return ErrDrawPileEmpty
+	}
+
+	last := len(t.DrawPile) - 1
+	t.DrawPile = slices.Clone(t.DrawPile)
+	t.DrawPile[0], t.DrawPile[last] = t.DrawPile[last], t.DrawPile[0]
+
+	return nil
+}
+
+func (t *Game) applyKittensMovedToTop(data *KittensMovedToTop) error {
+	kittens := make([]CardType, 0, data.GetCount())
+	others := make([]CardType, 0, len(t.DrawPile))
+	for _, card := range t.DrawPile {
+		if card == CardExplodingKitten {
+			kittens = append(kittens, card)
+		} else {
+			others = append(others, card)
+		}
+	}
+
+	t.DrawPile = slices.Concat(kittens, others)
+
+	return nil
+}
+
+func (t *Game) applyCardMarked(data *CardMarked) error {
+	if t.MarkedCards == nil {
+		t.MarkedCards = make(map[uuid.UUID][]CardType)
+	}
+
+	targetID := data.GetTargetPlayerID()
+	t.MarkedCards[targetID] = append(slices.Clone(t.MarkedCards[targetID]), data.GetCard())
+
+	return nil
+}
+
+func (t *Game) applyPlayerCursed(data *PlayerCursed) error {
+	if !t.IsCursed(data.GetTargetPlayerID()) {
+		t.CursedPlayerIDs = append(slices.Clone(t.CursedPlayerIDs), data.GetTargetPlayerID())
+	}
+
+	return nil
+}
+
+func (t *Game) applyCardCollected(data *CardCollected) error {
+	if err := t.removeFromHand(data.GetPlayerID(), data.GetCard()); err != nil {
+		return err
+	}
+
+	t.DrawPile = append(slices.Clone(t.DrawPile), data.GetCard())
+	t.PendingInteraction = nil
+
+	return nil
+}
+
+func (t *Game) applyDrawPileShuffled(data *DrawPileShuffled) error {
+	if len(data.GetSeed()) != DeckSeedSize {
+		return ErrInvalidDeckSeed
+	}
+
+	t.DrawPile = slices.Clone(t.DrawPile)
+	shuffleCards(newDeckRand(data.GetSeed()), t.DrawPile)
+
+	return nil
+}
+
+func (t *Game) applyTurnTimedOut(data *TurnTimedOut) error {
+	if t.Timeouts == nil {
+		t.Timeouts = make(map[uuid.UUID]int)
+	}
+
+	playerID := data.GetPlayerID()
+	t.Timeouts[playerID] = data.GetTimeouts()
+	if data.GetAFK() && !t.IsAFK(playerID) {
+		t.AFKPlayerIDs = append(slices.Clone(t.AFKPlayerIDs), playerID)
+	}
+
+	return nil
+}
+
+func (t *Game) applyPlayerReturned(data *PlayerReturned) error {
+	playerID := data.GetPlayerID()
+	delete(t.Timeouts, playerID)
+	t.AFKPlayerIDs = slices.DeleteFunc(slices.Clone(t.AFKPlayerIDs), func(id uuid.UUID) bool {
+		return id == playerID
+	})
+
+	return nil
+}
+
+func (t *Game) applyPlayerDisconnected(data *PlayerDisconnected) error {
+	if t.Disconnected == nil {
+		t.Disconnected = make(map[uuid.UUID]time.Time)
+	}
+
+	t.Disconnected[data.GetPlayerID()] = data.GetDeadline()
+
+	return nil
+}
+
+func (t *Game) applyPlayerReconnected(data *PlayerReconnected) error {
+	delete(t.Disconnected, data.GetPlayerID())
+
+	return nil
+}
+
+func (t *Game) applyDefuseNoped(data *DefuseNoped) error {
+	if err := t.removeFromHand(data.GetPlayerID(), CardDefuse); err != nil {
+		return err
+	}
+
+	t.DiscardPile = append(t.DiscardPile, CardDefuse)
+
+	return nil
+}
+
+// reveal replaces the private information of a player.
+func (t *Game) reveal(playerID uuid.UUID, reveal *Reveal) {
+	if t.Reveals == nil {
+		t.Reveals = make(map[uuid.UUID]*Reveal)
+	}
+
+	t.Reveals[playerID] = reveal
+}
+
+// removeFromHand removes a single card of the given type from the hand of a player.
+func (t *Game) removeFromHand(playerID uuid.UUID, card CardType) error {
+	hand := t.Hands[playerID]
+
+	index := slices.Index(hand, card)
+	if index < 0 {
+		return ErrCardNotInHand
+	}
+
+	t.Hands[playerID] = slices.Delete(slices.Clone(hand), index, index+1)
+
+	// A marked card is no longer face up once it left the hand.
+	if marked := t.MarkedCards[playerID]; t.CountCard(playerID, card) < countCards(marked, card) {
+		index := slices.Index(marked, card)
+		t.MarkedCards[playerID] = slices.Delete(slices.Clone(marked), index, index+1)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package game
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// DefaultTurnTimeout is how long a player has to finish a turn when the game was created without a turn timeout.
+	DefaultTurnTimeout = 60 * time.Second
+	// DefaultAFKTimeouts is the number of consecutive timeouts after which a player is marked AFK
+	// when the game was created without one.
+	DefaultAFKTimeouts = 3
+	// DefuseTimeout is how long a player has to put a drawn kitten back in the draw pile.
+	DefuseTimeout = 15 * time.Second
+	// AFKTimeout is how long the game waits before playing for an AFK or disconnected player.
+	AFKTimeout = 2 * time.Second
+	// DefaultDisconnectGrace is how long a disconnected player has to reconnect before being eliminated
+	// when they were disconnected without a grace period.
+	DefaultDisconnectGrace = 60 * time.Second
+)
+
+// deadline returns when the player is played for, zero when the game has no turn timer.
+func deadline(state *Game, playerID uuid.UUID, timeout time.Duration) time.Time {
+	if state.TurnTimeout == 0 {
+		return time.Time{}
+	}
+
+	if state.IsAFK(playerID) || state.IsDisconnected(playerID) {
+		timeout = min(timeout, AFKTimeout)
+	}
+
+	return TimeNow().Add(timeout)
+}
+
+// turnDeadline returns the end of a turn of the player starting now.
+func turnDeadline(state *Game, playerID uuid.UUID) time.Time {
+	return deadline(state, playerID, state.TurnTimeout)
+}
+
+// defuseDeadline returns when a kitten drawn now by the player is put back for them.
+func defuseDeadline(state *Game, playerID uuid.UUID) time.Time {
+	return deadline(state, playerID, DefuseTimeout)
+}
+
+// expireTurn plays for the player who ran out of time: a drawn kitten is put back at a random
+// position, otherwise the player draws.
+func expireTurn(e *emitter) error {
+	state := e.state
+	playerID := state.CurrentPlayerID
+	if state.PendingDefuse != nil {
+		playerID = state.PendingDefuse.PlayerID
+	}
+
+	timeouts := state.Timeouts[playerID] + 1
+	if err := e.emit(EventTypeTurnTimedOut, &TurnTimedOut{
+		GameID:   state.GameID,
+		PlayerID: playerID,
+		Timeouts: timeouts,
+		AFK:      timeouts >= state.AFKTimeouts,
+	}); err != nil {
+		return err
+	}
+
+	// The draw pile is only ever empty while the kittens are held thanks to Streaking Kittens,
+	// the turn then ends without a draw.
+	if state.PendingDefuse == nil && len(state.DrawPile) == 0 {
+		return endTurn(e)
+	}
+
+	if state.PendingDefuse == nil {
+		return drawCard(e, playerID)
+	}
+
+	position, err := randomIndex(len(state.DrawPile) + 1)
+	if err != nil {
+		return err
+	}
+
+	if err := placeKitten(e, playerID, position); err != nil {
+		return err
+	}
+
+	return endTurn(e)
+}
+
+// returnPlayer resets the timeouts of a player acting on their own.
+func returnPlayer(e *emitter, playerID uuid.UUID) error {
+	if e.state.Timeouts[playerID] == 0 && !e.state.IsAFK(playerID) {
+		return nil
+	}
+
+	return e.emit(EventTypePlayerReturned, &PlayerReturned{
+		GameID:   e.state.GameID,
+		PlayerID: playerID,
+	})
+}
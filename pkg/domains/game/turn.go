@@ -0,0 +1,38 @@
+package game
+
+import "github.com/gofrs/uuid"
+
+// AttackTurns is the number of turns an Attack gives to the next player.
+const AttackTurns = 2
+
+// endTurn ends the current turn, either by moving to the next turn of the same
+// player when they still owe turns, or by passing a single turn to the next player.
+func endTurn(e *emitter) error {
+	state := e.state
+
+	if state.TurnsRemaining > 1 {
+		return e.emit(EventTypeTurnAdvanced, &TurnAdvanced{
+			GameID:         state.GameID,
+			PlayerID:       state.CurrentPlayerID,
+			TurnsRemaining: state.TurnsRemaining - 1,
+			Deadline:       turnDeadline(state, state.CurrentPlayerID),
+		})
+	}
+
+	return passTurns(e, 1)
+}
+
+// passTurns ends all turns of the current player and gives the next player the given number of turns.
+func passTurns(e *emitter, turns int) error {
+	return passTurnsTo(e, e.state.NextPlayerID(e.state.CurrentPlayerID), turns)
+}
+
+// passTurnsTo ends all turns of the current player and gives the given player the given number of turns.
+func passTurnsTo(e *emitter, playerID uuid.UUID, turns int) error {
+	return e.emit(EventTypeTurnAdvanced, &TurnAdvanced{
+		GameID:         e.state.GameID,
+		PlayerID:       playerID,
+		TurnsRemaining: turns,
+		Deadline:       turnDeadline(e.state, playerID),
+	})
+}
@@ -0,0 +1,180 @@
+package game
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// PlayerView is the state of a game as seen by a single player. It is the only
+// projection of a game that may leave the server: anything secret, such as the
+// deck seed, the order of the draw pile, the hands of other players or the
+// position of a defused kitten, must never be copied into it.
+type PlayerView struct {
+	GameID   uuid.UUID `json:"game_id"`
+	LobbyID  uuid.UUID `json:"lobby_id"`
+	ViewerID uuid.UUID `json:"viewer_id"`
+	// Expansions are the card sets added to the base deck.
+	Expansions []Expansion `json:"expansions"`
+	// Hand is the hand of the viewer, empty once the viewer is eliminated or while they are cursed.
+	Hand []CardType `json:"hand"`
+	// HandHidden is set while a Curse of the Cat Butt keeps the viewer from seeing their hand.
+	HandHidden      bool         `json:"hand_hidden"`
+	Players         []PlayerSeat `json:"players"`
+	DrawPileSize    int          `json:"draw_pile_size"`
+	DiscardPileSize int          `json:"discard_pile_size"`
+	DiscardTop      CardType     `json:"discard_top,omitempty"`
+	// ImplodingKittenPosition is the number of cards above the Imploding Kitten once it is face up, otherwise -1.
+	ImplodingKittenPosition int       `json:"imploding_kitten_position"`
+	Reversed                bool      `json:"reversed"`
+	CurrentPlayerID         uuid.UUID `json:"current_player_id"`
+	TurnsRemaining          int       `json:"turns_remaining"`
+	// TurnDeadline is when the current player, or the player putting a kitten back, is played for.
+	TurnDeadline   time.Time     `json:"turn_deadline"`
+	ReactionWindow time.Duration `json:"reaction_window"`
+	PendingAction  *ActionView   `json:"pending_action,omitempty"`
+	// PendingDefusePlayerID is the player who has to put a kitten back in the draw pile.
+	PendingDefusePlayerID uuid.UUID           `json:"pending_defuse_player_id"`
+	PendingInteraction    *PendingInteraction `json:"pending_interaction,omitempty"`
+	// Reveal is the private information of the viewer.
+	Reveal   *Reveal   `json:"reveal,omitempty"`
+	Finished bool      `json:"finished"`
+	WinnerID uuid.UUID `json:"winner_id"`
+}
+
+// PlayerSeat is the public information about a player of the game.
+type PlayerSeat struct {
+	PlayerID   uuid.UUID `json:"player_id"`
+	CardCount  int       `json:"card_count"`
+	Eliminated bool      `json:"eliminated"`
+	// MarkedCards are the cards of the hand turned face up by a Mark.
+	MarkedCards []CardType `json:"marked_cards"`
+	Cursed      bool       `json:"cursed"`
+	// AFK is set while the player is played for as soon as it is their turn.
+	AFK bool `json:"afk"`
+	// Bot is set when the player is played by the server.
+	Bot bool `json:"bot"`
+	// Disconnected is set while the player is played for until they reconnect or ReconnectDeadline passes.
+	Disconnected      bool      `json:"disconnected"`
+	ReconnectDeadline time.Time `json:"reconnect_deadline"`
+	// RevealedHand is the hand the player held when eliminated, when the rules reveal it.
+	RevealedHand []CardType `json:"revealed_hand,omitempty"`
+}
+
+// ActionView is the public information about a card or combo waiting for its reaction window to close.
+type ActionView struct {
+	PlayerID       uuid.UUID  `json:"player_id"`
+	Card           CardType   `json:"card,omitempty"`
+	Combo          ComboType  `json:"combo,omitempty"`
+	Cards          []CardType `json:"cards,omitempty"`
+	TargetPlayerID uuid.UUID  `json:"target_player_id"`
+	NamedCard      CardType   `json:"named_card,omitempty"`
+	NopeCount      int        `json:"nope_count"`
+	Deadline       time.Time  `json:"deadline"`
+}
+
+// NewPlayerView projects the state of a game for the given viewer.
+func NewPlayerView(state *Game, viewerID uuid.UUID) *PlayerView {
+	view := &PlayerView{
+		GameID:                  state.GameID,
+		LobbyID:                 state.LobbyID,
+		ViewerID:                viewerID,
+		Expansions:              slices.Clone(state.Expansions),
+		Hand:                    slices.Clone(state.Hands[viewerID]),
+		Players:                 make([]PlayerSeat, 0, len(state.PlayerIDs)),
+		DrawPileSize:            len(state.DrawPile),
+		DiscardPileSize:         len(state.DiscardPile),
+		ImplodingKittenPosition: -1,
+		Reversed:                state.Reversed,
+		CurrentPlayerID:         state.CurrentPlayerID,
+		TurnsRemaining:          state.TurnsRemaining,
+		TurnDeadline:            state.turnDeadline(),
+		ReactionWindow:          state.ReactionWindow,
+		Finished:                state.Finished,
+		WinnerID:                state.WinnerID,
+	}
+
+	// A cursed player still plays their cards, but only knows how many they hold.
+	if state.IsCursed(viewerID) {
+		view.Hand = nil
+		view.HandHidden = true
+	}
+
+	if view.Hand == nil {
+		view.Hand = []CardType{}
+	}
+
+	if view.Expansions == nil {
+		view.Expansions = []Expansion{}
+	}
+
+	for _, playerID := range state.PlayerIDs {
+		markedCards := slices.Clone(state.MarkedCards[playerID])
+		if markedCards == nil {
+			markedCards = []CardType{}
+		}
+
+		view.Players = append(view.Players, PlayerSeat{
+			PlayerID:          playerID,
+			CardCount:         len(state.Hands[playerID]),
+			Eliminated:        slices.Contains(state.EliminatedPlayerIDs, playerID),
+			MarkedCards:       markedCards,
+			Cursed:            state.IsCursed(playerID),
+			AFK:               state.IsAFK(playerID),
+			Bot:               state.IsBot(playerID),
+			Disconnected:      state.IsDisconnected(playerID),
+			ReconnectDeadline: state.Disconnected[playerID],
+			RevealedHand:      slices.Clone(state.RevealedHands[playerID]),
+		})
+	}
+
+	if len(state.DiscardPile) > 0 {
+		view.DiscardTop = state.DiscardPile[len(state.DiscardPile)-1]
+	}
+
+	// A face up Imploding Kitten can be seen by everyone, wherever it is in the draw pile.
+	if state.ImplodingKittenFaceUp {
+		view.ImplodingKittenPosition = slices.Index(state.DrawPile, CardImplodingKitten)
+	}
+
+	if pending := state.PendingAction; pending != nil {
+		view.PendingAction = &ActionView{
+			PlayerID:       pending.Play.PlayerID,
+			Card:           pending.Play.Card,
+			Combo:          pending.Play.Combo,
+			Cards:          slices.Clone(pending.Play.Cards),
+			TargetPlayerID: pending.Play.TargetPlayerID,
+			NamedCard:      pending.Play.NamedCard,
+			NopeCount:      pending.NopeCount,
+			Deadline:       pending.Deadline,
+		}
+	}
+
+	if state.PendingDefuse != nil {
+		view.PendingDefusePlayerID = state.PendingDefuse.PlayerID
+	}
+
+	if pending := state.PendingInteraction; pending != nil {
+		interaction := *pending
+		view.PendingInteraction = &interaction
+	}
+
+	if reveal := state.Reveals[viewerID]; reveal != nil {
+		view.Reveal = &Reveal{
+			Kind:            reveal.Kind,
+			Cards:           slices.Clone(reveal.Cards),
+			OtherPlayerID:   reveal.OtherPlayerID,
+			DrawPileSize:    reveal.DrawPileSize,
+			DiscardPileSize: reveal.DiscardPileSize,
+		}
+	}
+
+	return view
+}
+
+// NewSpectatorView projects the state of a game for a user who does not play it. Like the
+// seats of the players, it only holds what everyone at the table can see.
+func NewSpectatorView(state *Game) *PlayerView {
+	return NewPlayerView(state, uuid.Nil)
+}
@@ -3,13 +3,18 @@ package lobby
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
 
 	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/aggregate"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/password"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
 )
 
@@ -71,7 +76,22 @@ type Aggregate struct {
 	*aggregate.AggregateBase
 
 	currentLobbyID uuid.UUID
+	lobbyCode      string
 	actived        bool
+	ranked         bool
+	started        bool
+	gameFinished   bool
+	hostUserID     uuid.UUID
+	participants   []uuid.UUID
+	expansions     []game.Expansion
+	bots           map[uuid.UUID]game.BotDifficulty
+	gameID         uuid.UUID
+	rematchVotes   []uuid.UUID
+	rules          game.GameRules
+	maxPlayers     int
+	visibility     Visibility
+	passwordHash   string
+	spectatorDelay time.Duration
 }
 
 var _ eventing.Aggregate = (*Aggregate)(nil)
@@ -96,10 +116,124 @@ func (a *Aggregate) validateCommand(cmd eventing.Command) error {
 			return ErrLobbyInWaitingMode
 		}
 	case *JoinLobby:
+		if !a.actived {
+			return ErrLobbyNotAvailable
+		}
+
+		if a.started {
+			return ErrLobbyAlreadyStarted
+		}
+
+		if slices.Contains(a.participants, typed.UserID) {
+			return ErrAlreadyLobbyParticipant
+		}
+
+		if a.visibility != VisibilityPublic && typed.LobbyCode != a.lobbyCode {
+			return ErrLobbyNotPublic
+		}
+
+		if a.passwordHash != "" && !password.Check(typed.Password, a.passwordHash) {
+			return ErrInvalidLobbyPassword
+		}
+
+		if len(a.participants) >= Capacity(a.maxPlayers, a.expansions) {
+			return ErrLobbyFull
+		}
 	case *LeaveLobby:
 		if !a.actived {
 			return ErrLobbyNotAvailable
 		}
+
+		// The participants are free to go once the game is over, whether they voted for a rematch or not.
+		if a.started && !a.gameFinished {
+			return ErrLobbyAlreadyStarted
+		}
+	case *StartGame:
+		if !a.actived {
+			return ErrLobbyNotAvailable
+		}
+
+		if a.started {
+			return ErrLobbyAlreadyStarted
+		}
+
+		if a.hostUserID != typed.UserID {
+			return ErrNotLobbyHost
+		}
+
+		if len(a.participants) < MinParticipants || len(a.participants) > Capacity(a.maxPlayers, a.expansions) {
+			return ErrInvalidParticipantCount
+		}
+	case *AddBot:
+		if !a.actived {
+			return ErrLobbyNotAvailable
+		}
+
+		if a.started {
+			return ErrLobbyAlreadyStarted
+		}
+
+		if a.hostUserID != typed.UserID {
+			return ErrNotLobbyHost
+		}
+
+		if len(a.participants) >= Capacity(a.maxPlayers, a.expansions) {
+			return ErrLobbyFull
+		}
+	case *VoteRematch:
+		if !a.actived {
+			return ErrLobbyNotAvailable
+		}
+
+		if !a.started {
+			return ErrLobbyNotStarted
+		}
+
+		if !a.gameFinished {
+			return ErrLobbyGameNotFinished
+		}
+
+		if !slices.Contains(a.participants, typed.UserID) {
+			return ErrNotLobbyParticipant
+		}
+
+		if slices.Contains(a.rematchVotes, typed.UserID) {
+			return ErrRematchAlreadyVoted
+		}
+	case *UpdateSettings:
+		if !a.actived {
+			return ErrLobbyNotAvailable
+		}
+
+		if a.started {
+			return ErrLobbyAlreadyStarted
+		}
+
+		if a.hostUserID != typed.UserID {
+			return ErrNotLobbyHost
+		}
+
+		// The deck must be dealt whatever the number of participants the game starts with.
+		if err := typed.Rules.ValidateFor(Capacity(a.maxPlayers, a.expansions), a.expansions); err != nil {
+			return err
+		}
+	case *FinishGame:
+		if !a.actived {
+			return ErrLobbyNotAvailable
+		}
+
+		// The game may be reported more than once, and a lobby that moved on ignores its previous games.
+		if !a.started || a.gameFinished || a.gameID != typed.GameID {
+			return ErrLobbyNotPlaying
+		}
+	case *AbortStart:
+		if !a.actived {
+			return ErrLobbyNotAvailable
+		}
+
+		if !a.started || a.gameFinished || a.gameID != typed.GameID {
+			return ErrLobbyNotPlaying
+		}
 	default:
 		// All other events require the aggregate to be created.
 	}
@@ -111,11 +245,18 @@ func (a *Aggregate) createEvent(cmd eventing.Command) error {
 	switch cmd := cmd.(type) {
 	case *CreateLobby:
 		a.AppendEvent(EventTypeLobbyCreated, &LobbyCreated{
-			LobbyID:      cmd.LobbyID,
-			LobbyCode:    cmd.LobbyCode,
-			LobbyName:    cmd.LobbyName,
-			HostUserID:   cmd.HostUserID,
-			Participants: []uuid.UUID{},
+			LobbyID:         cmd.LobbyID,
+			LobbyCode:       cmd.LobbyCode,
+			LobbyName:       cmd.LobbyName,
+			HostUserID:      cmd.HostUserID,
+			Participants:    []uuid.UUID{},
+			Expansions:      cmd.Expansions,
+			AllowSpectators: cmd.AllowSpectators,
+			SpectatorDelay:  cmd.SpectatorDelay,
+			Ranked:          cmd.Ranked,
+			MaxPlayers:      cmd.MaxPlayers,
+			Visibility:      cmd.Visibility,
+			PasswordHash:    cmd.PasswordHash,
 		}, TimeNow())
 	case *JoinLobby:
 		a.AppendEvent(EventTypeLobbyJoined, &LobbyJoined{
@@ -123,11 +264,77 @@ func (a *Aggregate) createEvent(cmd eventing.Command) error {
 			UserID:  cmd.UserID,
 		}, TimeNow())
 	case *LeaveLobby:
-		a.AppendEvent(EventTypeLobbyLeft, &LobbyLeft{
+		participants := lo.Without(a.participants, cmd.UserID)
+
+		left := &LobbyLeft{
+			LobbyID: cmd.LobbyID,
+			UserID:  cmd.UserID,
+		}
+		if cmd.UserID == a.hostUserID {
+			// The host role goes to the player who joined first, the bots cannot start a game.
+			left.HostUserID, _ = lo.Find(participants, func(participant uuid.UUID) bool {
+				_, bot := a.bots[participant]
+				return !bot
+			})
+		}
+		a.AppendEvent(EventTypeLobbyLeft, left, TimeNow())
+
+		// The vote is only waiting for the participants who stayed, the last of them to leave may settle it.
+		if a.gameFinished && a.rematchAgreed(participants, lo.Without(a.rematchVotes, cmd.UserID)) {
+			a.AppendEvent(EventTypeLobbyReopened, &LobbyReopened{
+				LobbyID:        cmd.LobbyID,
+				PreviousGameID: a.gameID,
+				Participants:   participants,
+			}, TimeNow())
+		}
+	case *StartGame:
+		a.AppendEvent(EventTypeLobbyStarted, &LobbyStarted{
+			LobbyID:        cmd.LobbyID,
+			GameID:         cmd.GameID,
+			Participants:   a.participants,
+			Bots:           a.bots,
+			Expansions:     a.expansions,
+			Ranked:         a.ranked,
+			Rules:          a.rules,
+			SpectatorDelay: a.spectatorDelay,
+		}, TimeNow())
+	case *AddBot:
+		a.AppendEvent(EventTypeLobbyBotAdded, &LobbyBotAdded{
+			LobbyID:    cmd.LobbyID,
+			BotID:      cmd.BotID,
+			Difficulty: cmd.Difficulty,
+		}, TimeNow())
+	case *VoteRematch:
+		a.AppendEvent(EventTypeLobbyRematchVoted, &LobbyRematchVoted{
 			LobbyID: cmd.LobbyID,
 			UserID:  cmd.UserID,
 		}, TimeNow())
 
+		if !a.rematchAgreed(a.participants, append(slices.Clone(a.rematchVotes), cmd.UserID)) {
+			return nil
+		}
+
+		a.AppendEvent(EventTypeLobbyReopened, &LobbyReopened{
+			LobbyID:        cmd.LobbyID,
+			PreviousGameID: a.gameID,
+			Participants:   a.participants,
+		}, TimeNow())
+	case *UpdateSettings:
+		a.AppendEvent(EventTypeLobbySettingsUpdated, &LobbySettingsUpdated{
+			LobbyID: cmd.LobbyID,
+			Rules:   cmd.Rules,
+		}, TimeNow())
+	case *FinishGame:
+		a.AppendEvent(EventTypeLobbyGameFinished, &LobbyGameFinished{
+			LobbyID: cmd.LobbyID,
+			GameID:  cmd.GameID,
+		}, TimeNow())
+	case *AbortStart:
+		a.AppendEvent(EventTypeLobbyStartAborted, &LobbyStartAborted{
+			LobbyID: cmd.LobbyID,
+			GameID:  cmd.GameID,
+		}, TimeNow())
+
 	default:
 		return fmt.Errorf("could not handle command: %s", cmd.CommandType())
 	}
@@ -135,6 +342,24 @@ func (a *Aggregate) createEvent(cmd eventing.Command) error {
 	return nil
 }
 
+// rematchAgreed reports whether every participant asked for a rematch, bots always agree but at least
+// one player must have voted.
+func (a *Aggregate) rematchAgreed(participants []uuid.UUID, votes []uuid.UUID) bool {
+	players := 0
+	for _, participant := range participants {
+		if _, bot := a.bots[participant]; bot {
+			continue
+		}
+
+		if !slices.Contains(votes, participant) {
+			return false
+		}
+		players++
+	}
+
+	return players > 0
+}
+
 // HandleCommand implements the HandleCommand method of the
 // eventing.CommandHandler interface.
 func (a *Aggregate) HandleCommand(ctx context.Context, cmd eventing.Command) error {
@@ -160,11 +385,81 @@ func (a *Aggregate) ApplyEvent(ctx context.Context, event common.Event) error {
 		}
 
 		a.currentLobbyID = data.LobbyID
+		a.lobbyCode = data.GetLobbyCode()
 		a.actived = true
+		a.hostUserID = data.HostUserID
+		a.participants = []uuid.UUID{data.HostUserID}
+		a.expansions = data.Expansions
+		a.ranked = data.GetRanked()
+		a.maxPlayers = data.GetMaxPlayers()
+		a.visibility = data.GetVisibility()
+		a.passwordHash = data.GetPasswordHash()
+		a.spectatorDelay = data.GetSpectatorDelay()
 
 	case EventTypeLobbyJoined:
+		data, ok := event.Data().(*LobbyJoined)
+		if !ok {
+			return fmt.Errorf("could not apply event: %s", event.EventType())
+		}
+
+		a.participants = append(a.participants, data.UserID)
 	case EventTypeLobbyLeft:
+		data, ok := event.Data().(*LobbyLeft)
+		if !ok {
+			return fmt.Errorf("could not apply event: %s", event.EventType())
+		}
+
 		a.actived = true
+		if data.UserID == a.hostUserID {
+			a.hostUserID = data.GetHostUserID()
+			a.actived = !a.hostUserID.IsNil()
+		}
+		a.participants = lo.Without(a.participants, data.UserID)
+		a.rematchVotes = lo.Without(a.rematchVotes, data.UserID)
+		delete(a.bots, data.UserID)
+	case EventTypeLobbyStarted:
+		data, ok := event.Data().(*LobbyStarted)
+		if !ok {
+			return fmt.Errorf("could not apply event: %s", event.EventType())
+		}
+
+		a.started = true
+		a.gameID = data.GameID
+	case EventTypeLobbyBotAdded:
+		data, ok := event.Data().(*LobbyBotAdded)
+		if !ok {
+			return fmt.Errorf("could not apply event: %s", event.EventType())
+		}
+
+		if a.bots == nil {
+			a.bots = make(map[uuid.UUID]game.BotDifficulty)
+		}
+		a.participants = append(a.participants, data.BotID)
+		a.bots[data.BotID] = data.Difficulty
+	case EventTypeLobbyRematchVoted:
+		data, ok := event.Data().(*LobbyRematchVoted)
+		if !ok {
+			return fmt.Errorf("could not apply event: %s", event.EventType())
+		}
+
+		a.rematchVotes = append(a.rematchVotes, data.UserID)
+	case EventTypeLobbyReopened:
+		a.started = false
+		a.gameFinished = false
+		a.gameID = uuid.Nil
+		a.rematchVotes = nil
+	case EventTypeLobbySettingsUpdated:
+		data, ok := event.Data().(*LobbySettingsUpdated)
+		if !ok {
+			return fmt.Errorf("could not apply event: %s", event.EventType())
+		}
+
+		a.rules = data.Rules
+	case EventTypeLobbyGameFinished:
+		a.gameFinished = true
+	case EventTypeLobbyStartAborted:
+		a.started = false
+		a.gameID = uuid.Nil
 
 	default:
 		return errors.WithStack(fmt.Errorf("could not apply event: %s", event.EventType()))
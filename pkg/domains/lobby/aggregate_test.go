@@ -0,0 +1,262 @@
+package lobby
+
+import (
+	"context"
+	goTesting "testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/password"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+)
+
+const lobbyCode = "KITTEN"
+
+type AggregateSuite struct {
+	*testing.Suite
+	ctx     context.Context
+	agg     *Aggregate
+	lobbyID uuid.UUID
+	hostID  uuid.UUID
+}
+
+func TestAggregateSuite(t *goTesting.T) {
+	as := &AggregateSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, as)
+}
+
+func (as *AggregateSuite) SetupTest() {
+	as.ctx = context.Background()
+	as.lobbyID = uuid.Must(uuid.NewV7())
+	as.hostID = uuid.Must(uuid.NewV7())
+}
+
+// handle runs a command and applies the resulting events, like the aggregate store does on save.
+func (as *AggregateSuite) handle(cmd eventing.Command) error {
+	if err := as.agg.HandleCommand(as.ctx, cmd); err != nil {
+		return err
+	}
+
+	for _, event := range as.agg.UncommittedEvents() {
+		as.NoError(as.agg.ApplyEvent(as.ctx, event))
+	}
+	as.agg.ClearUncommittedEvents()
+
+	return nil
+}
+
+// create opens the lobby of the suite with the given settings.
+func (as *AggregateSuite) create(cmd *CreateLobby) {
+	as.agg = &Aggregate{}
+	as.agg.OnCreate(as.lobbyID.String())
+
+	cmd.LobbyID = as.lobbyID
+	cmd.LobbyCode = lobbyCode
+	cmd.LobbyName = "Kittens"
+	cmd.HostUserID = as.hostID
+	as.NoError(cmd.Validate())
+	as.NoError(as.handle(cmd))
+}
+
+func (as *AggregateSuite) join(code string, password string) error {
+	return as.handle(&JoinLobby{
+		LobbyID:   as.lobbyID,
+		UserID:    uuid.Must(uuid.NewV7()),
+		LobbyCode: code,
+		Password:  password,
+	})
+}
+
+func (as *AggregateSuite) Test_JoinLobby_Capacity() {
+	as.create(&CreateLobby{MaxPlayers: 3})
+
+	as.NoError(as.join("", ""))
+	as.NoError(as.join(lobbyCode, ""))
+	as.ErrorIs(as.join("", ""), ErrLobbyFull)
+	as.ErrorIs(as.handle(&AddBot{LobbyID: as.lobbyID, UserID: as.hostID, BotID: uuid.Must(uuid.NewV7()), Difficulty: game.BotEasy}), ErrLobbyFull)
+	as.ErrorIs(as.handle(&JoinLobby{LobbyID: as.lobbyID, UserID: as.hostID}), ErrAlreadyLobbyParticipant)
+}
+
+func (as *AggregateSuite) Test_JoinLobby_Visibility() {
+	as.create(&CreateLobby{Visibility: VisibilityUnlisted})
+
+	as.ErrorIs(as.join("", ""), ErrLobbyNotPublic)
+	as.ErrorIs(as.join("OTHER", ""), ErrLobbyNotPublic)
+	as.NoError(as.join(lobbyCode, ""))
+}
+
+func (as *AggregateSuite) Test_JoinLobby_Password() {
+	passwordHash, err := password.Hash("meow")
+	as.NoError(err)
+	as.NotContains(passwordHash, "meow")
+
+	as.create(&CreateLobby{Visibility: VisibilityPrivate, PasswordHash: passwordHash})
+
+	as.ErrorIs(as.join(lobbyCode, ""), ErrInvalidLobbyPassword)
+	as.ErrorIs(as.join(lobbyCode, "woof"), ErrInvalidLobbyPassword)
+	as.ErrorIs(as.join("", "meow"), ErrLobbyNotPublic)
+	as.NoError(as.join(lobbyCode, "meow"))
+}
+
+func (as *AggregateSuite) Test_CreateLobby_InvalidSettings() {
+	var fieldErr *common.CommandFieldError
+
+	cmd := &CreateLobby{LobbyID: as.lobbyID, LobbyCode: lobbyCode, LobbyName: "Kittens", HostUserID: as.hostID}
+
+	cmd.MaxPlayers = MaxParticipants + 1
+	as.ErrorAs(cmd.Validate(), &fieldErr)
+	as.Equal("max_players", fieldErr.Field)
+
+	cmd.MaxPlayers, cmd.Visibility = 0, "SECRET"
+	as.ErrorAs(cmd.Validate(), &fieldErr)
+	as.Equal("visibility", fieldErr.Field)
+
+	cmd.Visibility = VisibilityPrivate
+	as.ErrorAs(cmd.Validate(), &fieldErr)
+	as.Equal("password", fieldErr.Field)
+
+	cmd.Visibility, cmd.SpectatorDelay = VisibilityPublic, MaxSpectatorDelay+time.Second
+	as.ErrorAs(cmd.Validate(), &fieldErr)
+	as.Equal("spectator_delay", fieldErr.Field)
+}
+
+func (as *AggregateSuite) Test_StartGame_CarriesTheSpectatorDelay() {
+	as.create(&CreateLobby{AllowSpectators: true, SpectatorDelay: 30 * time.Second})
+	as.NoError(as.handle(&JoinLobby{LobbyID: as.lobbyID, UserID: uuid.Must(uuid.NewV7())}))
+
+	as.NoError(as.agg.HandleCommand(as.ctx, &StartGame{LobbyID: as.lobbyID, UserID: as.hostID, GameID: uuid.Must(uuid.NewV7())}))
+	events := as.agg.UncommittedEvents()
+	as.Len(events, 1)
+
+	started, ok := events[0].Data().(*LobbyStarted)
+	as.True(ok)
+	as.Equal(30*time.Second, started.GetSpectatorDelay())
+}
+
+// play starts the game of the lobby, with the given participants joining first.
+func (as *AggregateSuite) play(participants ...uuid.UUID) uuid.UUID {
+	for _, participant := range participants {
+		as.NoError(as.handle(&JoinLobby{LobbyID: as.lobbyID, UserID: participant}))
+	}
+
+	gameID := uuid.Must(uuid.NewV7())
+	as.NoError(as.handle(&StartGame{LobbyID: as.lobbyID, UserID: as.hostID, GameID: gameID}))
+
+	return gameID
+}
+
+func (as *AggregateSuite) Test_AbortStart_WaitsForTheHostAgain() {
+	as.create(&CreateLobby{})
+	gameID := as.play(uuid.Must(uuid.NewV7()))
+
+	as.ErrorIs(as.handle(&AbortStart{LobbyID: as.lobbyID, GameID: uuid.Must(uuid.NewV7())}), ErrLobbyNotPlaying)
+	as.NoError(as.handle(&AbortStart{LobbyID: as.lobbyID, GameID: gameID}))
+	as.False(as.agg.started)
+	as.Equal(uuid.Nil, as.agg.gameID)
+	as.ErrorIs(as.handle(&AbortStart{LobbyID: as.lobbyID, GameID: gameID}), ErrLobbyNotPlaying)
+
+	as.NoError(as.handle(&StartGame{LobbyID: as.lobbyID, UserID: as.hostID, GameID: uuid.Must(uuid.NewV7())}))
+}
+
+func (as *AggregateSuite) Test_LeaveLobby_HandsTheHostOver() {
+	as.create(&CreateLobby{})
+	botID, playerID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	as.NoError(as.handle(&AddBot{LobbyID: as.lobbyID, UserID: as.hostID, BotID: botID, Difficulty: game.BotEasy}))
+	as.NoError(as.handle(&JoinLobby{LobbyID: as.lobbyID, UserID: playerID}))
+
+	// The bot joined first, but only a player can start the game.
+	as.NoError(as.handle(&LeaveLobby{LobbyID: as.lobbyID, UserID: as.hostID}))
+	as.Equal(playerID, as.agg.hostUserID)
+	as.ErrorIs(as.handle(&StartGame{LobbyID: as.lobbyID, UserID: as.hostID, GameID: uuid.Must(uuid.NewV7())}), ErrNotLobbyHost)
+	as.NoError(as.handle(&StartGame{LobbyID: as.lobbyID, UserID: playerID, GameID: uuid.Must(uuid.NewV7())}))
+}
+
+func (as *AggregateSuite) Test_LeaveLobby_ClosesTheLobby() {
+	as.create(&CreateLobby{})
+	as.NoError(as.handle(&AddBot{LobbyID: as.lobbyID, UserID: as.hostID, BotID: uuid.Must(uuid.NewV7()), Difficulty: game.BotEasy}))
+
+	// Nobody is left to start the game.
+	as.NoError(as.handle(&LeaveLobby{LobbyID: as.lobbyID, UserID: as.hostID}))
+	as.Equal(uuid.Nil, as.agg.hostUserID)
+	as.ErrorIs(as.handle(&LeaveLobby{LobbyID: as.lobbyID, UserID: as.hostID}), ErrLobbyNotAvailable)
+}
+
+func (as *AggregateSuite) Test_StartGame_CarriesTheGameSettings() {
+	expansions := []game.Expansion{game.ExpansionImplodingKittens}
+	as.create(&CreateLobby{Expansions: expansions, Ranked: true})
+	as.NoError(as.handle(&UpdateSettings{LobbyID: as.lobbyID, UserID: as.hostID, Rules: game.GameRules{Defuses: 2}}))
+	botID := uuid.Must(uuid.NewV7())
+	as.NoError(as.handle(&AddBot{LobbyID: as.lobbyID, UserID: as.hostID, BotID: botID, Difficulty: game.BotHard}))
+
+	gameID := uuid.Must(uuid.NewV7())
+	as.NoError(as.agg.HandleCommand(as.ctx, &StartGame{LobbyID: as.lobbyID, UserID: as.hostID, GameID: gameID}))
+	events := as.agg.UncommittedEvents()
+	as.Len(events, 1)
+
+	started, ok := events[0].Data().(*LobbyStarted)
+	as.True(ok)
+	as.Equal(gameID, started.GetGameID())
+	as.Equal([]uuid.UUID{as.hostID, botID}, started.GetParticipants())
+	as.Equal(map[uuid.UUID]game.BotDifficulty{botID: game.BotHard}, started.GetBots())
+	as.Equal(expansions, started.GetExpansions())
+	as.True(started.GetRanked())
+	as.Equal(2, started.GetRules().Defuses)
+}
+
+func (as *AggregateSuite) Test_VoteRematch_WaitsForTheGame() {
+	as.create(&CreateLobby{})
+	playerID := uuid.Must(uuid.NewV7())
+	gameID := as.play(playerID)
+
+	as.ErrorIs(as.handle(&VoteRematch{LobbyID: as.lobbyID, UserID: as.hostID}), ErrLobbyGameNotFinished)
+	as.ErrorIs(as.handle(&LeaveLobby{LobbyID: as.lobbyID, UserID: playerID}), ErrLobbyAlreadyStarted)
+	as.ErrorIs(as.handle(&FinishGame{LobbyID: as.lobbyID, GameID: uuid.Must(uuid.NewV7())}), ErrLobbyNotPlaying)
+
+	as.NoError(as.handle(&FinishGame{LobbyID: as.lobbyID, GameID: gameID}))
+	as.ErrorIs(as.handle(&FinishGame{LobbyID: as.lobbyID, GameID: gameID}), ErrLobbyNotPlaying)
+
+	as.NoError(as.handle(&VoteRematch{LobbyID: as.lobbyID, UserID: as.hostID}))
+	as.True(as.agg.started)
+	as.NoError(as.handle(&VoteRematch{LobbyID: as.lobbyID, UserID: playerID}))
+	as.False(as.agg.started)
+	as.False(as.agg.gameFinished)
+}
+
+func (as *AggregateSuite) Test_LeaveLobby_SettlesTheRematchVote() {
+	as.create(&CreateLobby{})
+	voterID, leaverID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	gameID := as.play(voterID, leaverID)
+	as.NoError(as.handle(&FinishGame{LobbyID: as.lobbyID, GameID: gameID}))
+
+	as.NoError(as.handle(&VoteRematch{LobbyID: as.lobbyID, UserID: as.hostID}))
+	as.NoError(as.handle(&VoteRematch{LobbyID: as.lobbyID, UserID: voterID}))
+	as.True(as.agg.started)
+
+	// The last participant who did not vote leaves, everyone left agreed to the rematch.
+	as.NoError(as.handle(&LeaveLobby{LobbyID: as.lobbyID, UserID: leaverID}))
+	as.False(as.agg.started)
+	as.Equal([]uuid.UUID{as.hostID, voterID}, as.agg.participants)
+}
+
+func (as *AggregateSuite) Test_LeaveLobby_DropsTheVote() {
+	as.create(&CreateLobby{})
+	playerID := uuid.Must(uuid.NewV7())
+	gameID := as.play(playerID)
+	as.NoError(as.handle(&FinishGame{LobbyID: as.lobbyID, GameID: gameID}))
+
+	as.NoError(as.handle(&VoteRematch{LobbyID: as.lobbyID, UserID: playerID}))
+	as.NoError(as.handle(&LeaveLobby{LobbyID: as.lobbyID, UserID: playerID}))
+	as.True(as.agg.started)
+	as.Empty(as.agg.rematchVotes)
+
+	as.NoError(as.handle(&VoteRematch{LobbyID: as.lobbyID, UserID: as.hostID}))
+	as.False(as.agg.started)
+}
@@ -1,10 +1,13 @@
 package lobby
 
 import (
+	"time"
+
 	"github.com/gofrs/uuid"
 
 	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/stringsutil"
 )
 
@@ -12,30 +15,67 @@ func init() {
 	eventing.RegisterCommand[CreateLobby, *CreateLobby]()
 	eventing.RegisterCommand[JoinLobby, *JoinLobby]()
 	eventing.RegisterCommand[LeaveLobby, *LeaveLobby]()
+	eventing.RegisterCommand[StartGame, *StartGame]()
+	eventing.RegisterCommand[AddBot, *AddBot]()
+	eventing.RegisterCommand[VoteRematch, *VoteRematch]()
+	eventing.RegisterCommand[UpdateSettings, *UpdateSettings]()
+	eventing.RegisterCommand[FinishGame, *FinishGame]()
+	eventing.RegisterCommand[AbortStart, *AbortStart]()
 }
 
 const (
-	CreateLobbyCommand = common.CommandType("lobby:create")
-	JoinLobbyCommand   = common.CommandType("lobby:join")
-	LeaveLobbyCommand  = common.CommandType("lobby:leave")
+	CreateLobbyCommand    = common.CommandType("lobby:create")
+	JoinLobbyCommand      = common.CommandType("lobby:join")
+	LeaveLobbyCommand     = common.CommandType("lobby:leave")
+	StartGameCommand      = common.CommandType("lobby:start")
+	AddBotCommand         = common.CommandType("lobby:add_bot")
+	VoteRematchCommand    = common.CommandType("lobby:vote_rematch")
+	UpdateSettingsCommand = common.CommandType("lobby:update_settings")
+	FinishGameCommand     = common.CommandType("lobby:finish_game")
+	AbortStartCommand     = common.CommandType("lobby:abort_start")
 )
 
 var AllCommands = []common.CommandType{
 	CreateLobbyCommand,
 	JoinLobbyCommand,
 	LeaveLobbyCommand,
+	StartGameCommand,
+	AddBotCommand,
+	VoteRematchCommand,
+	UpdateSettingsCommand,
+	FinishGameCommand,
+	AbortStartCommand,
 }
 
 // Static type check that the eventing.Command interface is implemented.
 var _ = eventing.Command(&CreateLobby{})
 var _ = eventing.Command(&JoinLobby{})
 var _ = eventing.Command(&LeaveLobby{})
+var _ = eventing.Command(&StartGame{})
+var _ = eventing.Command(&AddBot{})
+var _ = eventing.Command(&VoteRematch{})
+var _ = eventing.Command(&UpdateSettings{})
+var _ = eventing.Command(&FinishGame{})
+var _ = eventing.Command(&AbortStart{})
 
 type CreateLobby struct {
 	LobbyID    uuid.UUID `json:"lobby_id"`
 	LobbyCode  string    `json:"lobby_code"`
 	LobbyName  string    `json:"lobby_name"`
 	HostUserID uuid.UUID `json:"host_user_id"`
+	// Expansions are the card sets the game of the lobby is played with.
+	Expansions []game.Expansion `json:"expansions,omitempty"`
+	// AllowSpectators lets users who are not in the game follow it.
+	AllowSpectators bool `json:"allow_spectators,omitempty"`
+	// SpectatorDelay is how long the game is held back from the spectators, so they cannot help the players.
+	SpectatorDelay time.Duration `json:"spectator_delay,omitempty"`
+	// Ranked games change the ratings of the players, unless a bot takes part.
+	Ranked bool `json:"ranked,omitempty"`
+	// MaxPlayers caps the number of participants, zero lets the expansions decide.
+	MaxPlayers int        `json:"max_players,omitempty"`
+	Visibility Visibility `json:"visibility,omitempty"`
+	// PasswordHash is the hash of the join password, made with the user hashing helpers.
+	PasswordHash string `json:"password_hash,omitempty"`
 }
 
 func (c *CreateLobby) AggregateType() common.AggregateType { return AggregateType }
@@ -61,12 +101,38 @@ func (c *CreateLobby) Validate() error {
 		return &common.CommandFieldError{Field: "host_user_id", Details: "empty field"}
 	}
 
+	for _, expansion := range c.Expansions {
+		if !expansion.IsValid() {
+			return &common.CommandFieldError{Field: "expansions", Details: "unknown expansion"}
+		}
+	}
+
+	if c.SpectatorDelay < 0 || c.SpectatorDelay > MaxSpectatorDelay {
+		return &common.CommandFieldError{Field: "spectator_delay", Details: "invalid duration"}
+	}
+
+	if c.MaxPlayers != 0 && (c.MaxPlayers < MinParticipants || c.MaxPlayers > MaxParticipantsFor(c.Expansions)) {
+		return &common.CommandFieldError{Field: "max_players", Details: "invalid number of players"}
+	}
+
+	if c.Visibility != "" && !c.Visibility.IsValid() {
+		return &common.CommandFieldError{Field: "visibility", Details: "unknown visibility"}
+	}
+
+	if c.Visibility == VisibilityPrivate && stringsutil.IsBlank(c.PasswordHash) {
+		return &common.CommandFieldError{Field: "password", Details: "private lobbies need a password"}
+	}
+
 	return nil
 }
 
 type JoinLobby struct {
 	LobbyID uuid.UUID `json:"lobby_id"`
 	UserID  uuid.UUID `json:"user_id"`
+	// LobbyCode is the code the user joined with, empty when the lobby was joined by its id.
+	LobbyCode string `json:"lobby_code,omitempty"`
+	// Password is checked against the hash of the lobby, it is never stored.
+	Password string `json:"password,omitempty"`
 }
 
 func (c *JoinLobby) AggregateType() common.AggregateType { return AggregateType }
@@ -109,3 +175,164 @@ func (c *LeaveLobby) Validate() error {
 
 	return nil
 }
+
+type StartGame struct {
+	LobbyID uuid.UUID `json:"lobby_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	GameID  uuid.UUID `json:"game_id"`
+}
+
+func (c *StartGame) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *StartGame) AggregateID() string { return c.LobbyID.String() }
+
+func (c *StartGame) CommandType() common.CommandType { return StartGameCommand }
+
+func (c *StartGame) Validate() error {
+	if c.LobbyID == uuid.Nil {
+		return &common.CommandFieldError{Field: "lobby_id", Details: "empty field"}
+	}
+
+	if c.UserID == uuid.Nil {
+		return &common.CommandFieldError{Field: "user_id", Details: "empty field"}
+	}
+
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// AddBot seats a bot played by the server in the lobby, only the host can add bots.
+type AddBot struct {
+	LobbyID    uuid.UUID          `json:"lobby_id"`
+	UserID     uuid.UUID          `json:"user_id"`
+	BotID      uuid.UUID          `json:"bot_id"`
+	Difficulty game.BotDifficulty `json:"difficulty"`
+}
+
+func (c *AddBot) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *AddBot) AggregateID() string { return c.LobbyID.String() }
+
+func (c *AddBot) CommandType() common.CommandType { return AddBotCommand }
+
+func (c *AddBot) Validate() error {
+	if c.LobbyID == uuid.Nil {
+		return &common.CommandFieldError{Field: "lobby_id", Details: "empty field"}
+	}
+
+	if c.UserID == uuid.Nil {
+		return &common.CommandFieldError{Field: "user_id", Details: "empty field"}
+	}
+
+	if c.BotID == uuid.Nil {
+		return &common.CommandFieldError{Field: "bot_id", Details: "empty field"}
+	}
+
+	if !c.Difficulty.IsValid() {
+		return &common.CommandFieldError{Field: "difficulty", Details: "unknown difficulty"}
+	}
+
+	return nil
+}
+
+// VoteRematch asks for another game with the same participants once the game of the lobby is over.
+type VoteRematch struct {
+	LobbyID uuid.UUID `json:"lobby_id"`
+	UserID  uuid.UUID `json:"user_id"`
+}
+
+func (c *VoteRematch) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *VoteRematch) AggregateID() string { return c.LobbyID.String() }
+
+func (c *VoteRematch) CommandType() common.CommandType { return VoteRematchCommand }
+
+func (c *VoteRematch) Validate() error {
+	if c.LobbyID == uuid.Nil {
+		return &common.CommandFieldError{Field: "lobby_id", Details: "empty field"}
+	}
+
+	if c.UserID == uuid.Nil {
+		return &common.CommandFieldError{Field: "user_id", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// UpdateSettings replaces the house rules of the game of the lobby, only the host can change them
+// before the game starts.
+type UpdateSettings struct {
+	LobbyID uuid.UUID      `json:"lobby_id"`
+	UserID  uuid.UUID      `json:"user_id"`
+	Rules   game.GameRules `json:"rules"`
+}
+
+func (c *UpdateSettings) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *UpdateSettings) AggregateID() string { return c.LobbyID.String() }
+
+func (c *UpdateSettings) CommandType() common.CommandType { return UpdateSettingsCommand }
+
+func (c *UpdateSettings) Validate() error {
+	if c.LobbyID == uuid.Nil {
+		return &common.CommandFieldError{Field: "lobby_id", Details: "empty field"}
+	}
+
+	if c.UserID == uuid.Nil {
+		return &common.CommandFieldError{Field: "user_id", Details: "empty field"}
+	}
+
+	return c.Rules.Validate()
+}
+
+// FinishGame records the end of the game of the lobby, it is issued by the lobby server once the game
+// is finished so the participants can vote for a rematch or leave.
+type FinishGame struct {
+	LobbyID uuid.UUID `json:"lobby_id"`
+	GameID  uuid.UUID `json:"game_id"`
+}
+
+func (c *FinishGame) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *FinishGame) AggregateID() string { return c.LobbyID.String() }
+
+func (c *FinishGame) CommandType() common.CommandType { return FinishGameCommand }
+
+func (c *FinishGame) Validate() error {
+	if c.LobbyID == uuid.Nil {
+		return &common.CommandFieldError{Field: "lobby_id", Details: "empty field"}
+	}
+
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	return nil
+}
+
+// AbortStart puts the lobby back in waiting mode when its game could not be created after the lobby was started.
+type AbortStart struct {
+	LobbyID uuid.UUID `json:"lobby_id"`
+	GameID  uuid.UUID `json:"game_id"`
+}
+
+func (c *AbortStart) AggregateType() common.AggregateType { return AggregateType }
+
+func (c *AbortStart) AggregateID() string { return c.LobbyID.String() }
+
+func (c *AbortStart) CommandType() common.CommandType { return AbortStartCommand }
+
+func (c *AbortStart) Validate() error {
+	if c.LobbyID == uuid.Nil {
+		return &common.CommandFieldError{Field: "lobby_id", Details: "empty field"}
+	}
+
+	if c.GameID == uuid.Nil {
+		return &common.CommandFieldError{Field: "game_id", Details: "empty field"}
+	}
+
+	return nil
+}
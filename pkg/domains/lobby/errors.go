@@ -3,7 +3,19 @@ package lobby
 import "github.com/cockroachdb/errors"
 
 var (
-	ErrLobbyAlreadyCreated = errors.New("lobby already created")
-	ErrLobbyInWaitingMode  = errors.New("lobby is in wating mode")
-	ErrLobbyNotAvailable   = errors.New("lobby is not available")
+	ErrLobbyAlreadyCreated     = errors.New("lobby already created")
+	ErrLobbyInWaitingMode      = errors.New("lobby is in wating mode")
+	ErrLobbyNotAvailable       = errors.New("lobby is not available")
+	ErrLobbyAlreadyStarted     = errors.New("lobby already started")
+	ErrNotLobbyHost            = errors.New("user is not the lobby host")
+	ErrInvalidParticipantCount = errors.New("invalid number of participants")
+	ErrLobbyFull               = errors.New("lobby is full")
+	ErrLobbyNotStarted         = errors.New("lobby is not started")
+	ErrNotLobbyParticipant     = errors.New("user is not a participant of the lobby")
+	ErrRematchAlreadyVoted     = errors.New("user already voted for a rematch")
+	ErrAlreadyLobbyParticipant = errors.New("user is already a participant of the lobby")
+	ErrLobbyNotPublic          = errors.New("lobby can only be joined with its code")
+	ErrInvalidLobbyPassword    = errors.New("invalid lobby password")
+	ErrLobbyGameNotFinished    = errors.New("game of the lobby is not finished")
+	ErrLobbyNotPlaying         = errors.New("lobby is not playing the game")
 )
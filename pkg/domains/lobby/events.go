@@ -1,10 +1,13 @@
 package lobby
 
 import (
+	"time"
+
 	"github.com/gofrs/uuid"
 
 	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
 )
 
 // registerEvents registers the event types for the lobby domain
@@ -18,6 +21,13 @@ func registerEvents(subjFunc eventing.SubjectFunc, subjRootFunc eventing.Subject
 	eventing.RegisterEventData[LobbyCreated](EventTypeLobbyCreated, args...)
 	eventing.RegisterEventData[LobbyJoined](EventTypeLobbyJoined, args...)
 	eventing.RegisterEventData[LobbyLeft](EventTypeLobbyLeft, args...)
+	eventing.RegisterEventData[LobbyStarted](EventTypeLobbyStarted, args...)
+	eventing.RegisterEventData[LobbyBotAdded](EventTypeLobbyBotAdded, args...)
+	eventing.RegisterEventData[LobbyRematchVoted](EventTypeLobbyRematchVoted, args...)
+	eventing.RegisterEventData[LobbyReopened](EventTypeLobbyReopened, args...)
+	eventing.RegisterEventData[LobbySettingsUpdated](EventTypeLobbySettingsUpdated, args...)
+	eventing.RegisterEventData[LobbyGameFinished](EventTypeLobbyGameFinished, args...)
+	eventing.RegisterEventData[LobbyStartAborted](EventTypeLobbyStartAborted, args...)
 }
 
 // EventTypeLobbyCreated is the event type for when a lobby is created
@@ -29,10 +39,38 @@ var EventTypeLobbyJoined = (&LobbyJoined{}).EventType()
 // EventTypeLobbyLeft is the event type for when a user leaves a lobby
 var EventTypeLobbyLeft = (&LobbyLeft{}).EventType()
 
+// EventTypeLobbyStarted is the event type for when the host starts the game of a lobby
+var EventTypeLobbyStarted = (&LobbyStarted{}).EventType()
+
+// EventTypeLobbyBotAdded is the event type for when the host seats a bot in a lobby
+var EventTypeLobbyBotAdded = (&LobbyBotAdded{}).EventType()
+
+// EventTypeLobbyRematchVoted is the event type for when a participant asks for a rematch
+var EventTypeLobbyRematchVoted = (&LobbyRematchVoted{}).EventType()
+
+// EventTypeLobbyReopened is the event type for when a lobby waits for a new game after a rematch vote
+var EventTypeLobbyReopened = (&LobbyReopened{}).EventType()
+
+// EventTypeLobbySettingsUpdated is the event type for when the host changes the house rules of a lobby
+var EventTypeLobbySettingsUpdated = (&LobbySettingsUpdated{}).EventType()
+
+// EventTypeLobbyGameFinished is the event type for when the game of a lobby is over
+var EventTypeLobbyGameFinished = (&LobbyGameFinished{}).EventType()
+
+// EventTypeLobbyStartAborted is the event type for when the game of a started lobby could not be created
+var EventTypeLobbyStartAborted = (&LobbyStartAborted{}).EventType()
+
 var AllEventTypes = []common.EventType{
 	EventTypeLobbyCreated,
 	EventTypeLobbyJoined,
 	EventTypeLobbyLeft,
+	EventTypeLobbyStarted,
+	EventTypeLobbyBotAdded,
+	EventTypeLobbyRematchVoted,
+	EventTypeLobbyReopened,
+	EventTypeLobbySettingsUpdated,
+	EventTypeLobbyGameFinished,
+	EventTypeLobbyStartAborted,
 }
 
 type LobbyCreated struct {
@@ -41,6 +79,19 @@ type LobbyCreated struct {
 	LobbyName    string      `json:"lobby_name"`
 	HostUserID   uuid.UUID   `json:"host_user_id"`
 	Participants []uuid.UUID `json:"participants"`
+	// Expansions are the card sets the game of the lobby is played with.
+	Expansions []game.Expansion `json:"expansions,omitempty"`
+	// AllowSpectators lets users who are not in the game follow it.
+	AllowSpectators bool `json:"allow_spectators,omitempty"`
+	// SpectatorDelay is how long the game is held back from the spectators.
+	SpectatorDelay time.Duration `json:"spectator_delay,omitempty"`
+	// Ranked games change the ratings of the players, unless a bot takes part.
+	Ranked bool `json:"ranked,omitempty"`
+	// MaxPlayers is the number of participants the host accepts, zero when the expansions decide.
+	MaxPlayers int        `json:"max_players,omitempty"`
+	Visibility Visibility `json:"visibility,omitempty"`
+	// PasswordHash is the hash of the join password, empty when the lobby has none.
+	PasswordHash string `json:"password_hash,omitempty"`
 }
 
 func (p *LobbyCreated) EventType() common.EventType { return "LOBBY_CREATED" }
@@ -55,6 +106,27 @@ func (p *LobbyCreated) GetHostUserID() uuid.UUID { return p.HostUserID }
 
 func (p *LobbyCreated) GetParticipants() []uuid.UUID { return p.Participants }
 
+func (p *LobbyCreated) GetExpansions() []game.Expansion { return p.Expansions }
+
+func (p *LobbyCreated) GetAllowSpectators() bool { return p.AllowSpectators }
+
+func (p *LobbyCreated) GetSpectatorDelay() time.Duration { return p.SpectatorDelay }
+
+func (p *LobbyCreated) GetRanked() bool { return p.Ranked }
+
+func (p *LobbyCreated) GetMaxPlayers() int { return p.MaxPlayers }
+
+// GetVisibility returns the visibility of the lobby, the lobbies created before it existed are public.
+func (p *LobbyCreated) GetVisibility() Visibility {
+	if p.Visibility == "" {
+		return VisibilityPublic
+	}
+
+	return p.Visibility
+}
+
+func (p *LobbyCreated) GetPasswordHash() string { return p.PasswordHash }
+
 type LobbyJoined struct {
 	LobbyID uuid.UUID `json:"lobby_id"`
 	UserID  uuid.UUID `json:"user_id"`
@@ -69,6 +141,9 @@ func (p *LobbyJoined) GetUserID() uuid.UUID { return p.UserID }
 type LobbyLeft struct {
 	LobbyID uuid.UUID `json:"lobby_id"`
 	UserID  uuid.UUID `json:"user_id"`
+	// HostUserID is the participant who becomes the host when the host leaves. The lobby is closed
+	// when the host leaves no player behind.
+	HostUserID uuid.UUID `json:"host_user_id,omitempty"`
 }
 
 func (p *LobbyLeft) EventType() common.EventType { return "LOBBY_LEAVED" }
@@ -76,3 +151,114 @@ func (p *LobbyLeft) EventType() common.EventType { return "LOBBY_LEAVED" }
 func (p *LobbyLeft) GetLobbyID() uuid.UUID { return p.LobbyID }
 
 func (p *LobbyLeft) GetUserID() uuid.UUID { return p.UserID }
+
+func (p *LobbyLeft) GetHostUserID() uuid.UUID { return p.HostUserID }
+
+type LobbyStarted struct {
+	LobbyID      uuid.UUID   `json:"lobby_id"`
+	GameID       uuid.UUID   `json:"game_id"`
+	Participants []uuid.UUID `json:"participants"`
+	// Bots are the participants played by the server, with their difficulty.
+	Bots map[uuid.UUID]game.BotDifficulty `json:"bots,omitempty"`
+	// Expansions are the card sets the game is created with.
+	Expansions []game.Expansion `json:"expansions,omitempty"`
+	// Ranked games change the ratings of the players, unless a bot takes part.
+	Ranked bool `json:"ranked,omitempty"`
+	// Rules are the house rules the game is created with.
+	Rules game.GameRules `json:"rules,omitempty"`
+	// SpectatorDelay is how long the game is held back from its spectators.
+	SpectatorDelay time.Duration `json:"spectator_delay,omitempty"`
+}
+
+func (p *LobbyStarted) EventType() common.EventType { return "LOBBY_STARTED" }
+
+func (p *LobbyStarted) GetLobbyID() uuid.UUID { return p.LobbyID }
+
+func (p *LobbyStarted) GetGameID() uuid.UUID { return p.GameID }
+
+func (p *LobbyStarted) GetParticipants() []uuid.UUID { return p.Participants }
+
+func (p *LobbyStarted) GetBots() map[uuid.UUID]game.BotDifficulty { return p.Bots }
+
+func (p *LobbyStarted) GetExpansions() []game.Expansion { return p.Expansions }
+
+func (p *LobbyStarted) GetRanked() bool { return p.Ranked }
+
+func (p *LobbyStarted) GetRules() game.GameRules { return p.Rules }
+
+func (p *LobbyStarted) GetSpectatorDelay() time.Duration { return p.SpectatorDelay }
+
+type LobbyBotAdded struct {
+	LobbyID    uuid.UUID          `json:"lobby_id"`
+	BotID      uuid.UUID          `json:"bot_id"`
+	Difficulty game.BotDifficulty `json:"difficulty"`
+}
+
+func (p *LobbyBotAdded) EventType() common.EventType { return "LOBBY_BOT_ADDED" }
+
+func (p *LobbyBotAdded) GetLobbyID() uuid.UUID { return p.LobbyID }
+
+func (p *LobbyBotAdded) GetBotID() uuid.UUID { return p.BotID }
+
+func (p *LobbyBotAdded) GetDifficulty() game.BotDifficulty { return p.Difficulty }
+
+type LobbyRematchVoted struct {
+	LobbyID uuid.UUID `json:"lobby_id"`
+	UserID  uuid.UUID `json:"user_id"`
+}
+
+func (p *LobbyRematchVoted) EventType() common.EventType { return "LOBBY_REMATCH_VOTED" }
+
+func (p *LobbyRematchVoted) GetLobbyID() uuid.UUID { return p.LobbyID }
+
+func (p *LobbyRematchVoted) GetUserID() uuid.UUID { return p.UserID }
+
+// LobbyReopened follows the last rematch vote, the lobby waits for the host to start a new game.
+type LobbyReopened struct {
+	LobbyID        uuid.UUID   `json:"lobby_id"`
+	PreviousGameID uuid.UUID   `json:"previous_game_id"`
+	Participants   []uuid.UUID `json:"participants"`
+}
+
+func (p *LobbyReopened) EventType() common.EventType { return "LOBBY_REOPENED" }
+
+func (p *LobbyReopened) GetLobbyID() uuid.UUID { return p.LobbyID }
+
+func (p *LobbyReopened) GetPreviousGameID() uuid.UUID { return p.PreviousGameID }
+
+func (p *LobbyReopened) GetParticipants() []uuid.UUID { return p.Participants }
+
+type LobbySettingsUpdated struct {
+	LobbyID uuid.UUID      `json:"lobby_id"`
+	Rules   game.GameRules `json:"rules"`
+}
+
+func (p *LobbySettingsUpdated) EventType() common.EventType { return "LOBBY_SETTINGS_UPDATED" }
+
+func (p *LobbySettingsUpdated) GetLobbyID() uuid.UUID { return p.LobbyID }
+
+func (p *LobbySettingsUpdated) GetRules() game.GameRules { return p.Rules }
+
+// LobbyGameFinished follows the end of the game of the lobby, the participants may then leave or vote for a rematch.
+type LobbyGameFinished struct {
+	LobbyID uuid.UUID `json:"lobby_id"`
+	GameID  uuid.UUID `json:"game_id"`
+}
+
+func (p *LobbyGameFinished) EventType() common.EventType { return "LOBBY_GAME_FINISHED" }
+
+func (p *LobbyGameFinished) GetLobbyID() uuid.UUID { return p.LobbyID }
+
+func (p *LobbyGameFinished) GetGameID() uuid.UUID { return p.GameID }
+
+// LobbyStartAborted undoes LobbyStarted when the game could not be created, the lobby waits for the host again.
+type LobbyStartAborted struct {
+	LobbyID uuid.UUID `json:"lobby_id"`
+	GameID  uuid.UUID `json:"game_id"`
+}
+
+func (p *LobbyStartAborted) EventType() common.EventType { return "LOBBY_START_ABORTED" }
+
+func (p *LobbyStartAborted) GetLobbyID() uuid.UUID { return p.LobbyID }
+
+func (p *LobbyStartAborted) GetGameID() uuid.UUID { return p.GameID }
@@ -6,16 +6,85 @@ import (
 	"github.com/gofrs/uuid"
 
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
 )
 
+const (
+	// MinParticipants is the minimum number of participants needed to start a game.
+	MinParticipants = 2
+	// MaxParticipants is the maximum number of participants a game can be started with,
+	// when it is played without expansions.
+	MaxParticipants = game.MaxPlayers
+	// MaxSpectatorDelay is the longest a game can be held back from its spectators.
+	MaxSpectatorDelay = 5 * time.Minute
+)
+
+// MaxParticipantsFor returns the maximum number of participants of a game played with the given expansions.
+func MaxParticipantsFor(expansions []game.Expansion) int {
+	return game.MaxPlayersFor(expansions)
+}
+
+// Capacity returns the number of participants a lobby takes, the host can lower it below
+// what the expansions allow.
+func Capacity(maxPlayers int, expansions []game.Expansion) int {
+	if maxPlayers <= 0 || maxPlayers > MaxParticipantsFor(expansions) {
+		return MaxParticipantsFor(expansions)
+	}
+
+	return maxPlayers
+}
+
+// Visibility tells who can find and join a lobby.
+type Visibility string
+
+const (
+	// VisibilityPublic lobbies can be joined by anyone who knows the lobby, by its id or its code.
+	VisibilityPublic Visibility = "PUBLIC"
+	// VisibilityUnlisted lobbies can only be joined with their code.
+	VisibilityUnlisted Visibility = "UNLISTED"
+	// VisibilityPrivate lobbies can only be joined with their code and their password.
+	VisibilityPrivate Visibility = "PRIVATE"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+func (v Visibility) IsValid() bool {
+	return v == VisibilityPublic || v == VisibilityUnlisted || v == VisibilityPrivate
+}
+
 type Lobby struct {
-	LobbyID      uuid.UUID   `json:"lobby_id"`
-	LobbyCode    string      `json:"lobby_code"`
-	LobbyName    string      `json:"lobby_name"`
-	HostUserID   uuid.UUID   `json:"host_user_id"`
-	Participants []uuid.UUID `json:"participants"`
-	CreatedAt    time.Time   `json:"created_at"`
-	UpdatedAt    time.Time   `json:"updated_at"`
+	LobbyID      uuid.UUID        `json:"lobby_id"`
+	LobbyCode    string           `json:"lobby_code"`
+	LobbyName    string           `json:"lobby_name"`
+	HostUserID   uuid.UUID        `json:"host_user_id"`
+	Participants []uuid.UUID      `json:"participants"`
+	Expansions   []game.Expansion `json:"expansions"`
+	// Bots are the participants played by the server, with their difficulty.
+	Bots map[uuid.UUID]game.BotDifficulty `json:"bots,omitempty"`
+	// AllowSpectators lets users who are not in the game follow it.
+	AllowSpectators bool `json:"allow_spectators"`
+	// SpectatorDelay is how long the game is held back from the spectators, the one of the game in
+	// progress once it is started.
+	SpectatorDelay time.Duration `json:"spectator_delay,omitempty"`
+	// Ranked games change the ratings of the players, unless a bot takes part.
+	Ranked bool `json:"ranked"`
+	// Rules are the house rules the game of the lobby is played with.
+	Rules game.GameRules `json:"rules"`
+	// MaxPlayers is the number of participants the host accepts, zero when the expansions decide.
+	MaxPlayers int        `json:"max_players,omitempty"`
+	Visibility Visibility `json:"visibility"`
+	// HasPassword is set when the participants need a password to join, the password itself never
+	// leaves the aggregate.
+	HasPassword bool `json:"has_password,omitempty"`
+	// RematchVotes are the participants who asked for a rematch once the game was over.
+	RematchVotes []uuid.UUID `json:"rematch_votes"`
+	GameID       uuid.UUID   `json:"game_id"`
+	// GameFinished is set once the game of the lobby is over, until the lobby reopens.
+	GameFinished bool      `json:"game_finished,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
 }
 
 var _ = common.Entity(&Lobby{})
@@ -44,6 +113,59 @@ func (t *Lobby) GetParticipants() []uuid.UUID {
 	return t.Participants
 }
 
+func (t *Lobby) GetExpansions() []game.Expansion {
+	return t.Expansions
+}
+
+func (t *Lobby) GetBots() map[uuid.UUID]game.BotDifficulty {
+	return t.Bots
+}
+
+func (t *Lobby) GetAllowSpectators() bool {
+	return t.AllowSpectators
+}
+
+func (t *Lobby) GetSpectatorDelay() time.Duration {
+	return t.SpectatorDelay
+}
+
+func (t *Lobby) GetRanked() bool {
+	return t.Ranked
+}
+
+func (t *Lobby) GetRules() game.GameRules {
+	return t.Rules
+}
+
+func (t *Lobby) GetMaxPlayers() int {
+	return t.MaxPlayers
+}
+
+// GetCapacity returns the number of participants the lobby takes.
+func (t *Lobby) GetCapacity() int {
+	return Capacity(t.MaxPlayers, t.Expansions)
+}
+
+func (t *Lobby) GetVisibility() Visibility {
+	return t.Visibility
+}
+
+func (t *Lobby) GetHasPassword() bool {
+	return t.HasPassword
+}
+
+func (t *Lobby) GetRematchVotes() []uuid.UUID {
+	return t.RematchVotes
+}
+
+func (t *Lobby) GetGameID() uuid.UUID {
+	return t.GameID
+}
+
+func (t *Lobby) GetGameFinished() bool {
+	return t.GameFinished
+}
+
 func (t *Lobby) GetCreatedAt() time.Time {
 	return t.CreatedAt
 }
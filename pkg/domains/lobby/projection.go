@@ -16,12 +16,26 @@ type AllEventsProjector interface {
 	HandleLobbyCreated(ctx context.Context, event common.Event, data *LobbyCreated, entity *Lobby) (*Lobby, error)
 	HandleLobbyJoined(ctx context.Context, event common.Event, data *LobbyJoined, entity *Lobby) (*Lobby, error)
 	HandleLobbyLeft(ctx context.Context, event common.Event, data *LobbyLeft, entity *Lobby) (*Lobby, error)
+	HandleLobbyStarted(ctx context.Context, event common.Event, data *LobbyStarted, entity *Lobby) (*Lobby, error)
+	HandleLobbyBotAdded(ctx context.Context, event common.Event, data *LobbyBotAdded, entity *Lobby) (*Lobby, error)
+	HandleLobbyRematchVoted(ctx context.Context, event common.Event, data *LobbyRematchVoted, entity *Lobby) (*Lobby, error)
+	HandleLobbyReopened(ctx context.Context, event common.Event, data *LobbyReopened, entity *Lobby) (*Lobby, error)
+	HandleLobbySettingsUpdated(ctx context.Context, event common.Event, data *LobbySettingsUpdated, entity *Lobby) (*Lobby, error)
+	HandleLobbyGameFinished(ctx context.Context, event common.Event, data *LobbyGameFinished, entity *Lobby) (*Lobby, error)
+	HandleLobbyStartAborted(ctx context.Context, event common.Event, data *LobbyStartAborted, entity *Lobby) (*Lobby, error)
 }
 
 type eventsProjector interface {
 	handleLobbyCreated(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error)
 	handleLobbyJoined(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error)
 	handleLobbyLeft(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error)
+	handleLobbyStarted(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error)
+	handleLobbyBotAdded(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error)
+	handleLobbyRematchVoted(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error)
+	handleLobbyReopened(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error)
+	handleLobbySettingsUpdated(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error)
+	handleLobbyGameFinished(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error)
+	handleLobbyStartAborted(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error)
 }
 
 // LobbyProjector is an event handler for Projections in the Lobby domain.
@@ -139,6 +153,20 @@ func (p *LobbyProjector) handleLobbyEvent(ctx context.Context, event common.Even
 		eventHandler = p.handleLobbyJoined
 	case EventTypeLobbyLeft:
 		eventHandler = p.handleLobbyLeft
+	case EventTypeLobbyStarted:
+		eventHandler = p.handleLobbyStarted
+	case EventTypeLobbyBotAdded:
+		eventHandler = p.handleLobbyBotAdded
+	case EventTypeLobbyRematchVoted:
+		eventHandler = p.handleLobbyRematchVoted
+	case EventTypeLobbyReopened:
+		eventHandler = p.handleLobbyReopened
+	case EventTypeLobbySettingsUpdated:
+		eventHandler = p.handleLobbySettingsUpdated
+	case EventTypeLobbyGameFinished:
+		eventHandler = p.handleLobbyGameFinished
+	case EventTypeLobbyStartAborted:
+		eventHandler = p.handleLobbyStartAborted
 	default:
 		if unregistered, ok := event.(common.UnregisteredEvent); !ok || !unregistered.Unregistered() {
 			return nil, fmt.Errorf("unknown event type: %s", event.EventType())
@@ -217,3 +245,157 @@ func (p *LobbyProjector) handleLobbyLeft(ctx context.Context, event common.Event
 
 	return entity, nil
 }
+
+// handleLobbyStarted handles lobby started events.
+func (p *LobbyProjector) handleLobbyStarted(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error) {
+	data, ok := event.Data().(*LobbyStarted)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleLobbyStarted"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyStarted(ctx context.Context, event common.Event, data *LobbyStarted, entity *Lobby) (*Lobby, error)
+	}); ok {
+		return handler.HandleLobbyStarted(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyStarted(ctx context.Context, event common.Event, data *LobbyStarted) error
+	}); ok {
+		return entity, handler.HandleLobbyStarted(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleLobbyBotAdded handles lobby bot added events.
+func (p *LobbyProjector) handleLobbyBotAdded(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error) {
+	data, ok := event.Data().(*LobbyBotAdded)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleLobbyBotAdded"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyBotAdded(ctx context.Context, event common.Event, data *LobbyBotAdded, entity *Lobby) (*Lobby, error)
+	}); ok {
+		return handler.HandleLobbyBotAdded(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyBotAdded(ctx context.Context, event common.Event, data *LobbyBotAdded) error
+	}); ok {
+		return entity, handler.HandleLobbyBotAdded(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleLobbyRematchVoted handles lobby rematch voted events.
+func (p *LobbyProjector) handleLobbyRematchVoted(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error) {
+	data, ok := event.Data().(*LobbyRematchVoted)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleLobbyRematchVoted"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyRematchVoted(ctx context.Context, event common.Event, data *LobbyRematchVoted, entity *Lobby) (*Lobby, error)
+	}); ok {
+		return handler.HandleLobbyRematchVoted(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyRematchVoted(ctx context.Context, event common.Event, data *LobbyRematchVoted) error
+	}); ok {
+		return entity, handler.HandleLobbyRematchVoted(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleLobbyReopened handles lobby reopened events.
+func (p *LobbyProjector) handleLobbyReopened(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error) {
+	data, ok := event.Data().(*LobbyReopened)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleLobbyReopened"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyReopened(ctx context.Context, event common.Event, data *LobbyReopened, entity *Lobby) (*Lobby, error)
+	}); ok {
+		return handler.HandleLobbyReopened(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyReopened(ctx context.Context, event common.Event, data *LobbyReopened) error
+	}); ok {
+		return entity, handler.HandleLobbyReopened(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleLobbySettingsUpdated handles lobby settings updated events.
+func (p *LobbyProjector) handleLobbySettingsUpdated(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error) {
+	data, ok := event.Data().(*LobbySettingsUpdated)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleLobbySettingsUpdated"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbySettingsUpdated(ctx context.Context, event common.Event, data *LobbySettingsUpdated, entity *Lobby) (*Lobby, error)
+	}); ok {
+		return handler.HandleLobbySettingsUpdated(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbySettingsUpdated(ctx context.Context, event common.Event, data *LobbySettingsUpdated) error
+	}); ok {
+		return entity, handler.HandleLobbySettingsUpdated(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleLobbyGameFinished handles lobby game finished events.
+func (p *LobbyProjector) handleLobbyGameFinished(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error) {
+	data, ok := event.Data().(*LobbyGameFinished)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleLobbyGameFinished"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyGameFinished(ctx context.Context, event common.Event, data *LobbyGameFinished, entity *Lobby) (*Lobby, error)
+	}); ok {
+		return handler.HandleLobbyGameFinished(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyGameFinished(ctx context.Context, event common.Event, data *LobbyGameFinished) error
+	}); ok {
+		return entity, handler.HandleLobbyGameFinished(ctx, event, data)
+	}
+
+	return entity, nil
+}
+
+// handleLobbyStartAborted handles lobby start aborted events.
+func (p *LobbyProjector) handleLobbyStartAborted(ctx context.Context, event common.Event, entity *Lobby) (*Lobby, error) {
+	data, ok := event.Data().(*LobbyStartAborted)
+	if !ok {
+		return nil, errors.WithStack(errors.Wrap(ErrEventDataTypeMismatch, "handleLobbyStartAborted"))
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyStartAborted(ctx context.Context, event common.Event, data *LobbyStartAborted, entity *Lobby) (*Lobby, error)
+	}); ok {
+		return handler.HandleLobbyStartAborted(ctx, event, data, entity)
+	}
+
+	if handler, ok := p.handler.(interface {
+		HandleLobbyStartAborted(ctx context.Context, event common.Event, data *LobbyStartAborted) error
+	}); ok {
+		return entity, handler.HandleLobbyStartAborted(ctx, event, data)
+	}
+
+	return entity, nil
+}
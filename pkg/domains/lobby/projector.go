@@ -6,6 +6,7 @@ import (
 	"github.com/gofrs/uuid"
 
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
 )
 
@@ -40,6 +41,13 @@ func (p *Projector) HandleLobbyCreated(ctx context.Context, event common.Event,
 	entity.LobbyCode = data.GetLobbyCode()
 	entity.LobbyName = data.GetLobbyName()
 	entity.HostUserID = data.GetHostUserID()
+	entity.Expansions = data.GetExpansions()
+	entity.AllowSpectators = data.GetAllowSpectators()
+	entity.SpectatorDelay = data.GetSpectatorDelay()
+	entity.Ranked = data.GetRanked()
+	entity.MaxPlayers = data.GetMaxPlayers()
+	entity.Visibility = data.GetVisibility()
+	entity.HasPassword = data.GetPasswordHash() != ""
 	entity.CreatedAt = timeutil.NowRoundedForGranularity()
 	entity.Participants = []uuid.UUID{
 		data.GetHostUserID(),
@@ -55,12 +63,71 @@ func (p *Projector) HandleLobbyJoined(ctx context.Context, event common.Event, d
 }
 
 func (p *Projector) HandleLobbyLeft(ctx context.Context, event common.Event, data *LobbyLeft, entity *Lobby) (*Lobby, error) {
+	if data.GetUserID() == entity.HostUserID {
+		entity.HostUserID = data.GetHostUserID()
+	}
 	for i, participant := range entity.Participants {
 		if participant == data.GetUserID() {
 			entity.Participants = append(entity.Participants[:i], entity.Participants[i+1:]...)
 			break
 		}
 	}
+	for i, voter := range entity.RematchVotes {
+		if voter == data.GetUserID() {
+			entity.RematchVotes = append(entity.RematchVotes[:i], entity.RematchVotes[i+1:]...)
+			break
+		}
+	}
+	delete(entity.Bots, data.GetUserID())
+
+	return entity, nil
+}
+
+func (p *Projector) HandleLobbyStarted(ctx context.Context, event common.Event, data *LobbyStarted, entity *Lobby) (*Lobby, error) {
+	entity.GameID = data.GetGameID()
+	entity.SpectatorDelay = data.GetSpectatorDelay()
+
+	return entity, nil
+}
+
+func (p *Projector) HandleLobbyBotAdded(ctx context.Context, event common.Event, data *LobbyBotAdded, entity *Lobby) (*Lobby, error) {
+	if entity.Bots == nil {
+		entity.Bots = make(map[uuid.UUID]game.BotDifficulty)
+	}
+	entity.Participants = append(entity.Participants, data.GetBotID())
+	entity.Bots[data.GetBotID()] = data.GetDifficulty()
+
+	return entity, nil
+}
+
+func (p *Projector) HandleLobbyRematchVoted(ctx context.Context, event common.Event, data *LobbyRematchVoted, entity *Lobby) (*Lobby, error) {
+	entity.RematchVotes = append(entity.RematchVotes, data.GetUserID())
+
+	return entity, nil
+}
+
+func (p *Projector) HandleLobbyReopened(ctx context.Context, event common.Event, data *LobbyReopened, entity *Lobby) (*Lobby, error) {
+	entity.GameID = uuid.Nil
+	entity.GameFinished = false
+	entity.RematchVotes = nil
+
+	return entity, nil
+}
+
+func (p *Projector) HandleLobbySettingsUpdated(ctx context.Context, event common.Event, data *LobbySettingsUpdated, entity *Lobby) (*Lobby, error) {
+	entity.Rules = data.GetRules()
+
+	return entity, nil
+}
+
+func (p *Projector) HandleLobbyStartAborted(ctx context.Context, event common.Event, data *LobbyStartAborted, entity *Lobby) (*Lobby, error) {
+	entity.GameID = uuid.Nil
+
+	return entity, nil
+}
+
+func (p *Projector) HandleLobbyGameFinished(ctx context.Context, event common.Event, data *LobbyGameFinished, entity *Lobby) (*Lobby, error) {
+	entity.GameFinished = true
 
 	return entity, nil
 }
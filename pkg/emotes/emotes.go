@@ -0,0 +1,100 @@
+package emotes
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+)
+
+// DefaultCooldown is the default time a player waits between two emotes.
+const DefaultCooldown = 3 * time.Second
+
+var (
+	ErrInvalidEmote = errors.New("invalid emote")
+	ErrCooldown     = errors.New("emote sent too soon after the previous one")
+)
+
+// Emote is one of the predefined reactions a player can send during a game.
+type Emote string
+
+const (
+	EmoteLaugh    Emote = "LAUGH"
+	EmoteNopeFace Emote = "NOPE_FACE"
+	EmoteCry      Emote = "CRY"
+	EmoteShocked  Emote = "SHOCKED"
+	EmoteThinking Emote = "THINKING"
+	EmoteAngry    Emote = "ANGRY"
+	EmoteWave     Emote = "WAVE"
+	EmoteGoodGame Emote = "GOOD_GAME"
+)
+
+// All lists every emote, in the order they are shown.
+var All = []Emote{EmoteLaugh, EmoteNopeFace, EmoteCry, EmoteShocked, EmoteThinking, EmoteAngry, EmoteWave, EmoteGoodGame}
+
+func (e Emote) String() string {
+	return string(e)
+}
+
+func (e Emote) IsValid() bool {
+	for _, emote := range All {
+		if e == emote {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Message is an emote sent by a player to the other players of a game.
+type Message struct {
+	Emote          Emote     `json:"emote"`
+	GameID         uuid.UUID `json:"game_id"`
+	PlayerID       uuid.UUID `json:"player_id"`
+	TargetPlayerID uuid.UUID `json:"target_player_id,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// Mute stops or resumes the delivery of the emotes of a player to a user.
+type Mute struct {
+	UserID   uuid.UUID `json:"user_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+	Muted    bool      `json:"muted"`
+}
+
+// GameSubject is the NATS subject of the emotes of a game.
+func GameSubject(gameID uuid.UUID) string {
+	return fmt.Sprintf("%s.%s", constants.EmoteStream, gameID)
+}
+
+// MuteSubject is the NATS subject of the mutes of a user in a game, they reach every game stream
+// of the user whatever server holds it.
+func MuteSubject(gameID uuid.UUID, userID uuid.UUID) string {
+	return fmt.Sprintf("%s.%s.mute.%s", constants.EmoteStream, gameID, userID)
+}
+
+// Publish sends an emote to the players of its game over NATS core, it is lost for the players
+// who are not streaming the game.
+func Publish(conn *nats.Conn, message *Message) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return conn.Publish(GameSubject(message.GameID), data)
+}
+
+// PublishMute sends a mute to the game streams of its user.
+func PublishMute(conn *nats.Conn, gameID uuid.UUID, mute *Mute) error {
+	data, err := json.Marshal(mute)
+	if err != nil {
+		return err
+	}
+
+	return conn.Publish(MuteSubject(gameID, mute.UserID), data)
+}
@@ -0,0 +1,43 @@
+package emotes_test
+
+import (
+	"strings"
+	goTesting "testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/emotes"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+)
+
+type EmotesSuite struct {
+	*testing.Suite
+}
+
+func TestEmotesSuite(t *goTesting.T) {
+	es := &EmotesSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, es)
+}
+
+func (es *EmotesSuite) Test_IsValid() {
+	for _, emote := range emotes.All {
+		es.True(emote.IsValid())
+	}
+
+	es.False(emotes.Emote("").IsValid())
+	es.False(emotes.Emote("laugh").IsValid())
+}
+
+func (es *EmotesSuite) Test_Subjects_StayOutOfTheGameStream() {
+	gameID, userID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+
+	for _, subject := range []string{emotes.GameSubject(gameID), emotes.MuteSubject(gameID, userID)} {
+		es.False(strings.HasPrefix(subject, constants.GameStream+"."), subject)
+	}
+	es.NotEqual(emotes.GameSubject(gameID), emotes.MuteSubject(gameID, userID))
+}
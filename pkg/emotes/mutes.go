@@ -0,0 +1,101 @@
+package emotes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+)
+
+// muteTTL drops the mutes long after their game is over.
+const muteTTL = 24 * time.Hour
+
+// MuteStore keeps the mutes of every user in a JetStream key-value bucket, so a game stream opened
+// on any server starts with the players its user muted. A muted player has a key, unmuting removes it.
+type MuteStore struct {
+	kv jetstream.KeyValue
+}
+
+// NewMuteStore creates or updates the mute bucket.
+func NewMuteStore(ctx context.Context, js jetstream.JetStream) (*MuteStore, error) {
+	replicas := 1
+	if reps := config.Instance().GetInt(config.NatsStreamReplicas); reps > 0 {
+		replicas = reps
+	}
+
+	storage := jetstream.FileStorage
+	if ss := config.Instance().GetString(config.NatsStreamStorage); strings.EqualFold(ss, "memory") {
+		storage = jetstream.MemoryStorage
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:   constants.MuteBucket,
+		TTL:      muteTTL,
+		Storage:  storage,
+		Replicas: replicas,
+	})
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("could not create mute bucket: %w", err))
+	}
+
+	return &MuteStore{
+		kv: kv,
+	}, nil
+}
+
+// Set records a mute of a user in a game.
+func (s *MuteStore) Set(ctx context.Context, gameID uuid.UUID, mute *Mute) error {
+	key := muteKey(gameID, mute.UserID, mute.PlayerID)
+	if !mute.Muted {
+		return s.kv.Delete(ctx, key)
+	}
+
+	_, err := s.kv.Put(ctx, key, nil)
+	return err
+}
+
+// Muted returns the players a user muted in a game.
+func (s *MuteStore) Muted(ctx context.Context, gameID uuid.UUID, userID uuid.UUID) (map[uuid.UUID]bool, error) {
+	watcher, err := s.kv.WatchFiltered(ctx, []string{muteKey(gameID, userID, uuid.Nil)}, jetstream.IgnoreDeletes(), jetstream.MetaOnly())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	muted := make(map[uuid.UUID]bool)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case entry := <-watcher.Updates():
+			// A nil entry tells that all the current values were delivered.
+			if entry == nil {
+				return muted, nil
+			}
+
+			parts := strings.Split(entry.Key(), ".")
+			if playerID, err := uuid.FromString(parts[len(parts)-1]); err == nil {
+				muted[playerID] = true
+			}
+		}
+	}
+}
+
+// muteKey is the key of a mute, a nil player matches all the mutes of the user in the game.
+func muteKey(gameID uuid.UUID, userID uuid.UUID, playerID uuid.UUID) string {
+	if playerID.IsNil() {
+		return fmt.Sprintf("%s.%s.*", gameID, userID)
+	}
+
+	return fmt.Sprintf("%s.%s.%s", gameID, userID, playerID)
+}
@@ -0,0 +1,30 @@
+package emotes
+
+import (
+	goTesting "testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+)
+
+type MutesSuite struct {
+	*testing.Suite
+}
+
+func TestMutesSuite(t *goTesting.T) {
+	ms := &MutesSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, ms)
+}
+
+func (ms *MutesSuite) Test_MuteKey() {
+	gameID, userID, playerID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+
+	ms.Equal(gameID.String()+"."+userID.String()+"."+playerID.String(), muteKey(gameID, userID, playerID))
+	// The mutes of a user in a game are read with a single filter.
+	ms.Equal(gameID.String()+"."+userID.String()+".*", muteKey(gameID, userID, uuid.Nil))
+}
@@ -52,6 +52,20 @@ func NotFoundError(err error) error {
 	return connect.NewError(connect.CodeNotFound, errors.Newf("not found: %s", err))
 }
 
+func ResourceExhaustedError(err error) error {
+	if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+		return err
+	}
+	return connect.NewError(connect.CodeResourceExhausted, errors.Newf("resource exhausted: %s", err))
+}
+
+func PermissionDeniedError(err error) error {
+	if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+		return err
+	}
+	return connect.NewError(connect.CodePermissionDenied, errors.Newf("permission denied: %s", err))
+}
+
 func UnauthenticatedError(err error) error {
 	if connectErr := new(connect.Error); errors.As(err, &connectErr) {
 		return err
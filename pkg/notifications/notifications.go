@@ -0,0 +1,54 @@
+package notifications
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+)
+
+// NotificationType tells which payload a notification carries.
+type NotificationType string
+
+const (
+	NotificationTypeAchievementUnlocked NotificationType = "ACHIEVEMENT_UNLOCKED"
+)
+
+func (t NotificationType) String() string {
+	return string(t)
+}
+
+// Notification is a message sent to a single user over NATS core, it is lost when the user is not connected.
+type Notification struct {
+	Type                NotificationType     `json:"type"`
+	UserID              uuid.UUID            `json:"user_id"`
+	Timestamp           time.Time            `json:"timestamp"`
+	AchievementUnlocked *AchievementUnlocked `json:"achievement_unlocked,omitempty"`
+}
+
+// AchievementUnlocked is sent once a user unlocks an achievement.
+type AchievementUnlocked struct {
+	AchievementID string    `json:"achievement_id"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	GameID        uuid.UUID `json:"game_id"`
+}
+
+// UserSubject is the NATS subject of the notifications of a user.
+func UserSubject(userID uuid.UUID) string {
+	return fmt.Sprintf("%s.%s", constants.UserStream, userID)
+}
+
+// Publish sends a notification to its user.
+func Publish(conn *nats.Conn, notification *Notification) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	return conn.Publish(UserSubject(notification.UserID), data)
+}
@@ -0,0 +1,57 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// SystemSalt is added to the salt of every hash of the servers, losing it means no password
+	// can be checked anymore.
+	SystemSalt = "cWNZhnAiHeO8Y6UFW2HKor5WE6vbq8SC"
+	// Iterations is the number of rounds of the key derivation.
+	Iterations = 1000
+	keySize    = 32
+	saltSize   = 12
+)
+
+// Hash hashes a password with a random salt, the salt is kept in front of the hash so Check can
+// verify it later.
+func Hash(password string) (string, error) {
+	saltBytes := make([]byte, saltSize)
+	if _, err := rand.Read(saltBytes); err != nil {
+		return "", err
+	}
+
+	salt := base64.URLEncoding.EncodeToString(saltBytes)
+	return salt + "$" + hash(password, salt), nil
+}
+
+// Check reports whether a password matches a hash made by Hash.
+func Check(password string, hashed string) bool {
+	salt, expected, ok := strings.Cut(hashed, "$")
+	if !ok {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(hash(password, salt)), []byte(expected)) == 1
+}
+
+// Key derives a key from a secret and a salt, every hash of the servers goes through it.
+func Key(secret []byte, salt []byte) []byte {
+	return pbkdf2.Key(secret, salt, Iterations, keySize, sha256.New)
+}
+
+func hash(password string, salt string) string {
+	// Salt the password with both its own salt and the system salt
+	saltBytes := make([]byte, 0, len(salt)+len(SystemSalt))
+	saltBytes = append(saltBytes, []byte(salt)...)
+	saltBytes = append(saltBytes, []byte(SystemSalt)...)
+
+	return base64.URLEncoding.EncodeToString(Key([]byte(password), saltBytes))
+}
@@ -0,0 +1,45 @@
+package password
+
+import (
+	goTesting "testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+)
+
+type PasswordSuite struct {
+	*testing.Suite
+}
+
+func TestPasswordSuite(t *goTesting.T) {
+	ps := &PasswordSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, ps)
+}
+
+func (ps *PasswordSuite) Test_Check_MatchesHash() {
+	hashed, err := Hash("meow")
+	ps.NoError(err)
+
+	ps.True(Check("meow", hashed))
+	ps.False(Check("purr", hashed))
+	ps.False(Check("", hashed))
+}
+
+func (ps *PasswordSuite) Test_Hash_IsSalted() {
+	first, err := Hash("meow")
+	ps.NoError(err)
+	second, err := Hash("meow")
+	ps.NoError(err)
+
+	ps.NotEqual(first, second)
+	ps.True(Check("meow", second))
+}
+
+func (ps *PasswordSuite) Test_Check_InvalidHash() {
+	ps.False(Check("meow", ""))
+	ps.False(Check("meow", "meow"))
+}
@@ -0,0 +1,414 @@
+package presence
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+)
+
+// DefaultHeartbeat is how often an open stream tells the other servers it is still there.
+const DefaultHeartbeat = 5 * time.Second
+
+// missedHeartbeats is the number of heartbeats a stream may miss before it is considered closed.
+const missedHeartbeats = 3
+
+// bucketTTL removes the keys nobody swept, it is only a safety net.
+const bucketTTL = time.Hour
+
+// Kind tells the streams of the players of a game from the streams of its spectators.
+type Kind string
+
+const (
+	// KindPlayer keeps a player connected to their game, from the lobby or the game streams.
+	KindPlayer Kind = "player"
+	// KindGame is a player streaming the game itself, it counts against the stream limit of the game.
+	KindGame Kind = "game"
+	// KindSpectator is a spectator streaming the game, it counts against the stream limit of the game.
+	KindSpectator Kind = "spectator"
+)
+
+// limitedKinds are the kinds of the streams counted against the stream limit of a game.
+var limitedKinds = []Kind{KindGame, KindSpectator}
+
+// Key identifies an open stream in the registry.
+type Key struct {
+	GameID   uuid.UUID
+	Kind     Kind
+	UserID   uuid.UUID
+	StreamID uuid.UUID
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%s.%s.%s.%s", k.GameID, k.Kind, k.UserID, k.StreamID)
+}
+
+// filter returns the key filter matching the streams of the key, its unset parts match anything.
+func (k Key) filter() string {
+	parts := []string{k.GameID.String(), string(k.Kind), k.UserID.String(), "*"}
+	if k.GameID.IsNil() {
+		parts[0] = "*"
+	}
+	if k.Kind == "" {
+		parts[1] = "*"
+	}
+	if k.UserID.IsNil() {
+		parts[2] = "*"
+	}
+
+	return strings.Join(parts, ".")
+}
+
+func parseKey(key string) (Key, error) {
+	parts := strings.Split(key, ".")
+	if len(parts) != 4 {
+		return Key{}, errors.Errorf("invalid stream key %q", key)
+	}
+
+	gameID, err := uuid.FromString(parts[0])
+	if err != nil {
+		return Key{}, err
+	}
+
+	userID, err := uuid.FromString(parts[2])
+	if err != nil {
+		return Key{}, err
+	}
+
+	streamID, err := uuid.FromString(parts[3])
+	if err != nil {
+		return Key{}, err
+	}
+
+	return Key{GameID: gameID, Kind: Kind(parts[1]), UserID: userID, StreamID: streamID}, nil
+}
+
+// Registry keeps the streams open on the games in a JetStream key-value bucket, so every server
+// counts the streams of all the others. Each stream owns a key it refreshes at every heartbeat,
+// the keys of a server that went down are swept once they missed a few heartbeats.
+type Registry struct {
+	kv        jetstream.KeyValue
+	heartbeat time.Duration
+}
+
+// NewRegistry creates or updates the presence bucket.
+func NewRegistry(ctx context.Context, js jetstream.JetStream, heartbeat time.Duration) (*Registry, error) {
+	if heartbeat <= 0 {
+		heartbeat = DefaultHeartbeat
+	}
+
+	replicas := 1
+	if reps := config.Instance().GetInt(config.NatsStreamReplicas); reps > 0 {
+		replicas = reps
+	}
+
+	storage := jetstream.FileStorage
+	if ss := config.Instance().GetString(config.NatsStreamStorage); strings.EqualFold(ss, "memory") {
+		storage = jetstream.MemoryStorage
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:   constants.PresenceBucket,
+		TTL:      bucketTTL,
+		Storage:  storage,
+		Replicas: replicas,
+	})
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("could not create presence bucket: %w", err))
+	}
+
+	return &Registry{
+		kv:        kv,
+		heartbeat: heartbeat,
+	}, nil
+}
+
+// Stream is a stream registered on a game, it stays registered until it is closed.
+type Stream struct {
+	registry *Registry
+	key      Key
+	done     chan struct{}
+	once     sync.Once
+}
+
+// Open registers a new stream of a user on a game.
+func (r *Registry) Open(ctx context.Context, gameID uuid.UUID, userID uuid.UUID, kind Kind) (*Stream, error) {
+	key := Key{GameID: gameID, Kind: kind, UserID: userID, StreamID: uuid.Must(uuid.NewV7())}
+	if _, err := r.kv.Put(ctx, key.String(), nil); err != nil {
+		return nil, err
+	}
+
+	stream := &Stream{
+		registry: r,
+		key:      key,
+		done:     make(chan struct{}),
+	}
+	go stream.beat()
+
+	return stream, nil
+}
+
+// OpenLimited registers a new stream of a user on a game unless the game already has limit streams
+// of the limited kinds, a limit of 0 means no limit. The stream is registered before the streams are
+// counted, streams opened at once on several servers may all be refused near the limit but are never
+// all accepted over it.
+func (r *Registry) OpenLimited(ctx context.Context, gameID uuid.UUID, userID uuid.UUID, kind Kind, limit int) (*Stream, bool, error) {
+	stream, err := r.Open(ctx, gameID, userID, kind)
+	if err != nil || limit <= 0 {
+		return stream, err == nil, err
+	}
+
+	entries, err := r.entries(ctx, Key{GameID: gameID}.filter())
+	if err == nil && r.count(entries, limitedKinds...) <= limit {
+		return stream, true, nil
+	}
+
+	if closeErr := stream.Close(ctx); closeErr != nil {
+		log.Global().WarnContext(ctx, "failed to close refused stream", zap.String("key", stream.key.String()), zap.Error(closeErr))
+	}
+
+	return nil, false, err
+}
+
+func (s *Stream) beat() {
+	ticker := time.NewTicker(s.registry.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), s.registry.heartbeat)
+			if _, err := s.registry.kv.Put(ctx, s.key.String(), nil); err != nil {
+				log.Global().WarnContext(ctx, "failed to refresh stream presence", zap.String("key", s.key.String()), zap.Error(err))
+			}
+			cancel()
+		}
+	}
+}
+
+// Close removes the stream from the registry, it can be called more than once.
+func (s *Stream) Close(ctx context.Context) error {
+	var err error
+	s.once.Do(func() {
+		close(s.done)
+		err = s.registry.kv.Delete(ctx, s.key.String())
+	})
+
+	return err
+}
+
+// Count returns the number of streams open on a game. The streams are only counted for a kind and
+// a user when they are set.
+func (r *Registry) Count(ctx context.Context, gameID uuid.UUID, kind Kind, userID uuid.UUID) (int, error) {
+	entries, err := r.entries(ctx, Key{GameID: gameID, Kind: kind, UserID: userID}.filter())
+	if err != nil {
+		return 0, err
+	}
+
+	return r.count(entries), nil
+}
+
+// Watch calls changed with the game and its number of streams of a kind every time a stream of that
+// kind is opened or closed on any server, until the context is done. The streams already open when
+// the watch starts are reported first.
+func (r *Registry) Watch(ctx context.Context, kind Kind, changed func(gameID uuid.UUID, count int)) error {
+	watcher, err := r.kv.WatchFiltered(ctx, []string{Key{Kind: kind}.filter()}, jetstream.MetaOnly())
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer func() { _ = watcher.Stop() }()
+
+		// The heartbeats of the open streams are not changes.
+		open := make(map[string]struct{})
+		counts := make(map[uuid.UUID]int)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case entry, ok := <-watcher.Updates():
+				if !ok {
+					return
+				}
+				if entry == nil {
+					continue
+				}
+
+				key, err := parseKey(entry.Key())
+				if err != nil {
+					continue
+				}
+
+				_, known := open[entry.Key()]
+				if entry.Operation() == jetstream.KeyValuePut {
+					if known {
+						continue
+					}
+					open[entry.Key()] = struct{}{}
+					counts[key.GameID]++
+				} else {
+					if !known {
+						continue
+					}
+					delete(open, entry.Key())
+					counts[key.GameID]--
+				}
+
+				count := counts[key.GameID]
+				if count == 0 {
+					delete(counts, key.GameID)
+				}
+
+				changed(key.GameID, count)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Counter keeps the number of streams open on each game, as reported by Watch, so reading it does
+// not go through the bucket.
+type Counter struct {
+	mu     sync.RWMutex
+	counts map[uuid.UUID]int
+}
+
+func NewCounter() *Counter {
+	return &Counter{
+		counts: make(map[uuid.UUID]int),
+	}
+}
+
+// Set records the number of streams of a game.
+func (c *Counter) Set(gameID uuid.UUID, count int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if count <= 0 {
+		delete(c.counts, gameID)
+		return
+	}
+
+	c.counts[gameID] = count
+}
+
+// Get returns the number of streams of a game.
+func (c *Counter) Get(gameID uuid.UUID) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.counts[gameID]
+}
+
+// Sweep removes the streams that missed their heartbeats and hands them to expired, each stream is
+// handed to a single server.
+func (r *Registry) Sweep(ctx context.Context, expired func(ctx context.Context, key Key)) error {
+	entries, err := r.entries(ctx, ">")
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if r.alive(entry) {
+			continue
+		}
+
+		// The delete fails when the stream came back or another server swept it first.
+		if err := r.kv.Delete(ctx, entry.Key(), jetstream.LastRevision(entry.Revision())); err != nil {
+			continue
+		}
+
+		key, err := parseKey(entry.Key())
+		if err != nil {
+			log.Global().WarnContext(ctx, "swept an invalid stream key", zap.String("key", entry.Key()), zap.Error(err))
+			continue
+		}
+
+		expired(ctx, key)
+	}
+
+	return nil
+}
+
+// Run sweeps the registry at every heartbeat until the context is done.
+func (r *Registry) Run(ctx context.Context, expired func(ctx context.Context, key Key)) {
+	ticker := time.NewTicker(r.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Sweep(ctx, expired); err != nil {
+				log.Global().WarnContext(ctx, "failed to sweep the stream registry", zap.Error(err))
+			}
+		}
+	}
+}
+
+// count returns the number of alive entries, only of the kinds when they are set.
+func (r *Registry) count(entries []jetstream.KeyValueEntry, kinds ...Kind) int {
+	count := 0
+	for _, entry := range entries {
+		if !r.alive(entry) {
+			continue
+		}
+
+		if len(kinds) > 0 {
+			key, err := parseKey(entry.Key())
+			if err != nil || !slices.Contains(kinds, key.Kind) {
+				continue
+			}
+		}
+
+		count++
+	}
+
+	return count
+}
+
+func (r *Registry) alive(entry jetstream.KeyValueEntry) bool {
+	return time.Since(entry.Created()) < missedHeartbeats*r.heartbeat
+}
+
+// entries returns the latest entries of the keys matching the filter, the deleted keys aside.
+func (r *Registry) entries(ctx context.Context, filter string) ([]jetstream.KeyValueEntry, error) {
+	watcher, err := r.kv.WatchFiltered(ctx, []string{filter}, jetstream.IgnoreDeletes(), jetstream.MetaOnly())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	var entries []jetstream.KeyValueEntry
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case entry := <-watcher.Updates():
+			// A nil entry tells that all the current values were delivered.
+			if entry == nil {
+				return entries, nil
+			}
+			entries = append(entries, entry)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package presence
+
+import (
+	goTesting "testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+)
+
+type RegistrySuite struct {
+	*testing.Suite
+}
+
+func TestRegistrySuite(t *goTesting.T) {
+	rs := &RegistrySuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, rs)
+}
+
+func (rs *RegistrySuite) Test_Key_RoundTrip() {
+	key := Key{
+		GameID:   uuid.Must(uuid.NewV7()),
+		Kind:     KindSpectator,
+		UserID:   uuid.Must(uuid.NewV7()),
+		StreamID: uuid.Must(uuid.NewV7()),
+	}
+
+	parsed, err := parseKey(key.String())
+	rs.NoError(err)
+	rs.Equal(key, parsed)
+
+	_, err = parseKey(key.GameID.String() + ".player")
+	rs.Error(err)
+}
+
+func (rs *RegistrySuite) Test_Key_Filter() {
+	gameID, userID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+
+	rs.Equal("*.spectator.*.*", Key{Kind: KindSpectator}.filter())
+	rs.Equal(gameID.String()+".*.*.*", Key{GameID: gameID}.filter())
+	rs.Equal(gameID.String()+".spectator.*.*", Key{GameID: gameID, Kind: KindSpectator}.filter())
+	rs.Equal(gameID.String()+".player."+userID.String()+".*", Key{GameID: gameID, Kind: KindPlayer, UserID: userID}.filter())
+}
+
+func (rs *RegistrySuite) Test_Counter() {
+	gameID := uuid.Must(uuid.NewV7())
+	counter := NewCounter()
+	rs.Zero(counter.Get(gameID))
+
+	counter.Set(gameID, 2)
+	rs.Equal(2, counter.Get(gameID))
+
+	counter.Set(gameID, 0)
+	rs.Zero(counter.Get(gameID))
+	rs.Empty(counter.counts)
+}
@@ -0,0 +1,140 @@
+package simulator
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"slices"
+	"strconv"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+)
+
+// Report sums up the simulated games.
+type Report struct {
+	Games    int `json:"games"`
+	Finished int `json:"finished"`
+	// AverageTurns and AverageMoves are the length of the finished games, a move being any command
+	// issued by a bot or a deadline.
+	AverageTurns float64      `json:"average_turns"`
+	AverageMoves float64      `json:"average_moves"`
+	Seats        []SeatReport `json:"seats"`
+	// CardUsage counts the cards played over all games, Nopes and Defuses included.
+	CardUsage         map[game.CardType]int         `json:"card_usage"`
+	EliminationCauses map[game.EliminationCause]int `json:"elimination_causes"`
+
+	turns int
+	moves int
+}
+
+// SeatReport is how a seat of the turn order fared.
+type SeatReport struct {
+	// Seat starts at 1 with the player who takes the first turn.
+	Seat    int                `json:"seat"`
+	Bot     game.BotDifficulty `json:"bot"`
+	Wins    int                `json:"wins"`
+	WinRate float64            `json:"win_rate"`
+}
+
+func newReport(config Config) *Report {
+	seats := make([]SeatReport, 0, len(config.Bots))
+	for seat, bot := range config.Bots {
+		seats = append(seats, SeatReport{Seat: seat + 1, Bot: bot})
+	}
+
+	return &Report{
+		Seats:             seats,
+		CardUsage:         make(map[game.CardType]int),
+		EliminationCauses: make(map[game.EliminationCause]int),
+	}
+}
+
+func (r *Report) add(result *gameResult) {
+	r.Games++
+
+	for card, count := range result.cards {
+		r.CardUsage[card] += count
+	}
+
+	for cause, count := range result.eliminations {
+		r.EliminationCauses[cause] += count
+	}
+
+	if result.winnerSeat < 0 {
+		return
+	}
+
+	r.Finished++
+	r.Seats[result.winnerSeat].Wins++
+	r.turns += result.turns
+	r.moves += result.moves
+}
+
+func (r *Report) finalize() {
+	if r.Finished == 0 {
+		return
+	}
+
+	r.AverageTurns = float64(r.turns) / float64(r.Finished)
+	r.AverageMoves = float64(r.moves) / float64(r.Finished)
+	for i := range r.Seats {
+		r.Seats[i].WinRate = float64(r.Seats[i].Wins) / float64(r.Finished)
+	}
+}
+
+// WriteJSON writes the report as an indented JSON document.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+// WriteCSV writes the report as metric,key,value rows, one per statistic.
+func (r *Report) WriteCSV(w io.Writer) error {
+	records := [][]string{
+		{"metric", "key", "value"},
+		{"games", "", strconv.Itoa(r.Games)},
+		{"finished", "", strconv.Itoa(r.Finished)},
+		{"average_turns", "", formatFloat(r.AverageTurns)},
+		{"average_moves", "", formatFloat(r.AverageMoves)},
+	}
+
+	for _, seat := range r.Seats {
+		key := strconv.Itoa(seat.Seat) + ":" + seat.Bot.String()
+		records = append(records,
+			[]string{"seat_wins", key, strconv.Itoa(seat.Wins)},
+			[]string{"seat_win_rate", key, formatFloat(seat.WinRate)},
+		)
+	}
+
+	for _, card := range sortedKeys(r.CardUsage) {
+		records = append(records, []string{"card_usage", card.String(), strconv.Itoa(r.CardUsage[card])})
+	}
+
+	for _, cause := range sortedKeys(r.EliminationCauses) {
+		records = append(records, []string{"elimination_cause", string(cause), strconv.Itoa(r.EliminationCauses[cause])})
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(records); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', 4, 64)
+}
+
+// sortedKeys returns the keys of a map in order, so the rows of a report are stable.
+func sortedKeys[K ~string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,237 @@
+package simulator
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/aggregate"
+	aggregateCommandHandler "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/command_handler/aggregate"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/event_store/memory"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+)
+
+// DefaultMaxMoves is the number of moves after which a game is given up on.
+const DefaultMaxMoves = 5000
+
+var (
+	ErrNoGames    = errors.New("at least one game must be simulated")
+	ErrInvalidBot = errors.New("invalid bot difficulty")
+)
+
+// Config describes the games to simulate, every seat is played by a bot.
+type Config struct {
+	// Games is the number of games to play.
+	Games int
+	// Bots are the difficulties of the bots, one per seat in turn order.
+	Bots       []game.BotDifficulty
+	Expansions []game.Expansion
+	Rules      game.GameRules
+	// MaxMoves stops a game that does not finish, it is then reported as unfinished.
+	MaxMoves int
+}
+
+// Validate checks the configuration before any game is played.
+func (c *Config) Validate() error {
+	if c.Games <= 0 {
+		return ErrNoGames
+	}
+
+	for _, bot := range c.Bots {
+		if !bot.IsValid() {
+			return errors.Wrapf(ErrInvalidBot, "%q", bot)
+		}
+	}
+
+	if len(c.Bots) < game.MinPlayers || len(c.Bots) > game.MaxPlayersFor(c.Expansions) {
+		return game.ErrInvalidPlayerCount
+	}
+
+	for _, expansion := range c.Expansions {
+		if !expansion.IsValid() {
+			return game.ErrInvalidExpansion
+		}
+	}
+
+	return c.Rules.ValidateFor(len(c.Bots), c.Expansions)
+}
+
+// Run plays the games one after the other with the game aggregate and an in-memory event store.
+// The clock of the process is mocked, so the deadlines of the games are reached without waiting.
+func Run(ctx context.Context, config Config) (*Report, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if config.MaxMoves <= 0 {
+		config.MaxMoves = DefaultMaxMoves
+	}
+
+	store, err := memory.NewEventStore()
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	aggregateStore, err := aggregate.NewAggregateStore(store)
+	if err != nil {
+		return nil, err
+	}
+
+	handler, err := aggregateCommandHandler.NewCommandHandler(game.AggregateType, aggregateStore)
+	if err != nil {
+		return nil, err
+	}
+
+	timeutil.MockClock()
+
+	s := &simulator{
+		config:    config,
+		handler:   handler,
+		projector: game.NewProjector(),
+		report:    newReport(config),
+	}
+
+	for range config.Games {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		gameID := uuid.Must(uuid.NewV7())
+		err := s.play(ctx, gameID)
+		store.Delete(gameID.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.report.finalize()
+
+	return s.report, nil
+}
+
+type simulator struct {
+	config    Config
+	handler   *aggregateCommandHandler.CommandHandler
+	projector *game.Projector
+	report    *Report
+}
+
+// play runs a single game until it finishes or runs out of moves, then adds it to the report.
+func (s *simulator) play(ctx context.Context, gameID uuid.UUID) error {
+	players := make([]uuid.UUID, 0, len(s.config.Bots))
+	bots := make(map[uuid.UUID]game.BotDifficulty, len(s.config.Bots))
+	for _, difficulty := range s.config.Bots {
+		playerID := uuid.Must(uuid.NewV7())
+		players = append(players, playerID)
+		bots[playerID] = difficulty
+	}
+
+	result := newGameResult(players)
+
+	state := &game.Game{}
+	handle := func(cmd eventing.Command) error {
+		events, err := s.handler.HandleCommandEx(ctx, cmd)
+		if err != nil {
+			return errors.Wrapf(err, "simulated %s", cmd.CommandType())
+		}
+
+		for _, event := range events {
+			if state, err = s.projector.Project(ctx, event, state); err != nil {
+				return err
+			}
+
+			result.record(event)
+		}
+
+		result.moves++
+
+		return nil
+	}
+
+	if err := handle(&game.CreateGame{
+		GameID:     gameID,
+		LobbyID:    uuid.Must(uuid.NewV7()),
+		PlayerIDs:  players,
+		Expansions: s.config.Expansions,
+		Bots:       bots,
+		Rules:      s.config.Rules,
+	}); err != nil {
+		return err
+	}
+
+	for result.moves < s.config.MaxMoves && !state.GetFinished() {
+		cmd, err := game.BotMove(state)
+		if err != nil {
+			return err
+		}
+
+		// Nobody wants to move, the game goes on with its next deadline like the scheduler would.
+		if cmd == nil {
+			deadlines := state.Deadlines()
+			if len(deadlines) == 0 {
+				break
+			}
+
+			if wait := deadlines[0].At.Sub(game.TimeNow()); wait > 0 {
+				timeutil.MockedClock.Add(wait)
+			}
+			cmd = deadlines[0].Command
+		}
+
+		if err := handle(cmd); err != nil {
+			return err
+		}
+	}
+
+	s.report.add(result)
+
+	return nil
+}
+
+// gameResult is what a single game adds to the report.
+type gameResult struct {
+	seats        map[uuid.UUID]int
+	winnerSeat   int
+	turns        int
+	moves        int
+	cards        map[game.CardType]int
+	eliminations map[game.EliminationCause]int
+}
+
+func newGameResult(players []uuid.UUID) *gameResult {
+	seats := make(map[uuid.UUID]int, len(players))
+	for seat, playerID := range players {
+		seats[playerID] = seat
+	}
+
+	return &gameResult{
+		seats:        seats,
+		winnerSeat:   -1,
+		cards:        make(map[game.CardType]int),
+		eliminations: make(map[game.EliminationCause]int),
+	}
+}
+
+func (r *gameResult) record(event common.Event) {
+	switch data := event.Data().(type) {
+	case *game.CardPlayed:
+		for _, card := range data.PlayedCards() {
+			r.cards[card]++
+		}
+	case *game.KittenDefused, *game.DefuseNoped:
+		r.cards[game.CardDefuse]++
+	case *game.TurnAdvanced:
+		r.turns++
+	case *game.PlayerEliminated:
+		r.eliminations[data.Cause]++
+	case *game.GameFinished:
+		if seat, ok := r.seats[data.WinnerID]; ok {
+			r.winnerSeat = seat
+		}
+	}
+}
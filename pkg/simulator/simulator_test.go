@@ -0,0 +1,95 @@
+package simulator_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	goTesting "testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/simulator"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+)
+
+type SimulatorSuite struct {
+	*testing.Suite
+}
+
+func TestSimulatorSuite(t *goTesting.T) {
+	ss := &SimulatorSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, ss)
+}
+
+func (ss *SimulatorSuite) Test_Run_PlaysWholeGames() {
+	report, err := simulator.Run(context.Background(), simulator.Config{
+		Games:      20,
+		Bots:       []game.BotDifficulty{game.BotEasy, game.BotHard, game.BotHard},
+		Expansions: []game.Expansion{game.ExpansionImplodingKittens},
+		Rules:      game.GameRules{NopeDefuse: true, RemovedCards: []game.CardType{game.CardFavor}},
+	})
+	ss.NoError(err)
+
+	ss.Equal(20, report.Games)
+	ss.Equal(20, report.Finished)
+	ss.Greater(report.AverageTurns, 0.0)
+	ss.GreaterOrEqual(report.AverageMoves, report.AverageTurns)
+	ss.Zero(report.CardUsage[game.CardFavor])
+
+	wins, rate := 0, 0.0
+	for _, seat := range report.Seats {
+		wins += seat.Wins
+		rate += seat.WinRate
+	}
+	ss.Equal(report.Finished, wins)
+	ss.InDelta(1.0, rate, 0.0001)
+
+	// Every game eliminates all players but the winner.
+	eliminated := 0
+	for _, count := range report.EliminationCauses {
+		eliminated += count
+	}
+	ss.Equal(report.Finished*2, eliminated)
+}
+
+func (ss *SimulatorSuite) Test_Run_InvalidConfig() {
+	_, err := simulator.Run(context.Background(), simulator.Config{Bots: []game.BotDifficulty{game.BotEasy, game.BotEasy}})
+	ss.ErrorIs(err, simulator.ErrNoGames)
+
+	_, err = simulator.Run(context.Background(), simulator.Config{Games: 1, Bots: []game.BotDifficulty{game.BotEasy}})
+	ss.ErrorIs(err, game.ErrInvalidPlayerCount)
+
+	_, err = simulator.Run(context.Background(), simulator.Config{Games: 1, Bots: []game.BotDifficulty{game.BotEasy, "GENIUS"}})
+	ss.ErrorIs(err, simulator.ErrInvalidBot)
+}
+
+func (ss *SimulatorSuite) Test_Report_Formats() {
+	report, err := simulator.Run(context.Background(), simulator.Config{
+		Games: 2,
+		Bots:  []game.BotDifficulty{game.BotEasy, game.BotEasy},
+	})
+	ss.NoError(err)
+
+	var out bytes.Buffer
+	ss.NoError(report.WriteJSON(&out))
+
+	decoded := &simulator.Report{}
+	ss.NoError(json.Unmarshal(out.Bytes(), decoded))
+	ss.Equal(report.Games, decoded.Games)
+	ss.Equal(report.Seats, decoded.Seats)
+
+	out.Reset()
+	ss.NoError(report.WriteCSV(&out))
+
+	records, err := csv.NewReader(&out).ReadAll()
+	ss.NoError(err)
+	ss.Equal([]string{"metric", "key", "value"}, records[0])
+	ss.Equal([]string{"games", "", "2"}, records[1])
+	ss.Contains(records, []string{"seat_wins", "1:EASY", strconv.Itoa(report.Seats[0].Wins)})
+}
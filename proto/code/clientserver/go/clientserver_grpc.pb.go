@@ -20,13 +20,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ClientServer_CreateNewGuestUser_FullMethodName = "/com.sweetloveinyourheart.kittens.clients.ClientServer/CreateNewGuestUser"
-	ClientServer_GuestLogin_FullMethodName         = "/com.sweetloveinyourheart.kittens.clients.ClientServer/GuestLogin"
-	ClientServer_GetPlayerProfile_FullMethodName   = "/com.sweetloveinyourheart.kittens.clients.ClientServer/GetPlayerProfile"
-	ClientServer_CreateLobby_FullMethodName        = "/com.sweetloveinyourheart.kittens.clients.ClientServer/CreateLobby"
-	ClientServer_StreamLobby_FullMethodName        = "/com.sweetloveinyourheart.kittens.clients.ClientServer/StreamLobby"
-	ClientServer_JoinLobby_FullMethodName          = "/com.sweetloveinyourheart.kittens.clients.ClientServer/JoinLobby"
-	ClientServer_LeaveLobby_FullMethodName         = "/com.sweetloveinyourheart.kittens.clients.ClientServer/LeaveLobby"
+	ClientServer_CreateNewGuestUser_FullMethodName  = "/com.sweetloveinyourheart.kittens.clients.ClientServer/CreateNewGuestUser"
+	ClientServer_GuestLogin_FullMethodName          = "/com.sweetloveinyourheart.kittens.clients.ClientServer/GuestLogin"
+	ClientServer_GetPlayerProfile_FullMethodName    = "/com.sweetloveinyourheart.kittens.clients.ClientServer/GetPlayerProfile"
+	ClientServer_CreateLobby_FullMethodName         = "/com.sweetloveinyourheart.kittens.clients.ClientServer/CreateLobby"
+	ClientServer_StreamLobby_FullMethodName         = "/com.sweetloveinyourheart.kittens.clients.ClientServer/StreamLobby"
+	ClientServer_JoinLobby_FullMethodName           = "/com.sweetloveinyourheart.kittens.clients.ClientServer/JoinLobby"
+	ClientServer_LeaveLobby_FullMethodName          = "/com.sweetloveinyourheart.kittens.clients.ClientServer/LeaveLobby"
+	ClientServer_StartGame_FullMethodName           = "/com.sweetloveinyourheart.kittens.clients.ClientServer/StartGame"
+	ClientServer_AddBot_FullMethodName              = "/com.sweetloveinyourheart.kittens.clients.ClientServer/AddBot"
+	ClientServer_VoteRematch_FullMethodName         = "/com.sweetloveinyourheart.kittens.clients.ClientServer/VoteRematch"
+	ClientServer_UpdateLobbySettings_FullMethodName = "/com.sweetloveinyourheart.kittens.clients.ClientServer/UpdateLobbySettings"
+	ClientServer_DrawCard_FullMethodName            = "/com.sweetloveinyourheart.kittens.clients.ClientServer/DrawCard"
+	ClientServer_PlayCard_FullMethodName            = "/com.sweetloveinyourheart.kittens.clients.ClientServer/PlayCard"
+	ClientServer_DefuseKitten_FullMethodName        = "/com.sweetloveinyourheart.kittens.clients.ClientServer/DefuseKitten"
+	ClientServer_PlayCombo_FullMethodName           = "/com.sweetloveinyourheart.kittens.clients.ClientServer/PlayCombo"
+	ClientServer_ChooseCard_FullMethodName          = "/com.sweetloveinyourheart.kittens.clients.ClientServer/ChooseCard"
+	ClientServer_AlterFuture_FullMethodName         = "/com.sweetloveinyourheart.kittens.clients.ClientServer/AlterFuture"
+	ClientServer_StreamGame_FullMethodName          = "/com.sweetloveinyourheart.kittens.clients.ClientServer/StreamGame"
+	ClientServer_SpectateGame_FullMethodName        = "/com.sweetloveinyourheart.kittens.clients.ClientServer/SpectateGame"
+	ClientServer_ReplayGame_FullMethodName          = "/com.sweetloveinyourheart.kittens.clients.ClientServer/ReplayGame"
+	ClientServer_SendEmote_FullMethodName           = "/com.sweetloveinyourheart.kittens.clients.ClientServer/SendEmote"
+	ClientServer_MuteEmotes_FullMethodName          = "/com.sweetloveinyourheart.kittens.clients.ClientServer/MuteEmotes"
+	ClientServer_ListMatchHistory_FullMethodName    = "/com.sweetloveinyourheart.kittens.clients.ClientServer/ListMatchHistory"
+	ClientServer_GetLeaderboard_FullMethodName      = "/com.sweetloveinyourheart.kittens.clients.ClientServer/GetLeaderboard"
+	ClientServer_GetPlayerRating_FullMethodName     = "/com.sweetloveinyourheart.kittens.clients.ClientServer/GetPlayerRating"
+	ClientServer_ListAchievements_FullMethodName    = "/com.sweetloveinyourheart.kittens.clients.ClientServer/ListAchievements"
+	ClientServer_StreamNotifications_FullMethodName = "/com.sweetloveinyourheart.kittens.clients.ClientServer/StreamNotifications"
+	ClientServer_SendChatMessage_FullMethodName     = "/com.sweetloveinyourheart.kittens.clients.ClientServer/SendChatMessage"
+	ClientServer_StreamChat_FullMethodName          = "/com.sweetloveinyourheart.kittens.clients.ClientServer/StreamChat"
 )
 
 // ClientServerClient is the client API for ClientServer service.
@@ -40,6 +62,28 @@ type ClientServerClient interface {
 	StreamLobby(ctx context.Context, in *GetLobbyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetLobbyReply], error)
 	JoinLobby(ctx context.Context, in *JoinLobbyRequest, opts ...grpc.CallOption) (*JoinLobbyResponse, error)
 	LeaveLobby(ctx context.Context, in *LeaveLobbyRequest, opts ...grpc.CallOption) (*LeaveLobbyResponse, error)
+	StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*StartGameResponse, error)
+	AddBot(ctx context.Context, in *AddBotRequest, opts ...grpc.CallOption) (*AddBotResponse, error)
+	VoteRematch(ctx context.Context, in *VoteRematchRequest, opts ...grpc.CallOption) (*VoteRematchResponse, error)
+	UpdateLobbySettings(ctx context.Context, in *UpdateLobbySettingsRequest, opts ...grpc.CallOption) (*UpdateLobbySettingsResponse, error)
+	DrawCard(ctx context.Context, in *DrawCardRequest, opts ...grpc.CallOption) (*DrawCardResponse, error)
+	PlayCard(ctx context.Context, in *PlayCardRequest, opts ...grpc.CallOption) (*PlayCardResponse, error)
+	DefuseKitten(ctx context.Context, in *DefuseKittenRequest, opts ...grpc.CallOption) (*DefuseKittenResponse, error)
+	PlayCombo(ctx context.Context, in *PlayComboRequest, opts ...grpc.CallOption) (*PlayComboResponse, error)
+	ChooseCard(ctx context.Context, in *ChooseCardRequest, opts ...grpc.CallOption) (*ChooseCardResponse, error)
+	AlterFuture(ctx context.Context, in *AlterFutureRequest, opts ...grpc.CallOption) (*AlterFutureResponse, error)
+	StreamGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGameReply], error)
+	SpectateGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGameReply], error)
+	ReplayGame(ctx context.Context, in *ReplayGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplayGameReply], error)
+	SendEmote(ctx context.Context, in *SendEmoteRequest, opts ...grpc.CallOption) (*SendEmoteResponse, error)
+	MuteEmotes(ctx context.Context, in *MuteEmotesRequest, opts ...grpc.CallOption) (*MuteEmotesResponse, error)
+	ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest, opts ...grpc.CallOption) (*ListMatchHistoryResponse, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	GetPlayerRating(ctx context.Context, in *GetPlayerRatingRequest, opts ...grpc.CallOption) (*GetPlayerRatingResponse, error)
+	ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error)
+	StreamNotifications(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error)
+	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error)
+	StreamChat(ctx context.Context, in *StreamChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatMessage], error)
 }
 
 type clientServerClient struct {
@@ -129,6 +173,271 @@ func (c *clientServerClient) LeaveLobby(ctx context.Context, in *LeaveLobbyReque
 	return out, nil
 }
 
+func (c *clientServerClient) StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*StartGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartGameResponse)
+	err := c.cc.Invoke(ctx, ClientServer_StartGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) AddBot(ctx context.Context, in *AddBotRequest, opts ...grpc.CallOption) (*AddBotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBotResponse)
+	err := c.cc.Invoke(ctx, ClientServer_AddBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) VoteRematch(ctx context.Context, in *VoteRematchRequest, opts ...grpc.CallOption) (*VoteRematchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteRematchResponse)
+	err := c.cc.Invoke(ctx, ClientServer_VoteRematch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) UpdateLobbySettings(ctx context.Context, in *UpdateLobbySettingsRequest, opts ...grpc.CallOption) (*UpdateLobbySettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLobbySettingsResponse)
+	err := c.cc.Invoke(ctx, ClientServer_UpdateLobbySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) DrawCard(ctx context.Context, in *DrawCardRequest, opts ...grpc.CallOption) (*DrawCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrawCardResponse)
+	err := c.cc.Invoke(ctx, ClientServer_DrawCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) PlayCard(ctx context.Context, in *PlayCardRequest, opts ...grpc.CallOption) (*PlayCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayCardResponse)
+	err := c.cc.Invoke(ctx, ClientServer_PlayCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) DefuseKitten(ctx context.Context, in *DefuseKittenRequest, opts ...grpc.CallOption) (*DefuseKittenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefuseKittenResponse)
+	err := c.cc.Invoke(ctx, ClientServer_DefuseKitten_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) PlayCombo(ctx context.Context, in *PlayComboRequest, opts ...grpc.CallOption) (*PlayComboResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayComboResponse)
+	err := c.cc.Invoke(ctx, ClientServer_PlayCombo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) ChooseCard(ctx context.Context, in *ChooseCardRequest, opts ...grpc.CallOption) (*ChooseCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChooseCardResponse)
+	err := c.cc.Invoke(ctx, ClientServer_ChooseCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) AlterFuture(ctx context.Context, in *AlterFutureRequest, opts ...grpc.CallOption) (*AlterFutureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlterFutureResponse)
+	err := c.cc.Invoke(ctx, ClientServer_AlterFuture_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) StreamGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGameReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ClientServer_ServiceDesc.Streams[1], ClientServer_StreamGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetGameRequest, GetGameReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClientServer_StreamGameClient = grpc.ServerStreamingClient[GetGameReply]
+
+func (c *clientServerClient) SpectateGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGameReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ClientServer_ServiceDesc.Streams[2], ClientServer_SpectateGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetGameRequest, GetGameReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClientServer_SpectateGameClient = grpc.ServerStreamingClient[GetGameReply]
+
+func (c *clientServerClient) ReplayGame(ctx context.Context, in *ReplayGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplayGameReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ClientServer_ServiceDesc.Streams[3], ClientServer_ReplayGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReplayGameRequest, ReplayGameReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClientServer_ReplayGameClient = grpc.ServerStreamingClient[ReplayGameReply]
+
+func (c *clientServerClient) SendEmote(ctx context.Context, in *SendEmoteRequest, opts ...grpc.CallOption) (*SendEmoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendEmoteResponse)
+	err := c.cc.Invoke(ctx, ClientServer_SendEmote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) MuteEmotes(ctx context.Context, in *MuteEmotesRequest, opts ...grpc.CallOption) (*MuteEmotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteEmotesResponse)
+	err := c.cc.Invoke(ctx, ClientServer_MuteEmotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest, opts ...grpc.CallOption) (*ListMatchHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMatchHistoryResponse)
+	err := c.cc.Invoke(ctx, ClientServer_ListMatchHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, ClientServer_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) GetPlayerRating(ctx context.Context, in *GetPlayerRatingRequest, opts ...grpc.CallOption) (*GetPlayerRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlayerRatingResponse)
+	err := c.cc.Invoke(ctx, ClientServer_GetPlayerRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAchievementsResponse)
+	err := c.cc.Invoke(ctx, ClientServer_ListAchievements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) StreamNotifications(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ClientServer_ServiceDesc.Streams[4], ClientServer_StreamNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, Notification]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClientServer_StreamNotificationsClient = grpc.ServerStreamingClient[Notification]
+
+func (c *clientServerClient) SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendChatMessageResponse)
+	err := c.cc.Invoke(ctx, ClientServer_SendChatMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServerClient) StreamChat(ctx context.Context, in *StreamChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ClientServer_ServiceDesc.Streams[5], ClientServer_StreamChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamChatRequest, ChatMessage]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClientServer_StreamChatClient = grpc.ServerStreamingClient[ChatMessage]
+
 // ClientServerServer is the server API for ClientServer service.
 // All implementations should embed UnimplementedClientServerServer
 // for forward compatibility.
@@ -140,6 +449,28 @@ type ClientServerServer interface {
 	StreamLobby(*GetLobbyRequest, grpc.ServerStreamingServer[GetLobbyReply]) error
 	JoinLobby(context.Context, *JoinLobbyRequest) (*JoinLobbyResponse, error)
 	LeaveLobby(context.Context, *LeaveLobbyRequest) (*LeaveLobbyResponse, error)
+	StartGame(context.Context, *StartGameRequest) (*StartGameResponse, error)
+	AddBot(context.Context, *AddBotRequest) (*AddBotResponse, error)
+	VoteRematch(context.Context, *VoteRematchRequest) (*VoteRematchResponse, error)
+	UpdateLobbySettings(context.Context, *UpdateLobbySettingsRequest) (*UpdateLobbySettingsResponse, error)
+	DrawCard(context.Context, *DrawCardRequest) (*DrawCardResponse, error)
+	PlayCard(context.Context, *PlayCardRequest) (*PlayCardResponse, error)
+	DefuseKitten(context.Context, *DefuseKittenRequest) (*DefuseKittenResponse, error)
+	PlayCombo(context.Context, *PlayComboRequest) (*PlayComboResponse, error)
+	ChooseCard(context.Context, *ChooseCardRequest) (*ChooseCardResponse, error)
+	AlterFuture(context.Context, *AlterFutureRequest) (*AlterFutureResponse, error)
+	StreamGame(*GetGameRequest, grpc.ServerStreamingServer[GetGameReply]) error
+	SpectateGame(*GetGameRequest, grpc.ServerStreamingServer[GetGameReply]) error
+	ReplayGame(*ReplayGameRequest, grpc.ServerStreamingServer[ReplayGameReply]) error
+	SendEmote(context.Context, *SendEmoteRequest) (*SendEmoteResponse, error)
+	MuteEmotes(context.Context, *MuteEmotesRequest) (*MuteEmotesResponse, error)
+	ListMatchHistory(context.Context, *ListMatchHistoryRequest) (*ListMatchHistoryResponse, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	GetPlayerRating(context.Context, *GetPlayerRatingRequest) (*GetPlayerRatingResponse, error)
+	ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error)
+	StreamNotifications(*emptypb.Empty, grpc.ServerStreamingServer[Notification]) error
+	SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error)
+	StreamChat(*StreamChatRequest, grpc.ServerStreamingServer[ChatMessage]) error
 }
 
 // UnimplementedClientServerServer should be embedded to have
@@ -170,6 +501,72 @@ func (UnimplementedClientServerServer) JoinLobby(context.Context, *JoinLobbyRequ
 func (UnimplementedClientServerServer) LeaveLobby(context.Context, *LeaveLobbyRequest) (*LeaveLobbyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveLobby not implemented")
 }
+func (UnimplementedClientServerServer) StartGame(context.Context, *StartGameRequest) (*StartGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedClientServerServer) AddBot(context.Context, *AddBotRequest) (*AddBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBot not implemented")
+}
+func (UnimplementedClientServerServer) VoteRematch(context.Context, *VoteRematchRequest) (*VoteRematchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteRematch not implemented")
+}
+func (UnimplementedClientServerServer) UpdateLobbySettings(context.Context, *UpdateLobbySettingsRequest) (*UpdateLobbySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLobbySettings not implemented")
+}
+func (UnimplementedClientServerServer) DrawCard(context.Context, *DrawCardRequest) (*DrawCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawCard not implemented")
+}
+func (UnimplementedClientServerServer) PlayCard(context.Context, *PlayCardRequest) (*PlayCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayCard not implemented")
+}
+func (UnimplementedClientServerServer) DefuseKitten(context.Context, *DefuseKittenRequest) (*DefuseKittenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefuseKitten not implemented")
+}
+func (UnimplementedClientServerServer) PlayCombo(context.Context, *PlayComboRequest) (*PlayComboResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayCombo not implemented")
+}
+func (UnimplementedClientServerServer) ChooseCard(context.Context, *ChooseCardRequest) (*ChooseCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChooseCard not implemented")
+}
+func (UnimplementedClientServerServer) AlterFuture(context.Context, *AlterFutureRequest) (*AlterFutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterFuture not implemented")
+}
+func (UnimplementedClientServerServer) StreamGame(*GetGameRequest, grpc.ServerStreamingServer[GetGameReply]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGame not implemented")
+}
+func (UnimplementedClientServerServer) SpectateGame(*GetGameRequest, grpc.ServerStreamingServer[GetGameReply]) error {
+	return status.Errorf(codes.Unimplemented, "method SpectateGame not implemented")
+}
+func (UnimplementedClientServerServer) ReplayGame(*ReplayGameRequest, grpc.ServerStreamingServer[ReplayGameReply]) error {
+	return status.Errorf(codes.Unimplemented, "method ReplayGame not implemented")
+}
+func (UnimplementedClientServerServer) SendEmote(context.Context, *SendEmoteRequest) (*SendEmoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmote not implemented")
+}
+func (UnimplementedClientServerServer) MuteEmotes(context.Context, *MuteEmotesRequest) (*MuteEmotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteEmotes not implemented")
+}
+func (UnimplementedClientServerServer) ListMatchHistory(context.Context, *ListMatchHistoryRequest) (*ListMatchHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMatchHistory not implemented")
+}
+func (UnimplementedClientServerServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedClientServerServer) GetPlayerRating(context.Context, *GetPlayerRatingRequest) (*GetPlayerRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerRating not implemented")
+}
+func (UnimplementedClientServerServer) ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAchievements not implemented")
+}
+func (UnimplementedClientServerServer) StreamNotifications(*emptypb.Empty, grpc.ServerStreamingServer[Notification]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNotifications not implemented")
+}
+func (UnimplementedClientServerServer) SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChatMessage not implemented")
+}
+func (UnimplementedClientServerServer) StreamChat(*StreamChatRequest, grpc.ServerStreamingServer[ChatMessage]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChat not implemented")
+}
 func (UnimplementedClientServerServer) testEmbeddedByValue() {}
 
 // UnsafeClientServerServer may be embedded to opt out of forward compatibility for this service.
@@ -309,42 +706,496 @@ func _ClientServer_LeaveLobby_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// ClientServer_ServiceDesc is the grpc.ServiceDesc for ClientServer service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ClientServer_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "com.sweetloveinyourheart.kittens.clients.ClientServer",
-	HandlerType: (*ClientServerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateNewGuestUser",
-			Handler:    _ClientServer_CreateNewGuestUser_Handler,
-		},
-		{
-			MethodName: "GuestLogin",
-			Handler:    _ClientServer_GuestLogin_Handler,
-		},
-		{
-			MethodName: "GetPlayerProfile",
-			Handler:    _ClientServer_GetPlayerProfile_Handler,
-		},
-		{
-			MethodName: "CreateLobby",
-			Handler:    _ClientServer_CreateLobby_Handler,
-		},
-		{
-			MethodName: "JoinLobby",
-			Handler:    _ClientServer_JoinLobby_Handler,
-		},
-		{
-			MethodName: "LeaveLobby",
-			Handler:    _ClientServer_LeaveLobby_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "StreamLobby",
-			Handler:       _ClientServer_StreamLobby_Handler,
+func _ClientServer_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_StartGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).StartGame(ctx, req.(*StartGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_AddBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).AddBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_AddBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).AddBot(ctx, req.(*AddBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_VoteRematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRematchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).VoteRematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_VoteRematch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).VoteRematch(ctx, req.(*VoteRematchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_UpdateLobbySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLobbySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).UpdateLobbySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_UpdateLobbySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).UpdateLobbySettings(ctx, req.(*UpdateLobbySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_DrawCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).DrawCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_DrawCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).DrawCard(ctx, req.(*DrawCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_PlayCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).PlayCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_PlayCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).PlayCard(ctx, req.(*PlayCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_DefuseKitten_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefuseKittenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).DefuseKitten(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_DefuseKitten_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).DefuseKitten(ctx, req.(*DefuseKittenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_PlayCombo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayComboRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).PlayCombo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_PlayCombo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).PlayCombo(ctx, req.(*PlayComboRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_ChooseCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChooseCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).ChooseCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_ChooseCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).ChooseCard(ctx, req.(*ChooseCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_AlterFuture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterFutureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).AlterFuture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_AlterFuture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).AlterFuture(ctx, req.(*AlterFutureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_StreamGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServerServer).StreamGame(m, &grpc.GenericServerStream[GetGameRequest, GetGameReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClientServer_StreamGameServer = grpc.ServerStreamingServer[GetGameReply]
+
+func _ClientServer_SpectateGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServerServer).SpectateGame(m, &grpc.GenericServerStream[GetGameRequest, GetGameReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClientServer_SpectateGameServer = grpc.ServerStreamingServer[GetGameReply]
+
+func _ClientServer_ReplayGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServerServer).ReplayGame(m, &grpc.GenericServerStream[ReplayGameRequest, ReplayGameReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClientServer_ReplayGameServer = grpc.ServerStreamingServer[ReplayGameReply]
+
+func _ClientServer_SendEmote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).SendEmote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_SendEmote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).SendEmote(ctx, req.(*SendEmoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_MuteEmotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteEmotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).MuteEmotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_MuteEmotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).MuteEmotes(ctx, req.(*MuteEmotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_ListMatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).ListMatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_ListMatchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).ListMatchHistory(ctx, req.(*ListMatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_GetPlayerRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).GetPlayerRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_GetPlayerRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).GetPlayerRating(ctx, req.(*GetPlayerRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_ListAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).ListAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_ListAchievements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).ListAchievements(ctx, req.(*ListAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_StreamNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServerServer).StreamNotifications(m, &grpc.GenericServerStream[emptypb.Empty, Notification]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClientServer_StreamNotificationsServer = grpc.ServerStreamingServer[Notification]
+
+func _ClientServer_SendChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServerServer).SendChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientServer_SendChatMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServerServer).SendChatMessage(ctx, req.(*SendChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientServer_StreamChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServerServer).StreamChat(m, &grpc.GenericServerStream[StreamChatRequest, ChatMessage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClientServer_StreamChatServer = grpc.ServerStreamingServer[ChatMessage]
+
+// ClientServer_ServiceDesc is the grpc.ServiceDesc for ClientServer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClientServer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "com.sweetloveinyourheart.kittens.clients.ClientServer",
+	HandlerType: (*ClientServerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateNewGuestUser",
+			Handler:    _ClientServer_CreateNewGuestUser_Handler,
+		},
+		{
+			MethodName: "GuestLogin",
+			Handler:    _ClientServer_GuestLogin_Handler,
+		},
+		{
+			MethodName: "GetPlayerProfile",
+			Handler:    _ClientServer_GetPlayerProfile_Handler,
+		},
+		{
+			MethodName: "CreateLobby",
+			Handler:    _ClientServer_CreateLobby_Handler,
+		},
+		{
+			MethodName: "JoinLobby",
+			Handler:    _ClientServer_JoinLobby_Handler,
+		},
+		{
+			MethodName: "LeaveLobby",
+			Handler:    _ClientServer_LeaveLobby_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _ClientServer_StartGame_Handler,
+		},
+		{
+			MethodName: "AddBot",
+			Handler:    _ClientServer_AddBot_Handler,
+		},
+		{
+			MethodName: "VoteRematch",
+			Handler:    _ClientServer_VoteRematch_Handler,
+		},
+		{
+			MethodName: "UpdateLobbySettings",
+			Handler:    _ClientServer_UpdateLobbySettings_Handler,
+		},
+		{
+			MethodName: "DrawCard",
+			Handler:    _ClientServer_DrawCard_Handler,
+		},
+		{
+			MethodName: "PlayCard",
+			Handler:    _ClientServer_PlayCard_Handler,
+		},
+		{
+			MethodName: "DefuseKitten",
+			Handler:    _ClientServer_DefuseKitten_Handler,
+		},
+		{
+			MethodName: "PlayCombo",
+			Handler:    _ClientServer_PlayCombo_Handler,
+		},
+		{
+			MethodName: "ChooseCard",
+			Handler:    _ClientServer_ChooseCard_Handler,
+		},
+		{
+			MethodName: "AlterFuture",
+			Handler:    _ClientServer_AlterFuture_Handler,
+		},
+		{
+			MethodName: "SendEmote",
+			Handler:    _ClientServer_SendEmote_Handler,
+		},
+		{
+			MethodName: "MuteEmotes",
+			Handler:    _ClientServer_MuteEmotes_Handler,
+		},
+		{
+			MethodName: "ListMatchHistory",
+			Handler:    _ClientServer_ListMatchHistory_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _ClientServer_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetPlayerRating",
+			Handler:    _ClientServer_GetPlayerRating_Handler,
+		},
+		{
+			MethodName: "ListAchievements",
+			Handler:    _ClientServer_ListAchievements_Handler,
+		},
+		{
+			MethodName: "SendChatMessage",
+			Handler:    _ClientServer_SendChatMessage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLobby",
+			Handler:       _ClientServer_StreamLobby_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamGame",
+			Handler:       _ClientServer_StreamGame_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SpectateGame",
+			Handler:       _ClientServer_SpectateGame_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReplayGame",
+			Handler:       _ClientServer_ReplayGame_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamNotifications",
+			Handler:       _ClientServer_StreamNotifications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamChat",
+			Handler:       _ClientServer_StreamChat_Handler,
 			ServerStreams: true,
 		},
 	},
@@ -52,6 +52,61 @@ const (
 	ClientServerJoinLobbyProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/JoinLobby"
 	// ClientServerLeaveLobbyProcedure is the fully-qualified name of the ClientServer's LeaveLobby RPC.
 	ClientServerLeaveLobbyProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/LeaveLobby"
+	// ClientServerStartGameProcedure is the fully-qualified name of the ClientServer's StartGame RPC.
+	ClientServerStartGameProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/StartGame"
+	// ClientServerAddBotProcedure is the fully-qualified name of the ClientServer's AddBot RPC.
+	ClientServerAddBotProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/AddBot"
+	// ClientServerVoteRematchProcedure is the fully-qualified name of the ClientServer's VoteRematch
+	// RPC.
+	ClientServerVoteRematchProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/VoteRematch"
+	// ClientServerUpdateLobbySettingsProcedure is the fully-qualified name of the ClientServer's
+	// UpdateLobbySettings RPC.
+	ClientServerUpdateLobbySettingsProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/UpdateLobbySettings"
+	// ClientServerDrawCardProcedure is the fully-qualified name of the ClientServer's DrawCard RPC.
+	ClientServerDrawCardProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/DrawCard"
+	// ClientServerPlayCardProcedure is the fully-qualified name of the ClientServer's PlayCard RPC.
+	ClientServerPlayCardProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/PlayCard"
+	// ClientServerDefuseKittenProcedure is the fully-qualified name of the ClientServer's DefuseKitten
+	// RPC.
+	ClientServerDefuseKittenProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/DefuseKitten"
+	// ClientServerPlayComboProcedure is the fully-qualified name of the ClientServer's PlayCombo RPC.
+	ClientServerPlayComboProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/PlayCombo"
+	// ClientServerChooseCardProcedure is the fully-qualified name of the ClientServer's ChooseCard RPC.
+	ClientServerChooseCardProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/ChooseCard"
+	// ClientServerAlterFutureProcedure is the fully-qualified name of the ClientServer's AlterFuture
+	// RPC.
+	ClientServerAlterFutureProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/AlterFuture"
+	// ClientServerStreamGameProcedure is the fully-qualified name of the ClientServer's StreamGame RPC.
+	ClientServerStreamGameProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/StreamGame"
+	// ClientServerSpectateGameProcedure is the fully-qualified name of the ClientServer's SpectateGame
+	// RPC.
+	ClientServerSpectateGameProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/SpectateGame"
+	// ClientServerReplayGameProcedure is the fully-qualified name of the ClientServer's ReplayGame RPC.
+	ClientServerReplayGameProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/ReplayGame"
+	// ClientServerSendEmoteProcedure is the fully-qualified name of the ClientServer's SendEmote RPC.
+	ClientServerSendEmoteProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/SendEmote"
+	// ClientServerMuteEmotesProcedure is the fully-qualified name of the ClientServer's MuteEmotes RPC.
+	ClientServerMuteEmotesProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/MuteEmotes"
+	// ClientServerListMatchHistoryProcedure is the fully-qualified name of the ClientServer's
+	// ListMatchHistory RPC.
+	ClientServerListMatchHistoryProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/ListMatchHistory"
+	// ClientServerGetLeaderboardProcedure is the fully-qualified name of the ClientServer's
+	// GetLeaderboard RPC.
+	ClientServerGetLeaderboardProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/GetLeaderboard"
+	// ClientServerGetPlayerRatingProcedure is the fully-qualified name of the ClientServer's
+	// GetPlayerRating RPC.
+	ClientServerGetPlayerRatingProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/GetPlayerRating"
+	// ClientServerListAchievementsProcedure is the fully-qualified name of the ClientServer's
+	// ListAchievements RPC.
+	ClientServerListAchievementsProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/ListAchievements"
+	// ClientServerStreamNotificationsProcedure is the fully-qualified name of the ClientServer's
+	// StreamNotifications RPC.
+	ClientServerStreamNotificationsProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/StreamNotifications"
+	// ClientServerSendChatMessageProcedure is the fully-qualified name of the ClientServer's
+	// SendChatMessage RPC.
+	ClientServerSendChatMessageProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/SendChatMessage"
+	// ClientServerStreamChatProcedure is the fully-qualified name of the ClientServer's StreamChat RPC.
+	ClientServerStreamChatProcedure = "/com.sweetloveinyourheart.kittens.clients.ClientServer/StreamChat"
 )
 
 // ClientServerClient is a client for the com.sweetloveinyourheart.kittens.clients.ClientServer
@@ -64,6 +119,28 @@ type ClientServerClient interface {
 	StreamLobby(context.Context, *connect.Request[_go.GetLobbyRequest]) (*connect.ServerStreamForClient[_go.GetLobbyReply], error)
 	JoinLobby(context.Context, *connect.Request[_go.JoinLobbyRequest]) (*connect.Response[_go.JoinLobbyResponse], error)
 	LeaveLobby(context.Context, *connect.Request[_go.LeaveLobbyRequest]) (*connect.Response[_go.LeaveLobbyResponse], error)
+	StartGame(context.Context, *connect.Request[_go.StartGameRequest]) (*connect.Response[_go.StartGameResponse], error)
+	AddBot(context.Context, *connect.Request[_go.AddBotRequest]) (*connect.Response[_go.AddBotResponse], error)
+	VoteRematch(context.Context, *connect.Request[_go.VoteRematchRequest]) (*connect.Response[_go.VoteRematchResponse], error)
+	UpdateLobbySettings(context.Context, *connect.Request[_go.UpdateLobbySettingsRequest]) (*connect.Response[_go.UpdateLobbySettingsResponse], error)
+	DrawCard(context.Context, *connect.Request[_go.DrawCardRequest]) (*connect.Response[_go.DrawCardResponse], error)
+	PlayCard(context.Context, *connect.Request[_go.PlayCardRequest]) (*connect.Response[_go.PlayCardResponse], error)
+	DefuseKitten(context.Context, *connect.Request[_go.DefuseKittenRequest]) (*connect.Response[_go.DefuseKittenResponse], error)
+	PlayCombo(context.Context, *connect.Request[_go.PlayComboRequest]) (*connect.Response[_go.PlayComboResponse], error)
+	ChooseCard(context.Context, *connect.Request[_go.ChooseCardRequest]) (*connect.Response[_go.ChooseCardResponse], error)
+	AlterFuture(context.Context, *connect.Request[_go.AlterFutureRequest]) (*connect.Response[_go.AlterFutureResponse], error)
+	StreamGame(context.Context, *connect.Request[_go.GetGameRequest]) (*connect.ServerStreamForClient[_go.GetGameReply], error)
+	SpectateGame(context.Context, *connect.Request[_go.GetGameRequest]) (*connect.ServerStreamForClient[_go.GetGameReply], error)
+	ReplayGame(context.Context, *connect.Request[_go.ReplayGameRequest]) (*connect.ServerStreamForClient[_go.ReplayGameReply], error)
+	SendEmote(context.Context, *connect.Request[_go.SendEmoteRequest]) (*connect.Response[_go.SendEmoteResponse], error)
+	MuteEmotes(context.Context, *connect.Request[_go.MuteEmotesRequest]) (*connect.Response[_go.MuteEmotesResponse], error)
+	ListMatchHistory(context.Context, *connect.Request[_go.ListMatchHistoryRequest]) (*connect.Response[_go.ListMatchHistoryResponse], error)
+	GetLeaderboard(context.Context, *connect.Request[_go.GetLeaderboardRequest]) (*connect.Response[_go.GetLeaderboardResponse], error)
+	GetPlayerRating(context.Context, *connect.Request[_go.GetPlayerRatingRequest]) (*connect.Response[_go.GetPlayerRatingResponse], error)
+	ListAchievements(context.Context, *connect.Request[_go.ListAchievementsRequest]) (*connect.Response[_go.ListAchievementsResponse], error)
+	StreamNotifications(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[_go.Notification], error)
+	SendChatMessage(context.Context, *connect.Request[_go.SendChatMessageRequest]) (*connect.Response[_go.SendChatMessageResponse], error)
+	StreamChat(context.Context, *connect.Request[_go.StreamChatRequest]) (*connect.ServerStreamForClient[_go.ChatMessage], error)
 }
 
 // NewClientServerClient constructs a client for the
@@ -120,18 +197,172 @@ func NewClientServerClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(clientServerMethods.ByName("LeaveLobby")),
 			connect.WithClientOptions(opts...),
 		),
+		startGame: connect.NewClient[_go.StartGameRequest, _go.StartGameResponse](
+			httpClient,
+			baseURL+ClientServerStartGameProcedure,
+			connect.WithSchema(clientServerMethods.ByName("StartGame")),
+			connect.WithClientOptions(opts...),
+		),
+		addBot: connect.NewClient[_go.AddBotRequest, _go.AddBotResponse](
+			httpClient,
+			baseURL+ClientServerAddBotProcedure,
+			connect.WithSchema(clientServerMethods.ByName("AddBot")),
+			connect.WithClientOptions(opts...),
+		),
+		voteRematch: connect.NewClient[_go.VoteRematchRequest, _go.VoteRematchResponse](
+			httpClient,
+			baseURL+ClientServerVoteRematchProcedure,
+			connect.WithSchema(clientServerMethods.ByName("VoteRematch")),
+			connect.WithClientOptions(opts...),
+		),
+		updateLobbySettings: connect.NewClient[_go.UpdateLobbySettingsRequest, _go.UpdateLobbySettingsResponse](
+			httpClient,
+			baseURL+ClientServerUpdateLobbySettingsProcedure,
+			connect.WithSchema(clientServerMethods.ByName("UpdateLobbySettings")),
+			connect.WithClientOptions(opts...),
+		),
+		drawCard: connect.NewClient[_go.DrawCardRequest, _go.DrawCardResponse](
+			httpClient,
+			baseURL+ClientServerDrawCardProcedure,
+			connect.WithSchema(clientServerMethods.ByName("DrawCard")),
+			connect.WithClientOptions(opts...),
+		),
+		playCard: connect.NewClient[_go.PlayCardRequest, _go.PlayCardResponse](
+			httpClient,
+			baseURL+ClientServerPlayCardProcedure,
+			connect.WithSchema(clientServerMethods.ByName("PlayCard")),
+			connect.WithClientOptions(opts...),
+		),
+		defuseKitten: connect.NewClient[_go.DefuseKittenRequest, _go.DefuseKittenResponse](
+			httpClient,
+			baseURL+ClientServerDefuseKittenProcedure,
+			connect.WithSchema(clientServerMethods.ByName("DefuseKitten")),
+			connect.WithClientOptions(opts...),
+		),
+		playCombo: connect.NewClient[_go.PlayComboRequest, _go.PlayComboResponse](
+			httpClient,
+			baseURL+ClientServerPlayComboProcedure,
+			connect.WithSchema(clientServerMethods.ByName("PlayCombo")),
+			connect.WithClientOptions(opts...),
+		),
+		chooseCard: connect.NewClient[_go.ChooseCardRequest, _go.ChooseCardResponse](
+			httpClient,
+			baseURL+ClientServerChooseCardProcedure,
+			connect.WithSchema(clientServerMethods.ByName("ChooseCard")),
+			connect.WithClientOptions(opts...),
+		),
+		alterFuture: connect.NewClient[_go.AlterFutureRequest, _go.AlterFutureResponse](
+			httpClient,
+			baseURL+ClientServerAlterFutureProcedure,
+			connect.WithSchema(clientServerMethods.ByName("AlterFuture")),
+			connect.WithClientOptions(opts...),
+		),
+		streamGame: connect.NewClient[_go.GetGameRequest, _go.GetGameReply](
+			httpClient,
+			baseURL+ClientServerStreamGameProcedure,
+			connect.WithSchema(clientServerMethods.ByName("StreamGame")),
+			connect.WithClientOptions(opts...),
+		),
+		spectateGame: connect.NewClient[_go.GetGameRequest, _go.GetGameReply](
+			httpClient,
+			baseURL+ClientServerSpectateGameProcedure,
+			connect.WithSchema(clientServerMethods.ByName("SpectateGame")),
+			connect.WithClientOptions(opts...),
+		),
+		replayGame: connect.NewClient[_go.ReplayGameRequest, _go.ReplayGameReply](
+			httpClient,
+			baseURL+ClientServerReplayGameProcedure,
+			connect.WithSchema(clientServerMethods.ByName("ReplayGame")),
+			connect.WithClientOptions(opts...),
+		),
+		sendEmote: connect.NewClient[_go.SendEmoteRequest, _go.SendEmoteResponse](
+			httpClient,
+			baseURL+ClientServerSendEmoteProcedure,
+			connect.WithSchema(clientServerMethods.ByName("SendEmote")),
+			connect.WithClientOptions(opts...),
+		),
+		muteEmotes: connect.NewClient[_go.MuteEmotesRequest, _go.MuteEmotesResponse](
+			httpClient,
+			baseURL+ClientServerMuteEmotesProcedure,
+			connect.WithSchema(clientServerMethods.ByName("MuteEmotes")),
+			connect.WithClientOptions(opts...),
+		),
+		listMatchHistory: connect.NewClient[_go.ListMatchHistoryRequest, _go.ListMatchHistoryResponse](
+			httpClient,
+			baseURL+ClientServerListMatchHistoryProcedure,
+			connect.WithSchema(clientServerMethods.ByName("ListMatchHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getLeaderboard: connect.NewClient[_go.GetLeaderboardRequest, _go.GetLeaderboardResponse](
+			httpClient,
+			baseURL+ClientServerGetLeaderboardProcedure,
+			connect.WithSchema(clientServerMethods.ByName("GetLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlayerRating: connect.NewClient[_go.GetPlayerRatingRequest, _go.GetPlayerRatingResponse](
+			httpClient,
+			baseURL+ClientServerGetPlayerRatingProcedure,
+			connect.WithSchema(clientServerMethods.ByName("GetPlayerRating")),
+			connect.WithClientOptions(opts...),
+		),
+		listAchievements: connect.NewClient[_go.ListAchievementsRequest, _go.ListAchievementsResponse](
+			httpClient,
+			baseURL+ClientServerListAchievementsProcedure,
+			connect.WithSchema(clientServerMethods.ByName("ListAchievements")),
+			connect.WithClientOptions(opts...),
+		),
+		streamNotifications: connect.NewClient[emptypb.Empty, _go.Notification](
+			httpClient,
+			baseURL+ClientServerStreamNotificationsProcedure,
+			connect.WithSchema(clientServerMethods.ByName("StreamNotifications")),
+			connect.WithClientOptions(opts...),
+		),
+		sendChatMessage: connect.NewClient[_go.SendChatMessageRequest, _go.SendChatMessageResponse](
+			httpClient,
+			baseURL+ClientServerSendChatMessageProcedure,
+			connect.WithSchema(clientServerMethods.ByName("SendChatMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		streamChat: connect.NewClient[_go.StreamChatRequest, _go.ChatMessage](
+			httpClient,
+			baseURL+ClientServerStreamChatProcedure,
+			connect.WithSchema(clientServerMethods.ByName("StreamChat")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // clientServerClient implements ClientServerClient.
 type clientServerClient struct {
-	createNewGuestUser *connect.Client[_go.CreateNewGuestUserRequest, _go.CreateNewGuestUserResponse]
-	guestLogin         *connect.Client[_go.GuestLoginRequest, _go.GuestLoginResponse]
-	getPlayerProfile   *connect.Client[emptypb.Empty, _go.PlayerProfileResponse]
-	createLobby        *connect.Client[_go.CreateLobbyRequest, _go.CreateLobbyResponse]
-	streamLobby        *connect.Client[_go.GetLobbyRequest, _go.GetLobbyReply]
-	joinLobby          *connect.Client[_go.JoinLobbyRequest, _go.JoinLobbyResponse]
-	leaveLobby         *connect.Client[_go.LeaveLobbyRequest, _go.LeaveLobbyResponse]
+	createNewGuestUser  *connect.Client[_go.CreateNewGuestUserRequest, _go.CreateNewGuestUserResponse]
+	guestLogin          *connect.Client[_go.GuestLoginRequest, _go.GuestLoginResponse]
+	getPlayerProfile    *connect.Client[emptypb.Empty, _go.PlayerProfileResponse]
+	createLobby         *connect.Client[_go.CreateLobbyRequest, _go.CreateLobbyResponse]
+	streamLobby         *connect.Client[_go.GetLobbyRequest, _go.GetLobbyReply]
+	joinLobby           *connect.Client[_go.JoinLobbyRequest, _go.JoinLobbyResponse]
+	leaveLobby          *connect.Client[_go.LeaveLobbyRequest, _go.LeaveLobbyResponse]
+	startGame           *connect.Client[_go.StartGameRequest, _go.StartGameResponse]
+	addBot              *connect.Client[_go.AddBotRequest, _go.AddBotResponse]
+	voteRematch         *connect.Client[_go.VoteRematchRequest, _go.VoteRematchResponse]
+	updateLobbySettings *connect.Client[_go.UpdateLobbySettingsRequest, _go.UpdateLobbySettingsResponse]
+	drawCard            *connect.Client[_go.DrawCardRequest, _go.DrawCardResponse]
+	playCard            *connect.Client[_go.PlayCardRequest, _go.PlayCardResponse]
+	defuseKitten        *connect.Client[_go.DefuseKittenRequest, _go.DefuseKittenResponse]
+	playCombo           *connect.Client[_go.PlayComboRequest, _go.PlayComboResponse]
+	chooseCard          *connect.Client[_go.ChooseCardRequest, _go.ChooseCardResponse]
+	alterFuture         *connect.Client[_go.AlterFutureRequest, _go.AlterFutureResponse]
+	streamGame          *connect.Client[_go.GetGameRequest, _go.GetGameReply]
+	spectateGame        *connect.Client[_go.GetGameRequest, _go.GetGameReply]
+	replayGame          *connect.Client[_go.ReplayGameRequest, _go.ReplayGameReply]
+	sendEmote           *connect.Client[_go.SendEmoteRequest, _go.SendEmoteResponse]
+	muteEmotes          *connect.Client[_go.MuteEmotesRequest, _go.MuteEmotesResponse]
+	listMatchHistory    *connect.Client[_go.ListMatchHistoryRequest, _go.ListMatchHistoryResponse]
+	getLeaderboard      *connect.Client[_go.GetLeaderboardRequest, _go.GetLeaderboardResponse]
+	getPlayerRating     *connect.Client[_go.GetPlayerRatingRequest, _go.GetPlayerRatingResponse]
+	listAchievements    *connect.Client[_go.ListAchievementsRequest, _go.ListAchievementsResponse]
+	streamNotifications *connect.Client[emptypb.Empty, _go.Notification]
+	sendChatMessage     *connect.Client[_go.SendChatMessageRequest, _go.SendChatMessageResponse]
+	streamChat          *connect.Client[_go.StreamChatRequest, _go.ChatMessage]
 }
 
 // CreateNewGuestUser calls
@@ -170,6 +401,118 @@ func (c *clientServerClient) LeaveLobby(ctx context.Context, req *connect.Reques
 	return c.leaveLobby.CallUnary(ctx, req)
 }
 
+// StartGame calls com.sweetloveinyourheart.kittens.clients.ClientServer.StartGame.
+func (c *clientServerClient) StartGame(ctx context.Context, req *connect.Request[_go.StartGameRequest]) (*connect.Response[_go.StartGameResponse], error) {
+	return c.startGame.CallUnary(ctx, req)
+}
+
+// AddBot calls com.sweetloveinyourheart.kittens.clients.ClientServer.AddBot.
+func (c *clientServerClient) AddBot(ctx context.Context, req *connect.Request[_go.AddBotRequest]) (*connect.Response[_go.AddBotResponse], error) {
+	return c.addBot.CallUnary(ctx, req)
+}
+
+// VoteRematch calls com.sweetloveinyourheart.kittens.clients.ClientServer.VoteRematch.
+func (c *clientServerClient) VoteRematch(ctx context.Context, req *connect.Request[_go.VoteRematchRequest]) (*connect.Response[_go.VoteRematchResponse], error) {
+	return c.voteRematch.CallUnary(ctx, req)
+}
+
+// UpdateLobbySettings calls
+// com.sweetloveinyourheart.kittens.clients.ClientServer.UpdateLobbySettings.
+func (c *clientServerClient) UpdateLobbySettings(ctx context.Context, req *connect.Request[_go.UpdateLobbySettingsRequest]) (*connect.Response[_go.UpdateLobbySettingsResponse], error) {
+	return c.updateLobbySettings.CallUnary(ctx, req)
+}
+
+// DrawCard calls com.sweetloveinyourheart.kittens.clients.ClientServer.DrawCard.
+func (c *clientServerClient) DrawCard(ctx context.Context, req *connect.Request[_go.DrawCardRequest]) (*connect.Response[_go.DrawCardResponse], error) {
+	return c.drawCard.CallUnary(ctx, req)
+}
+
+// PlayCard calls com.sweetloveinyourheart.kittens.clients.ClientServer.PlayCard.
+func (c *clientServerClient) PlayCard(ctx context.Context, req *connect.Request[_go.PlayCardRequest]) (*connect.Response[_go.PlayCardResponse], error) {
+	return c.playCard.CallUnary(ctx, req)
+}
+
+// DefuseKitten calls com.sweetloveinyourheart.kittens.clients.ClientServer.DefuseKitten.
+func (c *clientServerClient) DefuseKitten(ctx context.Context, req *connect.Request[_go.DefuseKittenRequest]) (*connect.Response[_go.DefuseKittenResponse], error) {
+	return c.defuseKitten.CallUnary(ctx, req)
+}
+
+// PlayCombo calls com.sweetloveinyourheart.kittens.clients.ClientServer.PlayCombo.
+func (c *clientServerClient) PlayCombo(ctx context.Context, req *connect.Request[_go.PlayComboRequest]) (*connect.Response[_go.PlayComboResponse], error) {
+	return c.playCombo.CallUnary(ctx, req)
+}
+
+// ChooseCard calls com.sweetloveinyourheart.kittens.clients.ClientServer.ChooseCard.
+func (c *clientServerClient) ChooseCard(ctx context.Context, req *connect.Request[_go.ChooseCardRequest]) (*connect.Response[_go.ChooseCardResponse], error) {
+	return c.chooseCard.CallUnary(ctx, req)
+}
+
+// AlterFuture calls com.sweetloveinyourheart.kittens.clients.ClientServer.AlterFuture.
+func (c *clientServerClient) AlterFuture(ctx context.Context, req *connect.Request[_go.AlterFutureRequest]) (*connect.Response[_go.AlterFutureResponse], error) {
+	return c.alterFuture.CallUnary(ctx, req)
+}
+
+// StreamGame calls com.sweetloveinyourheart.kittens.clients.ClientServer.StreamGame.
+func (c *clientServerClient) StreamGame(ctx context.Context, req *connect.Request[_go.GetGameRequest]) (*connect.ServerStreamForClient[_go.GetGameReply], error) {
+	return c.streamGame.CallServerStream(ctx, req)
+}
+
+// SpectateGame calls com.sweetloveinyourheart.kittens.clients.ClientServer.SpectateGame.
+func (c *clientServerClient) SpectateGame(ctx context.Context, req *connect.Request[_go.GetGameRequest]) (*connect.ServerStreamForClient[_go.GetGameReply], error) {
+	return c.spectateGame.CallServerStream(ctx, req)
+}
+
+// ReplayGame calls com.sweetloveinyourheart.kittens.clients.ClientServer.ReplayGame.
+func (c *clientServerClient) ReplayGame(ctx context.Context, req *connect.Request[_go.ReplayGameRequest]) (*connect.ServerStreamForClient[_go.ReplayGameReply], error) {
+	return c.replayGame.CallServerStream(ctx, req)
+}
+
+// SendEmote calls com.sweetloveinyourheart.kittens.clients.ClientServer.SendEmote.
+func (c *clientServerClient) SendEmote(ctx context.Context, req *connect.Request[_go.SendEmoteRequest]) (*connect.Response[_go.SendEmoteResponse], error) {
+	return c.sendEmote.CallUnary(ctx, req)
+}
+
+// MuteEmotes calls com.sweetloveinyourheart.kittens.clients.ClientServer.MuteEmotes.
+func (c *clientServerClient) MuteEmotes(ctx context.Context, req *connect.Request[_go.MuteEmotesRequest]) (*connect.Response[_go.MuteEmotesResponse], error) {
+	return c.muteEmotes.CallUnary(ctx, req)
+}
+
+// ListMatchHistory calls com.sweetloveinyourheart.kittens.clients.ClientServer.ListMatchHistory.
+func (c *clientServerClient) ListMatchHistory(ctx context.Context, req *connect.Request[_go.ListMatchHistoryRequest]) (*connect.Response[_go.ListMatchHistoryResponse], error) {
+	return c.listMatchHistory.CallUnary(ctx, req)
+}
+
+// GetLeaderboard calls com.sweetloveinyourheart.kittens.clients.ClientServer.GetLeaderboard.
+func (c *clientServerClient) GetLeaderboard(ctx context.Context, req *connect.Request[_go.GetLeaderboardRequest]) (*connect.Response[_go.GetLeaderboardResponse], error) {
+	return c.getLeaderboard.CallUnary(ctx, req)
+}
+
+// GetPlayerRating calls com.sweetloveinyourheart.kittens.clients.ClientServer.GetPlayerRating.
+func (c *clientServerClient) GetPlayerRating(ctx context.Context, req *connect.Request[_go.GetPlayerRatingRequest]) (*connect.Response[_go.GetPlayerRatingResponse], error) {
+	return c.getPlayerRating.CallUnary(ctx, req)
+}
+
+// ListAchievements calls com.sweetloveinyourheart.kittens.clients.ClientServer.ListAchievements.
+func (c *clientServerClient) ListAchievements(ctx context.Context, req *connect.Request[_go.ListAchievementsRequest]) (*connect.Response[_go.ListAchievementsResponse], error) {
+	return c.listAchievements.CallUnary(ctx, req)
+}
+
+// StreamNotifications calls
+// com.sweetloveinyourheart.kittens.clients.ClientServer.StreamNotifications.
+func (c *clientServerClient) StreamNotifications(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[_go.Notification], error) {
+	return c.streamNotifications.CallServerStream(ctx, req)
+}
+
+// SendChatMessage calls com.sweetloveinyourheart.kittens.clients.ClientServer.SendChatMessage.
+func (c *clientServerClient) SendChatMessage(ctx context.Context, req *connect.Request[_go.SendChatMessageRequest]) (*connect.Response[_go.SendChatMessageResponse], error) {
+	return c.sendChatMessage.CallUnary(ctx, req)
+}
+
+// StreamChat calls com.sweetloveinyourheart.kittens.clients.ClientServer.StreamChat.
+func (c *clientServerClient) StreamChat(ctx context.Context, req *connect.Request[_go.StreamChatRequest]) (*connect.ServerStreamForClient[_go.ChatMessage], error) {
+	return c.streamChat.CallServerStream(ctx, req)
+}
+
 // ClientServerHandler is an implementation of the
 // com.sweetloveinyourheart.kittens.clients.ClientServer service.
 type ClientServerHandler interface {
@@ -180,6 +523,28 @@ type ClientServerHandler interface {
 	StreamLobby(context.Context, *connect.Request[_go.GetLobbyRequest], *connect.ServerStream[_go.GetLobbyReply]) error
 	JoinLobby(context.Context, *connect.Request[_go.JoinLobbyRequest]) (*connect.Response[_go.JoinLobbyResponse], error)
 	LeaveLobby(context.Context, *connect.Request[_go.LeaveLobbyRequest]) (*connect.Response[_go.LeaveLobbyResponse], error)
+	StartGame(context.Context, *connect.Request[_go.StartGameRequest]) (*connect.Response[_go.StartGameResponse], error)
+	AddBot(context.Context, *connect.Request[_go.AddBotRequest]) (*connect.Response[_go.AddBotResponse], error)
+	VoteRematch(context.Context, *connect.Request[_go.VoteRematchRequest]) (*connect.Response[_go.VoteRematchResponse], error)
+	UpdateLobbySettings(context.Context, *connect.Request[_go.UpdateLobbySettingsRequest]) (*connect.Response[_go.UpdateLobbySettingsResponse], error)
+	DrawCard(context.Context, *connect.Request[_go.DrawCardRequest]) (*connect.Response[_go.DrawCardResponse], error)
+	PlayCard(context.Context, *connect.Request[_go.PlayCardRequest]) (*connect.Response[_go.PlayCardResponse], error)
+	DefuseKitten(context.Context, *connect.Request[_go.DefuseKittenRequest]) (*connect.Response[_go.DefuseKittenResponse], error)
+	PlayCombo(context.Context, *connect.Request[_go.PlayComboRequest]) (*connect.Response[_go.PlayComboResponse], error)
+	ChooseCard(context.Context, *connect.Request[_go.ChooseCardRequest]) (*connect.Response[_go.ChooseCardResponse], error)
+	AlterFuture(context.Context, *connect.Request[_go.AlterFutureRequest]) (*connect.Response[_go.AlterFutureResponse], error)
+	StreamGame(context.Context, *connect.Request[_go.GetGameRequest], *connect.ServerStream[_go.GetGameReply]) error
+	SpectateGame(context.Context, *connect.Request[_go.GetGameRequest], *connect.ServerStream[_go.GetGameReply]) error
+	ReplayGame(context.Context, *connect.Request[_go.ReplayGameRequest], *connect.ServerStream[_go.ReplayGameReply]) error
+	SendEmote(context.Context, *connect.Request[_go.SendEmoteRequest]) (*connect.Response[_go.SendEmoteResponse], error)
+	MuteEmotes(context.Context, *connect.Request[_go.MuteEmotesRequest]) (*connect.Response[_go.MuteEmotesResponse], error)
+	ListMatchHistory(context.Context, *connect.Request[_go.ListMatchHistoryRequest]) (*connect.Response[_go.ListMatchHistoryResponse], error)
+	GetLeaderboard(context.Context, *connect.Request[_go.GetLeaderboardRequest]) (*connect.Response[_go.GetLeaderboardResponse], error)
+	GetPlayerRating(context.Context, *connect.Request[_go.GetPlayerRatingRequest]) (*connect.Response[_go.GetPlayerRatingResponse], error)
+	ListAchievements(context.Context, *connect.Request[_go.ListAchievementsRequest]) (*connect.Response[_go.ListAchievementsResponse], error)
+	StreamNotifications(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[_go.Notification]) error
+	SendChatMessage(context.Context, *connect.Request[_go.SendChatMessageRequest]) (*connect.Response[_go.SendChatMessageResponse], error)
+	StreamChat(context.Context, *connect.Request[_go.StreamChatRequest], *connect.ServerStream[_go.ChatMessage]) error
 }
 
 // NewClientServerHandler builds an HTTP handler from the service implementation. It returns the
@@ -231,6 +596,138 @@ func NewClientServerHandler(svc ClientServerHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(clientServerMethods.ByName("LeaveLobby")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientServerStartGameHandler := connect.NewUnaryHandler(
+		ClientServerStartGameProcedure,
+		svc.StartGame,
+		connect.WithSchema(clientServerMethods.ByName("StartGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerAddBotHandler := connect.NewUnaryHandler(
+		ClientServerAddBotProcedure,
+		svc.AddBot,
+		connect.WithSchema(clientServerMethods.ByName("AddBot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerVoteRematchHandler := connect.NewUnaryHandler(
+		ClientServerVoteRematchProcedure,
+		svc.VoteRematch,
+		connect.WithSchema(clientServerMethods.ByName("VoteRematch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerUpdateLobbySettingsHandler := connect.NewUnaryHandler(
+		ClientServerUpdateLobbySettingsProcedure,
+		svc.UpdateLobbySettings,
+		connect.WithSchema(clientServerMethods.ByName("UpdateLobbySettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerDrawCardHandler := connect.NewUnaryHandler(
+		ClientServerDrawCardProcedure,
+		svc.DrawCard,
+		connect.WithSchema(clientServerMethods.ByName("DrawCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerPlayCardHandler := connect.NewUnaryHandler(
+		ClientServerPlayCardProcedure,
+		svc.PlayCard,
+		connect.WithSchema(clientServerMethods.ByName("PlayCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerDefuseKittenHandler := connect.NewUnaryHandler(
+		ClientServerDefuseKittenProcedure,
+		svc.DefuseKitten,
+		connect.WithSchema(clientServerMethods.ByName("DefuseKitten")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerPlayComboHandler := connect.NewUnaryHandler(
+		ClientServerPlayComboProcedure,
+		svc.PlayCombo,
+		connect.WithSchema(clientServerMethods.ByName("PlayCombo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerChooseCardHandler := connect.NewUnaryHandler(
+		ClientServerChooseCardProcedure,
+		svc.ChooseCard,
+		connect.WithSchema(clientServerMethods.ByName("ChooseCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerAlterFutureHandler := connect.NewUnaryHandler(
+		ClientServerAlterFutureProcedure,
+		svc.AlterFuture,
+		connect.WithSchema(clientServerMethods.ByName("AlterFuture")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerStreamGameHandler := connect.NewServerStreamHandler(
+		ClientServerStreamGameProcedure,
+		svc.StreamGame,
+		connect.WithSchema(clientServerMethods.ByName("StreamGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerSpectateGameHandler := connect.NewServerStreamHandler(
+		ClientServerSpectateGameProcedure,
+		svc.SpectateGame,
+		connect.WithSchema(clientServerMethods.ByName("SpectateGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerReplayGameHandler := connect.NewServerStreamHandler(
+		ClientServerReplayGameProcedure,
+		svc.ReplayGame,
+		connect.WithSchema(clientServerMethods.ByName("ReplayGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerSendEmoteHandler := connect.NewUnaryHandler(
+		ClientServerSendEmoteProcedure,
+		svc.SendEmote,
+		connect.WithSchema(clientServerMethods.ByName("SendEmote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerMuteEmotesHandler := connect.NewUnaryHandler(
+		ClientServerMuteEmotesProcedure,
+		svc.MuteEmotes,
+		connect.WithSchema(clientServerMethods.ByName("MuteEmotes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerListMatchHistoryHandler := connect.NewUnaryHandler(
+		ClientServerListMatchHistoryProcedure,
+		svc.ListMatchHistory,
+		connect.WithSchema(clientServerMethods.ByName("ListMatchHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerGetLeaderboardHandler := connect.NewUnaryHandler(
+		ClientServerGetLeaderboardProcedure,
+		svc.GetLeaderboard,
+		connect.WithSchema(clientServerMethods.ByName("GetLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerGetPlayerRatingHandler := connect.NewUnaryHandler(
+		ClientServerGetPlayerRatingProcedure,
+		svc.GetPlayerRating,
+		connect.WithSchema(clientServerMethods.ByName("GetPlayerRating")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerListAchievementsHandler := connect.NewUnaryHandler(
+		ClientServerListAchievementsProcedure,
+		svc.ListAchievements,
+		connect.WithSchema(clientServerMethods.ByName("ListAchievements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerStreamNotificationsHandler := connect.NewServerStreamHandler(
+		ClientServerStreamNotificationsProcedure,
+		svc.StreamNotifications,
+		connect.WithSchema(clientServerMethods.ByName("StreamNotifications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerSendChatMessageHandler := connect.NewUnaryHandler(
+		ClientServerSendChatMessageProcedure,
+		svc.SendChatMessage,
+		connect.WithSchema(clientServerMethods.ByName("SendChatMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientServerStreamChatHandler := connect.NewServerStreamHandler(
+		ClientServerStreamChatProcedure,
+		svc.StreamChat,
+		connect.WithSchema(clientServerMethods.ByName("StreamChat")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/com.sweetloveinyourheart.kittens.clients.ClientServer/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ClientServerCreateNewGuestUserProcedure:
@@ -247,6 +744,50 @@ func NewClientServerHandler(svc ClientServerHandler, opts ...connect.HandlerOpti
 			clientServerJoinLobbyHandler.ServeHTTP(w, r)
 		case ClientServerLeaveLobbyProcedure:
 			clientServerLeaveLobbyHandler.ServeHTTP(w, r)
+		case ClientServerStartGameProcedure:
+			clientServerStartGameHandler.ServeHTTP(w, r)
+		case ClientServerAddBotProcedure:
+			clientServerAddBotHandler.ServeHTTP(w, r)
+		case ClientServerVoteRematchProcedure:
+			clientServerVoteRematchHandler.ServeHTTP(w, r)
+		case ClientServerUpdateLobbySettingsProcedure:
+			clientServerUpdateLobbySettingsHandler.ServeHTTP(w, r)
+		case ClientServerDrawCardProcedure:
+			clientServerDrawCardHandler.ServeHTTP(w, r)
+		case ClientServerPlayCardProcedure:
+			clientServerPlayCardHandler.ServeHTTP(w, r)
+		case ClientServerDefuseKittenProcedure:
+			clientServerDefuseKittenHandler.ServeHTTP(w, r)
+		case ClientServerPlayComboProcedure:
+			clientServerPlayComboHandler.ServeHTTP(w, r)
+		case ClientServerChooseCardProcedure:
+			clientServerChooseCardHandler.ServeHTTP(w, r)
+		case ClientServerAlterFutureProcedure:
+			clientServerAlterFutureHandler.ServeHTTP(w, r)
+		case ClientServerStreamGameProcedure:
+			clientServerStreamGameHandler.ServeHTTP(w, r)
+		case ClientServerSpectateGameProcedure:
+			clientServerSpectateGameHandler.ServeHTTP(w, r)
+		case ClientServerReplayGameProcedure:
+			clientServerReplayGameHandler.ServeHTTP(w, r)
+		case ClientServerSendEmoteProcedure:
+			clientServerSendEmoteHandler.ServeHTTP(w, r)
+		case ClientServerMuteEmotesProcedure:
+			clientServerMuteEmotesHandler.ServeHTTP(w, r)
+		case ClientServerListMatchHistoryProcedure:
+			clientServerListMatchHistoryHandler.ServeHTTP(w, r)
+		case ClientServerGetLeaderboardProcedure:
+			clientServerGetLeaderboardHandler.ServeHTTP(w, r)
+		case ClientServerGetPlayerRatingProcedure:
+			clientServerGetPlayerRatingHandler.ServeHTTP(w, r)
+		case ClientServerListAchievementsProcedure:
+			clientServerListAchievementsHandler.ServeHTTP(w, r)
+		case ClientServerStreamNotificationsProcedure:
+			clientServerStreamNotificationsHandler.ServeHTTP(w, r)
+		case ClientServerSendChatMessageProcedure:
+			clientServerSendChatMessageHandler.ServeHTTP(w, r)
+		case ClientServerStreamChatProcedure:
+			clientServerStreamChatHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -283,3 +824,91 @@ func (UnimplementedClientServerHandler) JoinLobby(context.Context, *connect.Requ
 func (UnimplementedClientServerHandler) LeaveLobby(context.Context, *connect.Request[_go.LeaveLobbyRequest]) (*connect.Response[_go.LeaveLobbyResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.LeaveLobby is not implemented"))
 }
+
+func (UnimplementedClientServerHandler) StartGame(context.Context, *connect.Request[_go.StartGameRequest]) (*connect.Response[_go.StartGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.StartGame is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) AddBot(context.Context, *connect.Request[_go.AddBotRequest]) (*connect.Response[_go.AddBotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.AddBot is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) VoteRematch(context.Context, *connect.Request[_go.VoteRematchRequest]) (*connect.Response[_go.VoteRematchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.VoteRematch is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) UpdateLobbySettings(context.Context, *connect.Request[_go.UpdateLobbySettingsRequest]) (*connect.Response[_go.UpdateLobbySettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.UpdateLobbySettings is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) DrawCard(context.Context, *connect.Request[_go.DrawCardRequest]) (*connect.Response[_go.DrawCardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.DrawCard is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) PlayCard(context.Context, *connect.Request[_go.PlayCardRequest]) (*connect.Response[_go.PlayCardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.PlayCard is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) DefuseKitten(context.Context, *connect.Request[_go.DefuseKittenRequest]) (*connect.Response[_go.DefuseKittenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.DefuseKitten is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) PlayCombo(context.Context, *connect.Request[_go.PlayComboRequest]) (*connect.Response[_go.PlayComboResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.PlayCombo is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) ChooseCard(context.Context, *connect.Request[_go.ChooseCardRequest]) (*connect.Response[_go.ChooseCardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.ChooseCard is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) AlterFuture(context.Context, *connect.Request[_go.AlterFutureRequest]) (*connect.Response[_go.AlterFutureResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.AlterFuture is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) StreamGame(context.Context, *connect.Request[_go.GetGameRequest], *connect.ServerStream[_go.GetGameReply]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.StreamGame is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) SpectateGame(context.Context, *connect.Request[_go.GetGameRequest], *connect.ServerStream[_go.GetGameReply]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.SpectateGame is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) ReplayGame(context.Context, *connect.Request[_go.ReplayGameRequest], *connect.ServerStream[_go.ReplayGameReply]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.ReplayGame is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) SendEmote(context.Context, *connect.Request[_go.SendEmoteRequest]) (*connect.Response[_go.SendEmoteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.SendEmote is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) MuteEmotes(context.Context, *connect.Request[_go.MuteEmotesRequest]) (*connect.Response[_go.MuteEmotesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.MuteEmotes is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) ListMatchHistory(context.Context, *connect.Request[_go.ListMatchHistoryRequest]) (*connect.Response[_go.ListMatchHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.ListMatchHistory is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) GetLeaderboard(context.Context, *connect.Request[_go.GetLeaderboardRequest]) (*connect.Response[_go.GetLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.GetLeaderboard is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) GetPlayerRating(context.Context, *connect.Request[_go.GetPlayerRatingRequest]) (*connect.Response[_go.GetPlayerRatingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.GetPlayerRating is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) ListAchievements(context.Context, *connect.Request[_go.ListAchievementsRequest]) (*connect.Response[_go.ListAchievementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.ListAchievements is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) StreamNotifications(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[_go.Notification]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.StreamNotifications is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) SendChatMessage(context.Context, *connect.Request[_go.SendChatMessageRequest]) (*connect.Response[_go.SendChatMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.SendChatMessage is not implemented"))
+}
+
+func (UnimplementedClientServerHandler) StreamChat(context.Context, *connect.Request[_go.StreamChatRequest], *connect.ServerStream[_go.ChatMessage]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.clients.ClientServer.StreamChat is not implemented"))
+}
@@ -0,0 +1,601 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: gameserver.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GameServer_CreateGame_FullMethodName       = "/com.sweetloveinyourheart.kittens.games.GameServer/CreateGame"
+	GameServer_DrawCard_FullMethodName         = "/com.sweetloveinyourheart.kittens.games.GameServer/DrawCard"
+	GameServer_PlayCard_FullMethodName         = "/com.sweetloveinyourheart.kittens.games.GameServer/PlayCard"
+	GameServer_PlayCombo_FullMethodName        = "/com.sweetloveinyourheart.kittens.games.GameServer/PlayCombo"
+	GameServer_ChooseCard_FullMethodName       = "/com.sweetloveinyourheart.kittens.games.GameServer/ChooseCard"
+	GameServer_DefuseKitten_FullMethodName     = "/com.sweetloveinyourheart.kittens.games.GameServer/DefuseKitten"
+	GameServer_AlterFuture_FullMethodName      = "/com.sweetloveinyourheart.kittens.games.GameServer/AlterFuture"
+	GameServer_DisconnectPlayer_FullMethodName = "/com.sweetloveinyourheart.kittens.games.GameServer/DisconnectPlayer"
+	GameServer_ReconnectPlayer_FullMethodName  = "/com.sweetloveinyourheart.kittens.games.GameServer/ReconnectPlayer"
+	GameServer_ListMatchHistory_FullMethodName = "/com.sweetloveinyourheart.kittens.games.GameServer/ListMatchHistory"
+	GameServer_GetLeaderboard_FullMethodName   = "/com.sweetloveinyourheart.kittens.games.GameServer/GetLeaderboard"
+	GameServer_GetPlayerRating_FullMethodName  = "/com.sweetloveinyourheart.kittens.games.GameServer/GetPlayerRating"
+	GameServer_ListAchievements_FullMethodName = "/com.sweetloveinyourheart.kittens.games.GameServer/ListAchievements"
+)
+
+// GameServerClient is the client API for GameServer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameServerClient interface {
+	// Create a game for the participants of a started lobby
+	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error)
+	// Draw a card, which ends the turn
+	DrawCard(ctx context.Context, in *DrawCardRequest, opts ...grpc.CallOption) (*DrawCardResponse, error)
+	// Play a card from the hand
+	PlayCard(ctx context.Context, in *PlayCardRequest, opts ...grpc.CallOption) (*PlayCardResponse, error)
+	// Play a combo of cards
+	PlayCombo(ctx context.Context, in *PlayComboRequest, opts ...grpc.CallOption) (*PlayComboResponse, error)
+	// Choose a card asked by a Favor or a combo
+	ChooseCard(ctx context.Context, in *ChooseCardRequest, opts ...grpc.CallOption) (*ChooseCardResponse, error)
+	// Defuse a drawn exploding kitten, or put a drawn imploding kitten back face up
+	DefuseKitten(ctx context.Context, in *DefuseKittenRequest, opts ...grpc.CallOption) (*DefuseKittenResponse, error)
+	// Put the cards seen by an Alter the Future back in a new order
+	AlterFuture(ctx context.Context, in *AlterFutureRequest, opts ...grpc.CallOption) (*AlterFutureResponse, error)
+	// Start the grace period of a player whose streams all dropped, they are played for until they reconnect
+	DisconnectPlayer(ctx context.Context, in *DisconnectPlayerRequest, opts ...grpc.CallOption) (*DisconnectPlayerResponse, error)
+	// End the grace period of a disconnected player
+	ReconnectPlayer(ctx context.Context, in *ReconnectPlayerRequest, opts ...grpc.CallOption) (*ReconnectPlayerResponse, error)
+	// List the finished games of a player, the most recent first
+	ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest, opts ...grpc.CallOption) (*ListMatchHistoryResponse, error)
+	// List the best rated players
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	// Get the rating of a player, with its recent changes
+	GetPlayerRating(ctx context.Context, in *GetPlayerRatingRequest, opts ...grpc.CallOption) (*GetPlayerRatingResponse, error)
+	// List the achievements unlocked by a player
+	ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error)
+}
+
+type gameServerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameServerClient(cc grpc.ClientConnInterface) GameServerClient {
+	return &gameServerClient{cc}
+}
+
+func (c *gameServerClient) CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGameResponse)
+	err := c.cc.Invoke(ctx, GameServer_CreateGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) DrawCard(ctx context.Context, in *DrawCardRequest, opts ...grpc.CallOption) (*DrawCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrawCardResponse)
+	err := c.cc.Invoke(ctx, GameServer_DrawCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) PlayCard(ctx context.Context, in *PlayCardRequest, opts ...grpc.CallOption) (*PlayCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayCardResponse)
+	err := c.cc.Invoke(ctx, GameServer_PlayCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) PlayCombo(ctx context.Context, in *PlayComboRequest, opts ...grpc.CallOption) (*PlayComboResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayComboResponse)
+	err := c.cc.Invoke(ctx, GameServer_PlayCombo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) ChooseCard(ctx context.Context, in *ChooseCardRequest, opts ...grpc.CallOption) (*ChooseCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChooseCardResponse)
+	err := c.cc.Invoke(ctx, GameServer_ChooseCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) DefuseKitten(ctx context.Context, in *DefuseKittenRequest, opts ...grpc.CallOption) (*DefuseKittenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefuseKittenResponse)
+	err := c.cc.Invoke(ctx, GameServer_DefuseKitten_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) AlterFuture(ctx context.Context, in *AlterFutureRequest, opts ...grpc.CallOption) (*AlterFutureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlterFutureResponse)
+	err := c.cc.Invoke(ctx, GameServer_AlterFuture_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) DisconnectPlayer(ctx context.Context, in *DisconnectPlayerRequest, opts ...grpc.CallOption) (*DisconnectPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisconnectPlayerResponse)
+	err := c.cc.Invoke(ctx, GameServer_DisconnectPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) ReconnectPlayer(ctx context.Context, in *ReconnectPlayerRequest, opts ...grpc.CallOption) (*ReconnectPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconnectPlayerResponse)
+	err := c.cc.Invoke(ctx, GameServer_ReconnectPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) ListMatchHistory(ctx context.Context, in *ListMatchHistoryRequest, opts ...grpc.CallOption) (*ListMatchHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMatchHistoryResponse)
+	err := c.cc.Invoke(ctx, GameServer_ListMatchHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, GameServer_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) GetPlayerRating(ctx context.Context, in *GetPlayerRatingRequest, opts ...grpc.CallOption) (*GetPlayerRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlayerRatingResponse)
+	err := c.cc.Invoke(ctx, GameServer_GetPlayerRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServerClient) ListAchievements(ctx context.Context, in *ListAchievementsRequest, opts ...grpc.CallOption) (*ListAchievementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAchievementsResponse)
+	err := c.cc.Invoke(ctx, GameServer_ListAchievements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameServerServer is the server API for GameServer service.
+// All implementations should embed UnimplementedGameServerServer
+// for forward compatibility.
+type GameServerServer interface {
+	// Create a game for the participants of a started lobby
+	CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error)
+	// Draw a card, which ends the turn
+	DrawCard(context.Context, *DrawCardRequest) (*DrawCardResponse, error)
+	// Play a card from the hand
+	PlayCard(context.Context, *PlayCardRequest) (*PlayCardResponse, error)
+	// Play a combo of cards
+	PlayCombo(context.Context, *PlayComboRequest) (*PlayComboResponse, error)
+	// Choose a card asked by a Favor or a combo
+	ChooseCard(context.Context, *ChooseCardRequest) (*ChooseCardResponse, error)
+	// Defuse a drawn exploding kitten, or put a drawn imploding kitten back face up
+	DefuseKitten(context.Context, *DefuseKittenRequest) (*DefuseKittenResponse, error)
+	// Put the cards seen by an Alter the Future back in a new order
+	AlterFuture(context.Context, *AlterFutureRequest) (*AlterFutureResponse, error)
+	// Start the grace period of a player whose streams all dropped, they are played for until they reconnect
+	DisconnectPlayer(context.Context, *DisconnectPlayerRequest) (*DisconnectPlayerResponse, error)
+	// End the grace period of a disconnected player
+	ReconnectPlayer(context.Context, *ReconnectPlayerRequest) (*ReconnectPlayerResponse, error)
+	// List the finished games of a player, the most recent first
+	ListMatchHistory(context.Context, *ListMatchHistoryRequest) (*ListMatchHistoryResponse, error)
+	// List the best rated players
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	// Get the rating of a player, with its recent changes
+	GetPlayerRating(context.Context, *GetPlayerRatingRequest) (*GetPlayerRatingResponse, error)
+	// List the achievements unlocked by a player
+	ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error)
+}
+
+// UnimplementedGameServerServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGameServerServer struct{}
+
+func (UnimplementedGameServerServer) CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (UnimplementedGameServerServer) DrawCard(context.Context, *DrawCardRequest) (*DrawCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawCard not implemented")
+}
+func (UnimplementedGameServerServer) PlayCard(context.Context, *PlayCardRequest) (*PlayCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayCard not implemented")
+}
+func (UnimplementedGameServerServer) PlayCombo(context.Context, *PlayComboRequest) (*PlayComboResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayCombo not implemented")
+}
+func (UnimplementedGameServerServer) ChooseCard(context.Context, *ChooseCardRequest) (*ChooseCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChooseCard not implemented")
+}
+func (UnimplementedGameServerServer) DefuseKitten(context.Context, *DefuseKittenRequest) (*DefuseKittenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefuseKitten not implemented")
+}
+func (UnimplementedGameServerServer) AlterFuture(context.Context, *AlterFutureRequest) (*AlterFutureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterFuture not implemented")
+}
+func (UnimplementedGameServerServer) DisconnectPlayer(context.Context, *DisconnectPlayerRequest) (*DisconnectPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectPlayer not implemented")
+}
+func (UnimplementedGameServerServer) ReconnectPlayer(context.Context, *ReconnectPlayerRequest) (*ReconnectPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconnectPlayer not implemented")
+}
+func (UnimplementedGameServerServer) ListMatchHistory(context.Context, *ListMatchHistoryRequest) (*ListMatchHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMatchHistory not implemented")
+}
+func (UnimplementedGameServerServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedGameServerServer) GetPlayerRating(context.Context, *GetPlayerRatingRequest) (*GetPlayerRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerRating not implemented")
+}
+func (UnimplementedGameServerServer) ListAchievements(context.Context, *ListAchievementsRequest) (*ListAchievementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAchievements not implemented")
+}
+func (UnimplementedGameServerServer) testEmbeddedByValue() {}
+
+// UnsafeGameServerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameServerServer will
+// result in compilation errors.
+type UnsafeGameServerServer interface {
+	mustEmbedUnimplementedGameServerServer()
+}
+
+func RegisterGameServerServer(s grpc.ServiceRegistrar, srv GameServerServer) {
+	// If the following call pancis, it indicates UnimplementedGameServerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GameServer_ServiceDesc, srv)
+}
+
+func _GameServer_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).CreateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_CreateGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).CreateGame(ctx, req.(*CreateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_DrawCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).DrawCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_DrawCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).DrawCard(ctx, req.(*DrawCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_PlayCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).PlayCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_PlayCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).PlayCard(ctx, req.(*PlayCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_PlayCombo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayComboRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).PlayCombo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_PlayCombo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).PlayCombo(ctx, req.(*PlayComboRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_ChooseCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChooseCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).ChooseCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_ChooseCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).ChooseCard(ctx, req.(*ChooseCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_DefuseKitten_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefuseKittenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).DefuseKitten(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_DefuseKitten_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).DefuseKitten(ctx, req.(*DefuseKittenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_AlterFuture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterFutureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).AlterFuture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_AlterFuture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).AlterFuture(ctx, req.(*AlterFutureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_DisconnectPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).DisconnectPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_DisconnectPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).DisconnectPlayer(ctx, req.(*DisconnectPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_ReconnectPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconnectPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).ReconnectPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_ReconnectPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).ReconnectPlayer(ctx, req.(*ReconnectPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_ListMatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).ListMatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_ListMatchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).ListMatchHistory(ctx, req.(*ListMatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_GetPlayerRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).GetPlayerRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_GetPlayerRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).GetPlayerRating(ctx, req.(*GetPlayerRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameServer_ListAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServerServer).ListAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameServer_ListAchievements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServerServer).ListAchievements(ctx, req.(*ListAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameServer_ServiceDesc is the grpc.ServiceDesc for GameServer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameServer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "com.sweetloveinyourheart.kittens.games.GameServer",
+	HandlerType: (*GameServerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGame",
+			Handler:    _GameServer_CreateGame_Handler,
+		},
+		{
+			MethodName: "DrawCard",
+			Handler:    _GameServer_DrawCard_Handler,
+		},
+		{
+			MethodName: "PlayCard",
+			Handler:    _GameServer_PlayCard_Handler,
+		},
+		{
+			MethodName: "PlayCombo",
+			Handler:    _GameServer_PlayCombo_Handler,
+		},
+		{
+			MethodName: "ChooseCard",
+			Handler:    _GameServer_ChooseCard_Handler,
+		},
+		{
+			MethodName: "DefuseKitten",
+			Handler:    _GameServer_DefuseKitten_Handler,
+		},
+		{
+			MethodName: "AlterFuture",
+			Handler:    _GameServer_AlterFuture_Handler,
+		},
+		{
+			MethodName: "DisconnectPlayer",
+			Handler:    _GameServer_DisconnectPlayer_Handler,
+		},
+		{
+			MethodName: "ReconnectPlayer",
+			Handler:    _GameServer_ReconnectPlayer_Handler,
+		},
+		{
+			MethodName: "ListMatchHistory",
+			Handler:    _GameServer_ListMatchHistory_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _GameServer_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetPlayerRating",
+			Handler:    _GameServer_GetPlayerRating_Handler,
+		},
+		{
+			MethodName: "ListAchievements",
+			Handler:    _GameServer_ListAchievements_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gameserver.proto",
+}
@@ -0,0 +1,477 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: gameserver.proto
+
+package grpcconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	_go "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// GameServerName is the fully-qualified name of the GameServer service.
+	GameServerName = "com.sweetloveinyourheart.kittens.games.GameServer"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// GameServerCreateGameProcedure is the fully-qualified name of the GameServer's CreateGame RPC.
+	GameServerCreateGameProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/CreateGame"
+	// GameServerDrawCardProcedure is the fully-qualified name of the GameServer's DrawCard RPC.
+	GameServerDrawCardProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/DrawCard"
+	// GameServerPlayCardProcedure is the fully-qualified name of the GameServer's PlayCard RPC.
+	GameServerPlayCardProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/PlayCard"
+	// GameServerPlayComboProcedure is the fully-qualified name of the GameServer's PlayCombo RPC.
+	GameServerPlayComboProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/PlayCombo"
+	// GameServerChooseCardProcedure is the fully-qualified name of the GameServer's ChooseCard RPC.
+	GameServerChooseCardProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/ChooseCard"
+	// GameServerDefuseKittenProcedure is the fully-qualified name of the GameServer's DefuseKitten RPC.
+	GameServerDefuseKittenProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/DefuseKitten"
+	// GameServerAlterFutureProcedure is the fully-qualified name of the GameServer's AlterFuture RPC.
+	GameServerAlterFutureProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/AlterFuture"
+	// GameServerDisconnectPlayerProcedure is the fully-qualified name of the GameServer's
+	// DisconnectPlayer RPC.
+	GameServerDisconnectPlayerProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/DisconnectPlayer"
+	// GameServerReconnectPlayerProcedure is the fully-qualified name of the GameServer's
+	// ReconnectPlayer RPC.
+	GameServerReconnectPlayerProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/ReconnectPlayer"
+	// GameServerListMatchHistoryProcedure is the fully-qualified name of the GameServer's
+	// ListMatchHistory RPC.
+	GameServerListMatchHistoryProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/ListMatchHistory"
+	// GameServerGetLeaderboardProcedure is the fully-qualified name of the GameServer's GetLeaderboard
+	// RPC.
+	GameServerGetLeaderboardProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/GetLeaderboard"
+	// GameServerGetPlayerRatingProcedure is the fully-qualified name of the GameServer's
+	// GetPlayerRating RPC.
+	GameServerGetPlayerRatingProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/GetPlayerRating"
+	// GameServerListAchievementsProcedure is the fully-qualified name of the GameServer's
+	// ListAchievements RPC.
+	GameServerListAchievementsProcedure = "/com.sweetloveinyourheart.kittens.games.GameServer/ListAchievements"
+)
+
+// GameServerClient is a client for the com.sweetloveinyourheart.kittens.games.GameServer service.
+type GameServerClient interface {
+	// Create a game for the participants of a started lobby
+	CreateGame(context.Context, *connect.Request[_go.CreateGameRequest]) (*connect.Response[_go.CreateGameResponse], error)
+	// Draw a card, which ends the turn
+	DrawCard(context.Context, *connect.Request[_go.DrawCardRequest]) (*connect.Response[_go.DrawCardResponse], error)
+	// Play a card from the hand
+	PlayCard(context.Context, *connect.Request[_go.PlayCardRequest]) (*connect.Response[_go.PlayCardResponse], error)
+	// Play a combo of cards
+	PlayCombo(context.Context, *connect.Request[_go.PlayComboRequest]) (*connect.Response[_go.PlayComboResponse], error)
+	// Choose a card asked by a Favor or a combo
+	ChooseCard(context.Context, *connect.Request[_go.ChooseCardRequest]) (*connect.Response[_go.ChooseCardResponse], error)
+	// Defuse a drawn exploding kitten, or put a drawn imploding kitten back face up
+	DefuseKitten(context.Context, *connect.Request[_go.DefuseKittenRequest]) (*connect.Response[_go.DefuseKittenResponse], error)
+	// Put the cards seen by an Alter the Future back in a new order
+	AlterFuture(context.Context, *connect.Request[_go.AlterFutureRequest]) (*connect.Response[_go.AlterFutureResponse], error)
+	// Start the grace period of a player whose streams all dropped, they are played for until they reconnect
+	DisconnectPlayer(context.Context, *connect.Request[_go.DisconnectPlayerRequest]) (*connect.Response[_go.DisconnectPlayerResponse], error)
+	// End the grace period of a disconnected player
+	ReconnectPlayer(context.Context, *connect.Request[_go.ReconnectPlayerRequest]) (*connect.Response[_go.ReconnectPlayerResponse], error)
+	// List the finished games of a player, the most recent first
+	ListMatchHistory(context.Context, *connect.Request[_go.ListMatchHistoryRequest]) (*connect.Response[_go.ListMatchHistoryResponse], error)
+	// List the best rated players
+	GetLeaderboard(context.Context, *connect.Request[_go.GetLeaderboardRequest]) (*connect.Response[_go.GetLeaderboardResponse], error)
+	// Get the rating of a player, with its recent changes
+	GetPlayerRating(context.Context, *connect.Request[_go.GetPlayerRatingRequest]) (*connect.Response[_go.GetPlayerRatingResponse], error)
+	// List the achievements unlocked by a player
+	ListAchievements(context.Context, *connect.Request[_go.ListAchievementsRequest]) (*connect.Response[_go.ListAchievementsResponse], error)
+}
+
+// NewGameServerClient constructs a client for the com.sweetloveinyourheart.kittens.games.GameServer
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGameServerClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GameServerClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	gameServerMethods := _go.File_gameserver_proto.Services().ByName("GameServer").Methods()
+	return &gameServerClient{
+		createGame: connect.NewClient[_go.CreateGameRequest, _go.CreateGameResponse](
+			httpClient,
+			baseURL+GameServerCreateGameProcedure,
+			connect.WithSchema(gameServerMethods.ByName("CreateGame")),
+			connect.WithClientOptions(opts...),
+		),
+		drawCard: connect.NewClient[_go.DrawCardRequest, _go.DrawCardResponse](
+			httpClient,
+			baseURL+GameServerDrawCardProcedure,
+			connect.WithSchema(gameServerMethods.ByName("DrawCard")),
+			connect.WithClientOptions(opts...),
+		),
+		playCard: connect.NewClient[_go.PlayCardRequest, _go.PlayCardResponse](
+			httpClient,
+			baseURL+GameServerPlayCardProcedure,
+			connect.WithSchema(gameServerMethods.ByName("PlayCard")),
+			connect.WithClientOptions(opts...),
+		),
+		playCombo: connect.NewClient[_go.PlayComboRequest, _go.PlayComboResponse](
+			httpClient,
+			baseURL+GameServerPlayComboProcedure,
+			connect.WithSchema(gameServerMethods.ByName("PlayCombo")),
+			connect.WithClientOptions(opts...),
+		),
+		chooseCard: connect.NewClient[_go.ChooseCardRequest, _go.ChooseCardResponse](
+			httpClient,
+			baseURL+GameServerChooseCardProcedure,
+			connect.WithSchema(gameServerMethods.ByName("ChooseCard")),
+			connect.WithClientOptions(opts...),
+		),
+		defuseKitten: connect.NewClient[_go.DefuseKittenRequest, _go.DefuseKittenResponse](
+			httpClient,
+			baseURL+GameServerDefuseKittenProcedure,
+			connect.WithSchema(gameServerMethods.ByName("DefuseKitten")),
+			connect.WithClientOptions(opts...),
+		),
+		alterFuture: connect.NewClient[_go.AlterFutureRequest, _go.AlterFutureResponse](
+			httpClient,
+			baseURL+GameServerAlterFutureProcedure,
+			connect.WithSchema(gameServerMethods.ByName("AlterFuture")),
+			connect.WithClientOptions(opts...),
+		),
+		disconnectPlayer: connect.NewClient[_go.DisconnectPlayerRequest, _go.DisconnectPlayerResponse](
+			httpClient,
+			baseURL+GameServerDisconnectPlayerProcedure,
+			connect.WithSchema(gameServerMethods.ByName("DisconnectPlayer")),
+			connect.WithClientOptions(opts...),
+		),
+		reconnectPlayer: connect.NewClient[_go.ReconnectPlayerRequest, _go.ReconnectPlayerResponse](
+			httpClient,
+			baseURL+GameServerReconnectPlayerProcedure,
+			connect.WithSchema(gameServerMethods.ByName("ReconnectPlayer")),
+			connect.WithClientOptions(opts...),
+		),
+		listMatchHistory: connect.NewClient[_go.ListMatchHistoryRequest, _go.ListMatchHistoryResponse](
+			httpClient,
+			baseURL+GameServerListMatchHistoryProcedure,
+			connect.WithSchema(gameServerMethods.ByName("ListMatchHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getLeaderboard: connect.NewClient[_go.GetLeaderboardRequest, _go.GetLeaderboardResponse](
+			httpClient,
+			baseURL+GameServerGetLeaderboardProcedure,
+			connect.WithSchema(gameServerMethods.ByName("GetLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlayerRating: connect.NewClient[_go.GetPlayerRatingRequest, _go.GetPlayerRatingResponse](
+			httpClient,
+			baseURL+GameServerGetPlayerRatingProcedure,
+			connect.WithSchema(gameServerMethods.ByName("GetPlayerRating")),
+			connect.WithClientOptions(opts...),
+		),
+		listAchievements: connect.NewClient[_go.ListAchievementsRequest, _go.ListAchievementsResponse](
+			httpClient,
+			baseURL+GameServerListAchievementsProcedure,
+			connect.WithSchema(gameServerMethods.ByName("ListAchievements")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// gameServerClient implements GameServerClient.
+type gameServerClient struct {
+	createGame       *connect.Client[_go.CreateGameRequest, _go.CreateGameResponse]
+	drawCard         *connect.Client[_go.DrawCardRequest, _go.DrawCardResponse]
+	playCard         *connect.Client[_go.PlayCardRequest, _go.PlayCardResponse]
+	playCombo        *connect.Client[_go.PlayComboRequest, _go.PlayComboResponse]
+	chooseCard       *connect.Client[_go.ChooseCardRequest, _go.ChooseCardResponse]
+	defuseKitten     *connect.Client[_go.DefuseKittenRequest, _go.DefuseKittenResponse]
+	alterFuture      *connect.Client[_go.AlterFutureRequest, _go.AlterFutureResponse]
+	disconnectPlayer *connect.Client[_go.DisconnectPlayerRequest, _go.DisconnectPlayerResponse]
+	reconnectPlayer  *connect.Client[_go.ReconnectPlayerRequest, _go.ReconnectPlayerResponse]
+	listMatchHistory *connect.Client[_go.ListMatchHistoryRequest, _go.ListMatchHistoryResponse]
+	getLeaderboard   *connect.Client[_go.GetLeaderboardRequest, _go.GetLeaderboardResponse]
+	getPlayerRating  *connect.Client[_go.GetPlayerRatingRequest, _go.GetPlayerRatingResponse]
+	listAchievements *connect.Client[_go.ListAchievementsRequest, _go.ListAchievementsResponse]
+}
+
+// CreateGame calls com.sweetloveinyourheart.kittens.games.GameServer.CreateGame.
+func (c *gameServerClient) CreateGame(ctx context.Context, req *connect.Request[_go.CreateGameRequest]) (*connect.Response[_go.CreateGameResponse], error) {
+	return c.createGame.CallUnary(ctx, req)
+}
+
+// DrawCard calls com.sweetloveinyourheart.kittens.games.GameServer.DrawCard.
+func (c *gameServerClient) DrawCard(ctx context.Context, req *connect.Request[_go.DrawCardRequest]) (*connect.Response[_go.DrawCardResponse], error) {
+	return c.drawCard.CallUnary(ctx, req)
+}
+
+// PlayCard calls com.sweetloveinyourheart.kittens.games.GameServer.PlayCard.
+func (c *gameServerClient) PlayCard(ctx context.Context, req *connect.Request[_go.PlayCardRequest]) (*connect.Response[_go.PlayCardResponse], error) {
+	return c.playCard.CallUnary(ctx, req)
+}
+
+// PlayCombo calls com.sweetloveinyourheart.kittens.games.GameServer.PlayCombo.
+func (c *gameServerClient) PlayCombo(ctx context.Context, req *connect.Request[_go.PlayComboRequest]) (*connect.Response[_go.PlayComboResponse], error) {
+	return c.playCombo.CallUnary(ctx, req)
+}
+
+// ChooseCard calls com.sweetloveinyourheart.kittens.games.GameServer.ChooseCard.
+func (c *gameServerClient) ChooseCard(ctx context.Context, req *connect.Request[_go.ChooseCardRequest]) (*connect.Response[_go.ChooseCardResponse], error) {
+	return c.chooseCard.CallUnary(ctx, req)
+}
+
+// DefuseKitten calls com.sweetloveinyourheart.kittens.games.GameServer.DefuseKitten.
+func (c *gameServerClient) DefuseKitten(ctx context.Context, req *connect.Request[_go.DefuseKittenRequest]) (*connect.Response[_go.DefuseKittenResponse], error) {
+	return c.defuseKitten.CallUnary(ctx, req)
+}
+
+// AlterFuture calls com.sweetloveinyourheart.kittens.games.GameServer.AlterFuture.
+func (c *gameServerClient) AlterFuture(ctx context.Context, req *connect.Request[_go.AlterFutureRequest]) (*connect.Response[_go.AlterFutureResponse], error) {
+	return c.alterFuture.CallUnary(ctx, req)
+}
+
+// DisconnectPlayer calls com.sweetloveinyourheart.kittens.games.GameServer.DisconnectPlayer.
+func (c *gameServerClient) DisconnectPlayer(ctx context.Context, req *connect.Request[_go.DisconnectPlayerRequest]) (*connect.Response[_go.DisconnectPlayerResponse], error) {
+	return c.disconnectPlayer.CallUnary(ctx, req)
+}
+
+// ReconnectPlayer calls com.sweetloveinyourheart.kittens.games.GameServer.ReconnectPlayer.
+func (c *gameServerClient) ReconnectPlayer(ctx context.Context, req *connect.Request[_go.ReconnectPlayerRequest]) (*connect.Response[_go.ReconnectPlayerResponse], error) {
+	return c.reconnectPlayer.CallUnary(ctx, req)
+}
+
+// ListMatchHistory calls com.sweetloveinyourheart.kittens.games.GameServer.ListMatchHistory.
+func (c *gameServerClient) ListMatchHistory(ctx context.Context, req *connect.Request[_go.ListMatchHistoryRequest]) (*connect.Response[_go.ListMatchHistoryResponse], error) {
+	return c.listMatchHistory.CallUnary(ctx, req)
+}
+
+// GetLeaderboard calls com.sweetloveinyourheart.kittens.games.GameServer.GetLeaderboard.
+func (c *gameServerClient) GetLeaderboard(ctx context.Context, req *connect.Request[_go.GetLeaderboardRequest]) (*connect.Response[_go.GetLeaderboardResponse], error) {
+	return c.getLeaderboard.CallUnary(ctx, req)
+}
+
+// GetPlayerRating calls com.sweetloveinyourheart.kittens.games.GameServer.GetPlayerRating.
+func (c *gameServerClient) GetPlayerRating(ctx context.Context, req *connect.Request[_go.GetPlayerRatingRequest]) (*connect.Response[_go.GetPlayerRatingResponse], error) {
+	return c.getPlayerRating.CallUnary(ctx, req)
+}
+
+// ListAchievements calls com.sweetloveinyourheart.kittens.games.GameServer.ListAchievements.
+func (c *gameServerClient) ListAchievements(ctx context.Context, req *connect.Request[_go.ListAchievementsRequest]) (*connect.Response[_go.ListAchievementsResponse], error) {
+	return c.listAchievements.CallUnary(ctx, req)
+}
+
+// GameServerHandler is an implementation of the com.sweetloveinyourheart.kittens.games.GameServer
+// service.
+type GameServerHandler interface {
+	// Create a game for the participants of a started lobby
+	CreateGame(context.Context, *connect.Request[_go.CreateGameRequest]) (*connect.Response[_go.CreateGameResponse], error)
+	// Draw a card, which ends the turn
+	DrawCard(context.Context, *connect.Request[_go.DrawCardRequest]) (*connect.Response[_go.DrawCardResponse], error)
+	// Play a card from the hand
+	PlayCard(context.Context, *connect.Request[_go.PlayCardRequest]) (*connect.Response[_go.PlayCardResponse], error)
+	// Play a combo of cards
+	PlayCombo(context.Context, *connect.Request[_go.PlayComboRequest]) (*connect.Response[_go.PlayComboResponse], error)
+	// Choose a card asked by a Favor or a combo
+	ChooseCard(context.Context, *connect.Request[_go.ChooseCardRequest]) (*connect.Response[_go.ChooseCardResponse], error)
+	// Defuse a drawn exploding kitten, or put a drawn imploding kitten back face up
+	DefuseKitten(context.Context, *connect.Request[_go.DefuseKittenRequest]) (*connect.Response[_go.DefuseKittenResponse], error)
+	// Put the cards seen by an Alter the Future back in a new order
+	AlterFuture(context.Context, *connect.Request[_go.AlterFutureRequest]) (*connect.Response[_go.AlterFutureResponse], error)
+	// Start the grace period of a player whose streams all dropped, they are played for until they reconnect
+	DisconnectPlayer(context.Context, *connect.Request[_go.DisconnectPlayerRequest]) (*connect.Response[_go.DisconnectPlayerResponse], error)
+	// End the grace period of a disconnected player
+	ReconnectPlayer(context.Context, *connect.Request[_go.ReconnectPlayerRequest]) (*connect.Response[_go.ReconnectPlayerResponse], error)
+	// List the finished games of a player, the most recent first
+	ListMatchHistory(context.Context, *connect.Request[_go.ListMatchHistoryRequest]) (*connect.Response[_go.ListMatchHistoryResponse], error)
+	// List the best rated players
+	GetLeaderboard(context.Context, *connect.Request[_go.GetLeaderboardRequest]) (*connect.Response[_go.GetLeaderboardResponse], error)
+	// Get the rating of a player, with its recent changes
+	GetPlayerRating(context.Context, *connect.Request[_go.GetPlayerRatingRequest]) (*connect.Response[_go.GetPlayerRatingResponse], error)
+	// List the achievements unlocked by a player
+	ListAchievements(context.Context, *connect.Request[_go.ListAchievementsRequest]) (*connect.Response[_go.ListAchievementsResponse], error)
+}
+
+// NewGameServerHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGameServerHandler(svc GameServerHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	gameServerMethods := _go.File_gameserver_proto.Services().ByName("GameServer").Methods()
+	gameServerCreateGameHandler := connect.NewUnaryHandler(
+		GameServerCreateGameProcedure,
+		svc.CreateGame,
+		connect.WithSchema(gameServerMethods.ByName("CreateGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerDrawCardHandler := connect.NewUnaryHandler(
+		GameServerDrawCardProcedure,
+		svc.DrawCard,
+		connect.WithSchema(gameServerMethods.ByName("DrawCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerPlayCardHandler := connect.NewUnaryHandler(
+		GameServerPlayCardProcedure,
+		svc.PlayCard,
+		connect.WithSchema(gameServerMethods.ByName("PlayCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerPlayComboHandler := connect.NewUnaryHandler(
+		GameServerPlayComboProcedure,
+		svc.PlayCombo,
+		connect.WithSchema(gameServerMethods.ByName("PlayCombo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerChooseCardHandler := connect.NewUnaryHandler(
+		GameServerChooseCardProcedure,
+		svc.ChooseCard,
+		connect.WithSchema(gameServerMethods.ByName("ChooseCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerDefuseKittenHandler := connect.NewUnaryHandler(
+		GameServerDefuseKittenProcedure,
+		svc.DefuseKitten,
+		connect.WithSchema(gameServerMethods.ByName("DefuseKitten")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerAlterFutureHandler := connect.NewUnaryHandler(
+		GameServerAlterFutureProcedure,
+		svc.AlterFuture,
+		connect.WithSchema(gameServerMethods.ByName("AlterFuture")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerDisconnectPlayerHandler := connect.NewUnaryHandler(
+		GameServerDisconnectPlayerProcedure,
+		svc.DisconnectPlayer,
+		connect.WithSchema(gameServerMethods.ByName("DisconnectPlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerReconnectPlayerHandler := connect.NewUnaryHandler(
+		GameServerReconnectPlayerProcedure,
+		svc.ReconnectPlayer,
+		connect.WithSchema(gameServerMethods.ByName("ReconnectPlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerListMatchHistoryHandler := connect.NewUnaryHandler(
+		GameServerListMatchHistoryProcedure,
+		svc.ListMatchHistory,
+		connect.WithSchema(gameServerMethods.ByName("ListMatchHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerGetLeaderboardHandler := connect.NewUnaryHandler(
+		GameServerGetLeaderboardProcedure,
+		svc.GetLeaderboard,
+		connect.WithSchema(gameServerMethods.ByName("GetLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerGetPlayerRatingHandler := connect.NewUnaryHandler(
+		GameServerGetPlayerRatingProcedure,
+		svc.GetPlayerRating,
+		connect.WithSchema(gameServerMethods.ByName("GetPlayerRating")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServerListAchievementsHandler := connect.NewUnaryHandler(
+		GameServerListAchievementsProcedure,
+		svc.ListAchievements,
+		connect.WithSchema(gameServerMethods.ByName("ListAchievements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/com.sweetloveinyourheart.kittens.games.GameServer/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GameServerCreateGameProcedure:
+			gameServerCreateGameHandler.ServeHTTP(w, r)
+		case GameServerDrawCardProcedure:
+			gameServerDrawCardHandler.ServeHTTP(w, r)
+		case GameServerPlayCardProcedure:
+			gameServerPlayCardHandler.ServeHTTP(w, r)
+		case GameServerPlayComboProcedure:
+			gameServerPlayComboHandler.ServeHTTP(w, r)
+		case GameServerChooseCardProcedure:
+			gameServerChooseCardHandler.ServeHTTP(w, r)
+		case GameServerDefuseKittenProcedure:
+			gameServerDefuseKittenHandler.ServeHTTP(w, r)
+		case GameServerAlterFutureProcedure:
+			gameServerAlterFutureHandler.ServeHTTP(w, r)
+		case GameServerDisconnectPlayerProcedure:
+			gameServerDisconnectPlayerHandler.ServeHTTP(w, r)
+		case GameServerReconnectPlayerProcedure:
+			gameServerReconnectPlayerHandler.ServeHTTP(w, r)
+		case GameServerListMatchHistoryProcedure:
+			gameServerListMatchHistoryHandler.ServeHTTP(w, r)
+		case GameServerGetLeaderboardProcedure:
+			gameServerGetLeaderboardHandler.ServeHTTP(w, r)
+		case GameServerGetPlayerRatingProcedure:
+			gameServerGetPlayerRatingHandler.ServeHTTP(w, r)
+		case GameServerListAchievementsProcedure:
+			gameServerListAchievementsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGameServerHandler returns CodeUnimplemented from all methods.
+type UnimplementedGameServerHandler struct{}
+
+func (UnimplementedGameServerHandler) CreateGame(context.Context, *connect.Request[_go.CreateGameRequest]) (*connect.Response[_go.CreateGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.CreateGame is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) DrawCard(context.Context, *connect.Request[_go.DrawCardRequest]) (*connect.Response[_go.DrawCardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.DrawCard is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) PlayCard(context.Context, *connect.Request[_go.PlayCardRequest]) (*connect.Response[_go.PlayCardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.PlayCard is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) PlayCombo(context.Context, *connect.Request[_go.PlayComboRequest]) (*connect.Response[_go.PlayComboResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.PlayCombo is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) ChooseCard(context.Context, *connect.Request[_go.ChooseCardRequest]) (*connect.Response[_go.ChooseCardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.ChooseCard is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) DefuseKitten(context.Context, *connect.Request[_go.DefuseKittenRequest]) (*connect.Response[_go.DefuseKittenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.DefuseKitten is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) AlterFuture(context.Context, *connect.Request[_go.AlterFutureRequest]) (*connect.Response[_go.AlterFutureResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.AlterFuture is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) DisconnectPlayer(context.Context, *connect.Request[_go.DisconnectPlayerRequest]) (*connect.Response[_go.DisconnectPlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.DisconnectPlayer is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) ReconnectPlayer(context.Context, *connect.Request[_go.ReconnectPlayerRequest]) (*connect.Response[_go.ReconnectPlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.ReconnectPlayer is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) ListMatchHistory(context.Context, *connect.Request[_go.ListMatchHistoryRequest]) (*connect.Response[_go.ListMatchHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.ListMatchHistory is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) GetLeaderboard(context.Context, *connect.Request[_go.GetLeaderboardRequest]) (*connect.Response[_go.GetLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.GetLeaderboard is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) GetPlayerRating(context.Context, *connect.Request[_go.GetPlayerRatingRequest]) (*connect.Response[_go.GetPlayerRatingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.GetPlayerRating is not implemented"))
+}
+
+func (UnimplementedGameServerHandler) ListAchievements(context.Context, *connect.Request[_go.ListAchievementsRequest]) (*connect.Response[_go.ListAchievementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("com.sweetloveinyourheart.kittens.games.GameServer.ListAchievements is not implemented"))
+}
@@ -0,0 +1,117 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/notifications"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	gameProto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
+)
+
+func (a *actions) ListAchievements(ctx context.Context, request *connect.Request[proto.ListAchievementsRequest]) (response *connect.Response[proto.ListAchievementsResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	if id := strings.TrimSpace(request.Msg.GetUserId()); id != "" {
+		userID, err = uuid.FromString(id)
+		if err != nil {
+			return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("user_id", err))
+		}
+	}
+
+	// The achievements are unlocked and kept by the gameserver.
+	res, err := a.gameServerClient.ListAchievements(ctx, connect.NewRequest(&gameProto.ListAchievementsRequest{
+		UserId: userID.String(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*proto.Achievement, 0, len(res.Msg.GetAchievements()))
+	for _, achievement := range res.Msg.GetAchievements() {
+		result = append(result, &proto.Achievement{
+			AchievementId: achievement.GetAchievementId(),
+			Name:          achievement.GetName(),
+			Description:   achievement.GetDescription(),
+			GameId:        achievement.GetGameId(),
+			UnlockedAt:    achievement.GetUnlockedAt(),
+		})
+	}
+
+	return connect.NewResponse(&proto.ListAchievementsResponse{
+		Achievements: result,
+	}), nil
+}
+
+func (a *actions) StreamNotifications(ctx context.Context, request *connect.Request[emptypb.Empty], stream *connect.ServerStream[proto.Notification]) error {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		return grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	notificationChan := make(chan *nats.Msg, constants.NatsChannelBufferSize)
+	subscription, err := a.bus.ChanSubscribe(notifications.UserSubject(userID), notificationChan)
+	if err != nil {
+		log.Global().ErrorContext(ctx, "Error subscribing to user notifications", zap.Error(err), zap.String("user_id", userID.String()))
+		return grpc.InternalError(err)
+	}
+	defer func() {
+		err := subscription.Unsubscribe()
+		if err != nil {
+			log.Global().ErrorContext(ctx, "Error unsubscribing from user notifications", zap.Error(err), zap.String("user_id", userID.String()))
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Global().InfoContext(ctx, "stream context done, closing stream", zap.String("user_id", userID.String()))
+			return ctx.Err()
+		case msg := <-notificationChan:
+			var notification notifications.Notification
+			if err := json.Unmarshal(msg.Data, &notification); err != nil {
+				log.Global().WarnContext(ctx, "Error reading user notification", zap.Error(err), zap.String("user_id", userID.String()))
+				continue
+			}
+
+			if err := stream.Send(notificationToProto(&notification)); err != nil {
+				log.Global().ErrorContext(ctx, "Error sending user notification", zap.Error(err), zap.String("user_id", userID.String()))
+				return err
+			}
+		}
+	}
+}
+
+func notificationToProto(notification *notifications.Notification) *proto.Notification {
+	result := &proto.Notification{
+		Type:      notification.Type.String(),
+		Timestamp: notification.Timestamp.UnixMilli(),
+	}
+
+	if unlocked := notification.AchievementUnlocked; unlocked != nil {
+		result.AchievementUnlocked = &proto.Achievement{
+			AchievementId: unlocked.AchievementID,
+			Name:          unlocked.Name,
+			Description:   unlocked.Description,
+			GameId:        unlocked.GameID.String(),
+			UnlockedAt:    notification.Timestamp.UnixMilli(),
+		}
+	}
+
+	return result
+}
@@ -3,14 +3,19 @@ package actions
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/nats-io/nats.go"
 	"github.com/samber/do"
 
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/chat"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/interceptors"
 	"github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go/grpcconnect"
+	gameServerConnect "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go/grpcconnect"
 	userServerConnect "github.com/sweetloveinyourheart/exploding-kittens/proto/code/userserver/go/grpcconnect"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
 )
 
 type actions struct {
@@ -18,7 +23,10 @@ type actions struct {
 	defaultAuth func(context.Context, string) (context.Context, error)
 	bus         *nats.Conn
 
+	chatFilter *chat.Filter
+
 	userServerClient userServerConnect.UserServerClient
+	gameServerClient gameServerConnect.GameServerClient
 }
 
 // AuthFuncOverride is a callback function that overrides the default authorization middleware in the GRPC layer. This is
@@ -36,10 +44,24 @@ func (a *actions) AuthFuncOverride(ctx context.Context, token string, fullMethod
 }
 
 func NewActions(ctx context.Context, signingToken string) *actions {
-	return &actions{
+	wordlist := chat.DefaultWordlist
+	if words := config.Instance().GetString("clientserver.chat.wordlist"); strings.TrimSpace(words) != "" {
+		wordlist = strings.Split(words, ",")
+	}
+
+	a := &actions{
 		context:          ctx,
 		defaultAuth:      interceptors.ConnectAuthHandler(signingToken),
 		bus:              do.MustInvokeNamed[*nats.Conn](nil, fmt.Sprintf("%s-conn", constants.Bus)),
+		chatFilter:       chat.NewFilter(wordlist),
 		userServerClient: do.MustInvoke[userServerConnect.UserServerClient](nil),
+		gameServerClient: do.MustInvoke[gameServerConnect.GameServerClient](nil),
 	}
+
+	// Every server sweeps the streams left open by the servers that went down.
+	if domains.Presence != nil {
+		go domains.Presence.Run(ctx, a.streamExpired)
+	}
+
+	return a
 }
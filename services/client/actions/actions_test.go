@@ -0,0 +1,120 @@
+package actions_test
+
+import (
+	"context"
+	"fmt"
+	goTesting "testing"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/samber/do"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/aggregate"
+	aggregateCommandHandler "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/command_handler/aggregate"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/command_handler/bus"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/event_store/memory"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/lobby"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+	gameProto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	gameServerConnect "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go/grpcconnect"
+	userServerConnect "github.com/sweetloveinyourheart/exploding-kittens/proto/code/userserver/go/grpcconnect"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
+)
+
+type ActionsSuite struct {
+	*testing.Suite
+	store      *memory.EventStore
+	gameServer *stubGameServer
+}
+
+func TestActionsSuite(t *goTesting.T) {
+	as := &ActionsSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, as)
+}
+
+// SetupTest runs the lobby aggregate on an in-memory event store, the lobbies are read back from the same events.
+func (as *ActionsSuite) SetupTest() {
+	var err error
+	as.store, err = memory.NewEventStore()
+	as.NoError(err)
+
+	aggregateStore, err := aggregate.NewAggregateStore(as.store)
+	as.NoError(err)
+
+	handler, err := aggregateCommandHandler.NewCommandHandler(lobby.AggregateType, aggregateStore)
+	as.NoError(err)
+
+	domains.CommandBus = bus.NewCommandHandler()
+	for _, command := range lobby.AllCommands {
+		as.NoError(domains.CommandBus.SetHandler(handler, command))
+	}
+	domains.LobbyRepo = &lobbyRepo{store: as.store}
+
+	// Without a connection the lobby updates are not published, which the actions report as an error.
+	do.OverrideNamedValue(nil, fmt.Sprintf("%s-conn", constants.Bus), (*nats.Conn)(nil))
+
+	as.gameServer = &stubGameServer{}
+	do.Override[gameServerConnect.GameServerClient](nil, func(i *do.Injector) (gameServerConnect.GameServerClient, error) {
+		return as.gameServer, nil
+	})
+	do.Override[userServerConnect.UserServerClient](nil, func(i *do.Injector) (userServerConnect.UserServerClient, error) {
+		return nil, nil
+	})
+}
+
+func (as *ActionsSuite) TearDownTest() {
+	as.NoError(as.store.Close())
+}
+
+// lobbyRepo projects a lobby from the events of the in-memory store.
+type lobbyRepo struct {
+	eventing.ReadRepo[lobby.Lobby, *lobby.Lobby]
+	store *memory.EventStore
+}
+
+func (r *lobbyRepo) Find(ctx context.Context, id string) (*lobby.Lobby, error) {
+	events, err := r.store.Load(ctx, id)
+	if errors.Is(err, eventing.ErrAggregateNotFound) {
+		return nil, eventing.ErrEntityNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return nil, eventing.ErrEntityNotFound
+	}
+
+	projector := lobby.NewProjector()
+	entity := &lobby.Lobby{}
+	for _, event := range events {
+		if entity, err = projector.Projector.Project(ctx, event, entity); err != nil {
+			return nil, err
+		}
+	}
+
+	return entity, nil
+}
+
+// stubGameServer records the created games and fails with err.
+type stubGameServer struct {
+	gameServerConnect.GameServerClient
+	created []*gameProto.CreateGameRequest
+	err     error
+}
+
+func (s *stubGameServer) CreateGame(ctx context.Context, request *connect.Request[gameProto.CreateGameRequest]) (*connect.Response[gameProto.CreateGameResponse], error) {
+	s.created = append(s.created, request.Msg)
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return connect.NewResponse(&gameProto.CreateGameResponse{GameId: request.Msg.GetGameId()}), nil
+}
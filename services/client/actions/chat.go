@@ -0,0 +1,152 @@
+package actions
+
+import (
+	"context"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/chat"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
+)
+
+func (a *actions) SendChatMessage(ctx context.Context, request *connect.Request[proto.SendChatMessageRequest]) (response *connect.Response[proto.SendChatMessageResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	channel, err := chat.ParseChannel(request.Msg.GetChannel(), request.Msg.GetChannelId())
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("channel", err))
+	}
+
+	text, err := chat.Text(request.Msg.GetText(), config.Instance().GetInt("clientserver.chat.max_length"))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("text", err))
+	}
+
+	if err := a.checkChatMember(ctx, channel, userID); err != nil {
+		return nil, err
+	}
+
+	if allowed, err := domains.ChatLimiter.Allow(ctx, userID); err != nil {
+		return nil, grpc.InternalError(err)
+	} else if !allowed {
+		return nil, grpc.ResourceExhaustedError(chat.ErrRateLimited)
+	}
+
+	message := &chat.Message{
+		MessageID: uuid.Must(uuid.NewV7()),
+		Channel:   channel,
+		UserID:    userID,
+		Text:      a.chatFilter.Clean(text),
+		Timestamp: timeutil.Clock.Now(),
+	}
+	if err := domains.ChatStore.Publish(ctx, message); err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	return connect.NewResponse(&proto.SendChatMessageResponse{
+		Message: chatMessageToProto(message),
+	}), nil
+}
+
+func (a *actions) StreamChat(ctx context.Context, request *connect.Request[proto.StreamChatRequest], stream *connect.ServerStream[proto.ChatMessage]) error {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		return grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	channel, err := chat.ParseChannel(request.Msg.GetChannel(), request.Msg.GetChannelId())
+	if err != nil {
+		return grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("channel", err))
+	}
+
+	if err := a.checkChatMember(ctx, channel, userID); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The recent messages come first, so the players who just joined catch up with the conversation.
+	messages, err := domains.ChatStore.Subscribe(ctx, channel)
+	if err != nil {
+		log.Global().ErrorContext(ctx, "Error subscribing to chat", zap.Error(err), zap.String("user_id", userID.String()), zap.String("subject", channel.Subject()))
+		return grpc.InternalError(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Global().InfoContext(ctx, "stream context done, closing stream", zap.String("user_id", userID.String()))
+			return ctx.Err()
+		case message, ok := <-messages:
+			if !ok {
+				return grpc.InternalError(errors.New("chat subscription closed"))
+			}
+
+			if err := stream.Send(chatMessageToProto(message)); err != nil {
+				log.Global().ErrorContext(ctx, "Error sending chat message", zap.Error(err), zap.String("user_id", userID.String()))
+				return err
+			}
+		}
+	}
+}
+
+// checkChatMember checks the user takes part in the lobby or the game of the channel.
+func (a *actions) checkChatMember(ctx context.Context, channel chat.Channel, userID uuid.UUID) error {
+	switch channel.Type {
+	case chat.ChannelTypeLobby:
+		lobbyState, err := domains.LobbyRepo.Find(ctx, channel.ID.String())
+		if err != nil {
+			if errors.Is(err, eventing.ErrEntityNotFound) {
+				return grpc.NotFoundError(errors.New("no such lobby"))
+			}
+
+			return grpc.InternalError(err)
+		}
+
+		if !slices.Contains(lobbyState.GetParticipants(), userID) {
+			return grpc.PermissionDeniedError(errors.New("user not part of the lobby"))
+		}
+	case chat.ChannelTypeGame:
+		gameState, err := domains.GameRepo.Find(ctx, channel.ID.String())
+		if err != nil {
+			if errors.Is(err, eventing.ErrEntityNotFound) {
+				return grpc.NotFoundError(errors.New("no such game"))
+			}
+
+			return grpc.InternalError(err)
+		}
+
+		if !slices.Contains(gameState.GetPlayerIDs(), userID) {
+			return grpc.PermissionDeniedError(errors.New("user not part of the game"))
+		}
+	}
+
+	return nil
+}
+
+func chatMessageToProto(message *chat.Message) *proto.ChatMessage {
+	return &proto.ChatMessage{
+		MessageId: message.MessageID.String(),
+		Channel:   message.Channel.Type.String(),
+		ChannelId: message.Channel.ID.String(),
+		UserId:    message.UserID.String(),
+		Text:      message.Text,
+		Timestamp: message.Timestamp.UnixMilli(),
+	}
+}
@@ -0,0 +1,39 @@
+package actions_test
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/lobby"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/actions"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
+)
+
+func (as *ActionsSuite) Test_SendChatMessage_NotAMember() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lobbyID := uuid.Must(uuid.NewV7())
+	as.NoError(domains.CommandBus.HandleCommand(ctx, &lobby.CreateLobby{LobbyID: lobbyID, LobbyCode: "KITTEN", LobbyName: "Kittens", HostUserID: uuid.Must(uuid.NewV7())}))
+
+	userCtx := context.WithValue(ctx, grpc.AuthToken, uuid.Must(uuid.NewV7()))
+	a := actions.NewActions(ctx, "test")
+
+	_, err := a.SendChatMessage(userCtx, connect.NewRequest(&proto.SendChatMessageRequest{
+		Channel:   "LOBBY",
+		ChannelId: lobbyID.String(),
+		Text:      "meow",
+	}))
+	as.Equal(connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = a.SendChatMessage(userCtx, connect.NewRequest(&proto.SendChatMessageRequest{
+		Channel:   "LOBBY",
+		ChannelId: uuid.Must(uuid.NewV7()).String(),
+		Text:      "meow",
+	}))
+	as.Equal(connect.CodeNotFound, connect.CodeOf(err))
+}
@@ -0,0 +1,181 @@
+package actions
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/emotes"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
+)
+
+func (a *actions) SendEmote(ctx context.Context, request *connect.Request[proto.SendEmoteRequest]) (response *connect.Response[proto.SendEmoteResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	if err := (*SendEmoteRequestValidator)(request.Msg).Validate(); err != nil {
+		return nil, err
+	}
+
+	gameState, err := a.findGameOfPlayer(ctx, request.Msg.GetGameId(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetPlayerID := uuid.FromStringOrNil(strings.TrimSpace(request.Msg.GetTargetPlayerId()))
+	if targetPlayerID != uuid.Nil && !slices.Contains(gameState.GetPlayerIDs(), targetPlayerID) {
+		return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "target_player_id", "not a player of the game"))
+	}
+
+	if allowed, err := domains.EmoteCooldown.Allow(ctx, userID); err != nil {
+		return nil, grpc.InternalError(err)
+	} else if !allowed {
+		return nil, grpc.ResourceExhaustedError(emotes.ErrCooldown)
+	}
+
+	// Emotes only go over NATS core, they never reach the game stream nor its replays.
+	if err := emotes.Publish(a.bus, &emotes.Message{
+		Emote:          emotes.Emote(strings.ToUpper(strings.TrimSpace(request.Msg.GetEmote()))),
+		GameID:         gameState.GetGameID(),
+		PlayerID:       userID,
+		TargetPlayerID: targetPlayerID,
+		Timestamp:      timeutil.Clock.Now(),
+	}); err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	return connect.NewResponse(&proto.SendEmoteResponse{
+		GameId: gameState.GetGameID().String(),
+	}), nil
+}
+
+func (a *actions) MuteEmotes(ctx context.Context, request *connect.Request[proto.MuteEmotesRequest]) (response *connect.Response[proto.MuteEmotesResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	if err := (*MuteEmotesRequestValidator)(request.Msg).Validate(); err != nil {
+		return nil, err
+	}
+
+	gameState, err := a.findGameOfPlayer(ctx, request.Msg.GetGameId(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	playerID := uuid.FromStringOrNil(strings.TrimSpace(request.Msg.GetPlayerId()))
+	if !slices.Contains(gameState.GetPlayerIDs(), playerID) {
+		return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "player_id", "not a player of the game"))
+	}
+
+	mute := &emotes.Mute{
+		UserID:   userID,
+		PlayerID: playerID,
+		Muted:    request.Msg.GetMuted(),
+	}
+
+	// The mute is kept for the streams opened later, the open ones are told right away.
+	if err := domains.Mutes.Set(ctx, gameState.GetGameID(), mute); err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	if err := emotes.PublishMute(a.bus, gameState.GetGameID(), mute); err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	return connect.NewResponse(&proto.MuteEmotesResponse{
+		GameId: gameState.GetGameID().String(),
+	}), nil
+}
+
+// findGameOfPlayer returns the game, unless the user does not play it.
+func (a *actions) findGameOfPlayer(ctx context.Context, gameID string, userID uuid.UUID) (*game.Game, error) {
+	gameState, err := domains.GameRepo.Find(ctx, strings.TrimSpace(gameID))
+	if err != nil {
+		if errors.Is(err, eventing.ErrEntityNotFound) {
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "no such game"))
+		}
+
+		return nil, grpc.NotFoundError(err)
+	}
+
+	if !slices.Contains(gameState.GetPlayerIDs(), userID) {
+		return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "not a player of the game"))
+	}
+
+	return gameState, nil
+}
+
+// emoteToProto converts an emote into its grpc message.
+func emoteToProto(message *emotes.Message) *proto.Emote {
+	return &proto.Emote{
+		Emote:          message.Emote.String(),
+		PlayerId:       message.PlayerID.String(),
+		TargetPlayerId: uuidString(message.TargetPlayerID),
+		Timestamp:      message.Timestamp.UnixMilli(),
+	}
+}
+
+type SendEmoteRequestValidator proto.SendEmoteRequest
+
+func (request *SendEmoteRequestValidator) Validate() error {
+	var fieldErrors []*errdetails.BadRequest_FieldViolation
+	_, err := uuid.FromString(strings.TrimSpace(request.GameId))
+	if err != nil {
+		fieldErrors = append(fieldErrors, grpc.FieldViolation("game_id", err))
+	}
+
+	if !emotes.Emote(strings.ToUpper(strings.TrimSpace(request.Emote))).IsValid() {
+		fieldErrors = append(fieldErrors, grpc.FieldViolation("emote", emotes.ErrInvalidEmote))
+	}
+
+	if target := strings.TrimSpace(request.TargetPlayerId); target != "" {
+		_, err := uuid.FromString(target)
+		if err != nil {
+			fieldErrors = append(fieldErrors, grpc.FieldViolation("target_player_id", err))
+		}
+	}
+
+	if fieldErrors == nil {
+		return nil
+	}
+
+	return grpc.InvalidArgumentErrorWithField(fieldErrors...)
+}
+
+type MuteEmotesRequestValidator proto.MuteEmotesRequest
+
+func (request *MuteEmotesRequestValidator) Validate() error {
+	var fieldErrors []*errdetails.BadRequest_FieldViolation
+	_, err := uuid.FromString(strings.TrimSpace(request.GameId))
+	if err != nil {
+		fieldErrors = append(fieldErrors, grpc.FieldViolation("game_id", err))
+	}
+
+	_, err = uuid.FromString(strings.TrimSpace(request.PlayerId))
+	if err != nil {
+		fieldErrors = append(fieldErrors, grpc.FieldViolation("player_id", err))
+	}
+
+	if fieldErrors == nil {
+		return nil
+	}
+
+	return grpc.InvalidArgumentErrorWithField(fieldErrors...)
+}
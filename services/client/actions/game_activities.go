@@ -0,0 +1,169 @@
+package actions
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	gameProto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
+)
+
+// The game commands are handled by the gameserver, which already reports the
+// rule violations as grpc errors, so its errors are returned as they are.
+
+func (a *actions) DrawCard(ctx context.Context, request *connect.Request[proto.DrawCardRequest]) (response *connect.Response[proto.DrawCardResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	gameID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetGameId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("game_id", err))
+	}
+
+	if _, err := a.gameServerClient.DrawCard(ctx, connect.NewRequest(&gameProto.DrawCardRequest{
+		GameId:   gameID.String(),
+		PlayerId: userID.String(),
+	})); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&proto.DrawCardResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) PlayCard(ctx context.Context, request *connect.Request[proto.PlayCardRequest]) (response *connect.Response[proto.PlayCardResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	gameID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetGameId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("game_id", err))
+	}
+
+	if _, err := a.gameServerClient.PlayCard(ctx, connect.NewRequest(&gameProto.PlayCardRequest{
+		GameId:         gameID.String(),
+		PlayerId:       userID.String(),
+		Card:           request.Msg.GetCard(),
+		TargetPlayerId: request.Msg.GetTargetPlayerId(),
+	})); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&proto.PlayCardResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) PlayCombo(ctx context.Context, request *connect.Request[proto.PlayComboRequest]) (response *connect.Response[proto.PlayComboResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	gameID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetGameId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("game_id", err))
+	}
+
+	if _, err := a.gameServerClient.PlayCombo(ctx, connect.NewRequest(&gameProto.PlayComboRequest{
+		GameId:         gameID.String(),
+		PlayerId:       userID.String(),
+		Cards:          request.Msg.GetCards(),
+		TargetPlayerId: request.Msg.GetTargetPlayerId(),
+		NamedCard:      request.Msg.GetNamedCard(),
+	})); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&proto.PlayComboResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) ChooseCard(ctx context.Context, request *connect.Request[proto.ChooseCardRequest]) (response *connect.Response[proto.ChooseCardResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	gameID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetGameId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("game_id", err))
+	}
+
+	if _, err := a.gameServerClient.ChooseCard(ctx, connect.NewRequest(&gameProto.ChooseCardRequest{
+		GameId:   gameID.String(),
+		PlayerId: userID.String(),
+		Card:     request.Msg.GetCard(),
+	})); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&proto.ChooseCardResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) DefuseKitten(ctx context.Context, request *connect.Request[proto.DefuseKittenRequest]) (response *connect.Response[proto.DefuseKittenResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	gameID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetGameId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("game_id", err))
+	}
+
+	if _, err := a.gameServerClient.DefuseKitten(ctx, connect.NewRequest(&gameProto.DefuseKittenRequest{
+		GameId:   gameID.String(),
+		PlayerId: userID.String(),
+		Position: request.Msg.GetPosition(),
+	})); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&proto.DefuseKittenResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) AlterFuture(ctx context.Context, request *connect.Request[proto.AlterFutureRequest]) (response *connect.Response[proto.AlterFutureResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	gameID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetGameId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("game_id", err))
+	}
+
+	if _, err := a.gameServerClient.AlterFuture(ctx, connect.NewRequest(&gameProto.AlterFutureRequest{
+		GameId:   gameID.String(),
+		PlayerId: userID.String(),
+		Cards:    request.Msg.GetCards(),
+	})); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&proto.AlterFutureResponse{
+		GameId: gameID.String(),
+	}), nil
+}
@@ -0,0 +1,120 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
+)
+
+func (a *actions) ReplayGame(ctx context.Context, request *connect.Request[proto.ReplayGameRequest], stream *connect.ServerStream[proto.ReplayGameReply]) error {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		return grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	if err := (*ReplayGameRequestValidator)(request.Msg).Validate(); err != nil {
+		return err
+	}
+
+	gameState, err := domains.GameRepo.Find(ctx, strings.TrimSpace(request.Msg.GetGameId()))
+	if err != nil {
+		if errors.Is(err, eventing.ErrEntityNotFound) {
+			return grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "no such game"))
+		}
+
+		return grpc.InternalError(err)
+	}
+
+	// The events of a game in progress are never loaded.
+	if !gameState.GetFinished() {
+		return grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "game is not finished"))
+	}
+
+	// The hands of the players are only shown to the players of the game.
+	if request.Msg.GetGodView() && !slices.Contains(gameState.GetPlayerIDs(), userID) {
+		return grpc.PreconditionError(grpc.PreconditionFailure("state", "god_view", "not a player of the game"))
+	}
+
+	// The replay is read from the event stream, the projection only holds the last state of the game.
+	events, err := game.LoadGameEvents(ctx, gameState.GetGameID())
+	if err != nil {
+		return grpc.InternalError(err)
+	}
+
+	steps, err := game.Replay(ctx, events, request.Msg.GetGodView())
+	if err != nil {
+		if errors.Is(err, game.ErrGameNotFinished) {
+			return grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "game is not finished"))
+		}
+
+		return grpc.InternalError(err)
+	}
+
+	for i, step := range steps {
+		data, err := json.Marshal(step.Data)
+		if err != nil {
+			return grpc.InternalError(err)
+		}
+
+		if err := stream.Send(&proto.ReplayGameReply{
+			Event: &proto.GameReplayEvent{
+				Sequence:  int32(i + 1),
+				EventType: step.EventType.String(),
+				Timestamp: step.Timestamp.UnixMilli(),
+				Data:      string(data),
+				Hands:     handsToProto(step.Hands),
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handsToProto converts the hands of a god view into their grpc messages, ordered by player id.
+func handsToProto(hands map[uuid.UUID][]game.CardType) []*proto.GameHand {
+	playerIDs := slices.SortedFunc(maps.Keys(hands), func(a, b uuid.UUID) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	result := make([]*proto.GameHand, 0, len(hands))
+	for _, playerID := range playerIDs {
+		result = append(result, &proto.GameHand{
+			PlayerId: playerID.String(),
+			Cards:    cardsToStrings(hands[playerID]),
+		})
+	}
+
+	return result
+}
+
+type ReplayGameRequestValidator proto.ReplayGameRequest
+
+func (request *ReplayGameRequestValidator) Validate() error {
+	var fieldErrors []*errdetails.BadRequest_FieldViolation
+	_, err := uuid.FromString(strings.TrimSpace(request.GameId))
+	if err != nil {
+		fieldErrors = append(fieldErrors, grpc.FieldViolation("game_id", err))
+	}
+
+	if fieldErrors == nil {
+		return nil
+	}
+
+	return grpc.InvalidArgumentErrorWithField(fieldErrors...)
+}
@@ -0,0 +1,209 @@
+package actions
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"github.com/zmwangx/debounce"
+	"go.uber.org/zap"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/presence"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains/match"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
+)
+
+// delayedReply is a view of the game held back from the spectator until At.
+type delayedReply struct {
+	At    time.Time
+	Reply *proto.GetGameReply
+}
+
+func (a *actions) SpectateGame(ctx context.Context, request *connect.Request[proto.GetGameRequest], stream *connect.ServerStream[proto.GetGameReply]) error {
+	errContext, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var streamError error
+
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		return grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	if err := (*GetGameRequestValidator)(request.Msg).Validate(); err != nil {
+		return err
+	}
+
+	gameState, err := domains.GameRepo.Find(ctx, strings.TrimSpace(request.Msg.GetGameId()))
+	if err != nil {
+		if errors.Is(err, eventing.ErrEntityNotFound) {
+			return grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "no such game"))
+		}
+
+		return grpc.NotFoundError(err)
+	}
+
+	// Players stream their own view, a delayed public one would only help them ghost their own game.
+	if slices.Contains(gameState.GetPlayerIDs(), userID) {
+		return grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "a player of the game cannot spectate it"))
+	}
+
+	lobbyState, err := domains.LobbyRepo.Find(ctx, gameState.GetLobbyID().String())
+	if err != nil {
+		if errors.Is(err, eventing.ErrEntityNotFound) {
+			return grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "no such lobby"))
+		}
+
+		return grpc.NotFoundError(err)
+	}
+
+	if !lobbyState.GetAllowSpectators() {
+		return grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "the lobby does not allow spectators"))
+	}
+
+	gameID := gameState.GetGameID()
+	// The players see the new spectator count once the registry reports the stream.
+	closeStream, err := a.openGameStream(ctx, gameID, userID, presence.KindSpectator)
+	if err != nil {
+		return err
+	}
+	defer closeStream()
+
+	// The delay is the one the game was started with, the lobby keeps it until the next game.
+	delay := lobbyState.GetSpectatorDelay()
+	delayed := make(chan delayedReply, 64)
+
+	// Every send goes through the mutex, and none is made once the handler is done with the stream.
+	mux := &sync.Mutex{}
+	send := func(reply *proto.GetGameReply) {
+		mux.Lock()
+		defer mux.Unlock()
+
+		if errContext.Err() != nil {
+			return
+		}
+
+		if err := stream.Send(reply); err != nil {
+			log.Global().ErrorContext(ctx, "Error sending game data", zap.Error(err), zap.String("user_id", userID.String()))
+			streamError = err
+			cancel()
+		}
+	}
+
+	sendData := func() {
+		gameState, err := domains.GameRepo.Find(ctx, gameID.String())
+		if err != nil {
+			mux.Lock()
+			defer mux.Unlock()
+
+			if errors.Is(err, eventing.ErrEntityNotFound) {
+				streamError = grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "no such game"))
+				cancel()
+				return
+			}
+			streamError = err
+			cancel()
+			return
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Only the public view leaves the server, spectators never see a hand.
+		reply := &proto.GetGameReply{
+			Game: gameViewToProto(game.NewSpectatorView(gameState)),
+		}
+		reply.Game.SpectatorCount = a.spectatorCount(gameID)
+
+		if delay <= 0 {
+			send(reply)
+			return
+		}
+
+		select {
+		case delayed <- delayedReply{At: time.Now().Add(delay), Reply: reply}:
+		case <-errContext.Done():
+		}
+	}
+
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+
+		// A throttled sendData may still be running, it finds the context done.
+		mux.Lock()
+		defer mux.Unlock()
+	}()
+
+	if delay > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-errContext.Done():
+					return
+				case item := <-delayed:
+					timer := time.NewTimer(time.Until(item.At))
+					select {
+					case <-errContext.Done():
+						timer.Stop()
+						return
+					case <-timer.C:
+					}
+					send(item.Reply)
+				}
+			}
+		}()
+	}
+
+	sendData()
+
+	debounced, control := debounce.Throttle(sendData, 100*time.Millisecond)
+	keepAlive := time.NewTimer(KeepAliveTimeout)
+	defer func() {
+		if !keepAlive.Stop() {
+			// drain the timer chan
+			select {
+			case <-keepAlive.C:
+			default:
+			}
+		}
+		control.Cancel()
+	}()
+
+	unsubscribeGame := domains.GameSubscriber.SubscribeMatch(match.MatchGameID(gameID), func(_ string, _ any) {
+		debounced()
+		keepAlive.Reset(KeepAliveTimeout)
+	})
+	defer unsubscribeGame()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Global().InfoContext(ctx, "stream context done, closing stream", zap.String("user_id", userID.String()))
+			return ctx.Err()
+		case <-errContext.Done():
+			log.Global().WarnContext(ctx, "error context done, closing stream", zap.String("user_id", userID.String()))
+			mux.Lock()
+			defer mux.Unlock()
+			return streamError
+		case <-keepAlive.C:
+			debounced()
+			keepAlive.Reset(KeepAliveTimeout)
+		}
+	}
+}
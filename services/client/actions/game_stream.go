@@ -0,0 +1,338 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/zmwangx/debounce"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/emotes"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/presence"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains/match"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
+)
+
+func (a *actions) StreamGame(ctx context.Context, request *connect.Request[proto.GetGameRequest], stream *connect.ServerStream[proto.GetGameReply]) error {
+	errContext, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var streamError error
+
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		return grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	if err := (*GetGameRequestValidator)(request.Msg).Validate(); err != nil {
+		return err
+	}
+
+	gameState, err := domains.GameRepo.Find(ctx, strings.TrimSpace(request.Msg.GetGameId()))
+	if err != nil {
+		if errors.Is(err, eventing.ErrEntityNotFound) {
+			return grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "no such game"))
+		}
+
+		return grpc.NotFoundError(err)
+	}
+
+	if !slices.Contains(gameState.GetPlayerIDs(), userID) {
+		return grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "not a player of the game"))
+	}
+
+	closeStream, err := a.openGameStream(ctx, gameState.GetGameID(), userID, presence.KindGame)
+	if err != nil {
+		return err
+	}
+	defer closeStream()
+
+	// A player coming back gets the full state of the game in the first reply.
+	closePresence := a.trackPresence(ctx, gameState, userID)
+	defer closePresence()
+
+	mux := &sync.Mutex{}
+	sendData := func() {
+		mux.Lock()
+		defer mux.Unlock()
+
+		gameState, err := domains.GameRepo.Find(ctx, gameState.GetGameID().String())
+		if err != nil {
+			if errors.Is(err, eventing.ErrEntityNotFound) {
+				streamError = grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "no such game"))
+				cancel()
+				return
+			}
+			streamError = err
+			cancel()
+			return
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				// Handle and log the panic so it does not cause an entire system crash
+				log.Global().ErrorContext(ctx, "recovered stream panic", zap.Any("panic", r), zap.String("user_id", userID.String()))
+				streamError = errors.WithStack(errors.New("recovered stream panic"))
+				cancel()
+				return
+			}
+		}()
+
+		// Only the redacted view of the player leaves the server, never the game state itself.
+		reply := &proto.GetGameReply{
+			Game: gameViewToProto(game.NewPlayerView(gameState, userID)),
+		}
+		reply.Game.SpectatorCount = a.spectatorCount(gameState.GetGameID())
+
+		err = stream.Send(reply)
+		if err != nil {
+			log.Global().ErrorContext(ctx, "Error sending game data", zap.Error(err), zap.String("user_id", userID.String()))
+			streamError = err
+			cancel()
+			return
+		}
+	}
+
+	sendData()
+
+	debounced, control := debounce.Throttle(sendData, 100*time.Millisecond)
+	keepAlive := time.NewTimer(KeepAliveTimeout)
+	defer func() {
+		if !keepAlive.Stop() {
+			// drain the timer chan
+			select {
+			case <-keepAlive.C:
+			default:
+			}
+		}
+		control.Cancel()
+	}()
+
+	if streamError != nil {
+		return streamError
+	}
+
+	unsubscribeGame := domains.GameSubscriber.SubscribeMatch(match.MatchGameID(gameState.GetGameID()), func(_ string, _ any) {
+		debounced()
+		keepAlive.Reset(KeepAliveTimeout)
+	})
+	defer unsubscribeGame()
+
+	muteSubject := emotes.MuteSubject(gameState.GetGameID(), userID)
+	emoteChan := make(chan *nats.Msg, constants.NatsChannelBufferSize)
+	for _, subject := range []string{emotes.GameSubject(gameState.GetGameID()), muteSubject} {
+		subscription, err := a.bus.ChanSubscribe(subject, emoteChan)
+		if err != nil {
+			log.Global().ErrorContext(ctx, "Error subscribing to emotes", zap.Error(err), zap.String("user_id", userID.String()))
+			return grpc.InternalError(err)
+		}
+		defer func() {
+			if err := subscription.Unsubscribe(); err != nil {
+				log.Global().ErrorContext(ctx, "Error unsubscribing from emotes", zap.Error(err), zap.String("user_id", userID.String()))
+			}
+		}()
+	}
+
+	// The players the user muted before the stream opened, the mutes published since are applied on top.
+	muted, err := domains.Mutes.Muted(ctx, gameState.GetGameID(), userID)
+	if err != nil {
+		log.Global().ErrorContext(ctx, "Error reading emote mutes", zap.Error(err), zap.String("user_id", userID.String()))
+		return grpc.InternalError(err)
+	}
+	sendEmote := func(msg *nats.Msg) {
+		if msg.Subject == muteSubject {
+			var mute emotes.Mute
+			if err := json.Unmarshal(msg.Data, &mute); err != nil {
+				log.Global().WarnContext(ctx, "Error reading emote mute", zap.Error(err), zap.String("user_id", userID.String()))
+				return
+			}
+			muted[mute.PlayerID] = mute.Muted
+			return
+		}
+
+		var emote emotes.Message
+		if err := json.Unmarshal(msg.Data, &emote); err != nil {
+			log.Global().WarnContext(ctx, "Error reading emote", zap.Error(err), zap.String("user_id", userID.String()))
+			return
+		}
+		if muted[emote.PlayerID] {
+			return
+		}
+
+		mux.Lock()
+		defer mux.Unlock()
+
+		if err := stream.Send(&proto.GetGameReply{Emote: emoteToProto(&emote)}); err != nil {
+			log.Global().ErrorContext(ctx, "Error sending emote", zap.Error(err), zap.String("user_id", userID.String()))
+			streamError = err
+			cancel()
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Global().InfoContext(ctx, "stream context done, closing stream", zap.String("user_id", userID.String()))
+			return ctx.Err()
+		case <-errContext.Done():
+			log.Global().WarnContext(ctx, "error context done, closing stream", zap.String("user_id", userID.String()))
+			return streamError
+		case msg := <-emoteChan:
+			sendEmote(msg)
+		case <-keepAlive.C:
+			debounced()
+			keepAlive.Reset(KeepAliveTimeout)
+		}
+	}
+}
+
+// gameViewToProto converts the view of a player into its grpc message.
+func gameViewToProto(view *game.PlayerView) *proto.Game {
+	reply := &proto.Game{
+		GameId:                  view.GameID.String(),
+		LobbyId:                 view.LobbyID.String(),
+		Hand:                    cardsToStrings(view.Hand),
+		Players:                 make([]*proto.GamePlayer, 0, len(view.Players)),
+		DrawPileSize:            int32(view.DrawPileSize),
+		DiscardPileSize:         int32(view.DiscardPileSize),
+		DiscardTop:              view.DiscardTop.String(),
+		CurrentPlayerId:         uuidString(view.CurrentPlayerID),
+		TurnsRemaining:          int32(view.TurnsRemaining),
+		PendingDefusePlayerId:   uuidString(view.PendingDefusePlayerID),
+		Finished:                view.Finished,
+		WinnerId:                uuidString(view.WinnerID),
+		Reversed:                view.Reversed,
+		Expansions:              expansionsToStrings(view.Expansions),
+		ImplodingKittenPosition: int32(view.ImplodingKittenPosition),
+		HandHidden:              view.HandHidden,
+	}
+
+	if !view.TurnDeadline.IsZero() {
+		reply.TurnDeadline = view.TurnDeadline.UnixMilli()
+	}
+
+	for _, player := range view.Players {
+		gamePlayer := &proto.GamePlayer{
+			PlayerId:     player.PlayerID.String(),
+			CardCount:    int32(player.CardCount),
+			Eliminated:   player.Eliminated,
+			MarkedCards:  cardsToStrings(player.MarkedCards),
+			Cursed:       player.Cursed,
+			Afk:          player.AFK,
+			Bot:          player.Bot,
+			Disconnected: player.Disconnected,
+			RevealedHand: cardsToStrings(player.RevealedHand),
+		}
+		if !player.ReconnectDeadline.IsZero() {
+			gamePlayer.ReconnectDeadline = player.ReconnectDeadline.UnixMilli()
+		}
+
+		reply.Players = append(reply.Players, gamePlayer)
+	}
+
+	if action := view.PendingAction; action != nil {
+		reply.PendingAction = &proto.GameAction{
+			PlayerId:       action.PlayerID.String(),
+			Card:           action.Card.String(),
+			Combo:          action.Combo.String(),
+			Cards:          cardsToStrings(action.Cards),
+			TargetPlayerId: uuidString(action.TargetPlayerID),
+			NamedCard:      action.NamedCard.String(),
+			NopeCount:      int32(action.NopeCount),
+			Deadline:       action.Deadline.UnixMilli(),
+		}
+	}
+
+	if interaction := view.PendingInteraction; interaction != nil {
+		reply.PendingInteraction = &proto.GameInteraction{
+			Kind:           interaction.Kind.String(),
+			PlayerId:       interaction.PlayerID.String(),
+			TargetPlayerId: uuidString(interaction.TargetPlayerID),
+			ChooserId:      interaction.ChooserID.String(),
+			Deadline:       interaction.Deadline.UnixMilli(),
+		}
+	}
+
+	if reveal := view.Reveal; reveal != nil {
+		reply.Reveal = &proto.GameReveal{
+			Kind:          string(reveal.Kind),
+			Cards:         cardsToStrings(reveal.Cards),
+			OtherPlayerId: uuidString(reveal.OtherPlayerID),
+		}
+	}
+
+	return reply
+}
+
+func cardsToStrings(cards []game.CardType) []string {
+	result := make([]string, 0, len(cards))
+	for _, card := range cards {
+		result = append(result, card.String())
+	}
+
+	return result
+}
+
+func expansionsToStrings(expansions []game.Expansion) []string {
+	result := make([]string, 0, len(expansions))
+	for _, expansion := range expansions {
+		result = append(result, expansion.String())
+	}
+
+	return result
+}
+
+// botsToStrings returns the difficulty of each bot keyed by the string of its id.
+func botsToStrings(bots map[uuid.UUID]game.BotDifficulty) map[string]string {
+	result := make(map[string]string, len(bots))
+	for botID, difficulty := range bots {
+		result[botID.String()] = difficulty.String()
+	}
+
+	return result
+}
+
+// uuidString returns the string of an optional id, uuid.Nil is an empty string.
+func uuidString(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+type GetGameRequestValidator proto.GetGameRequest
+
+func (request *GetGameRequestValidator) Validate() error {
+	var fieldErrors []*errdetails.BadRequest_FieldViolation
+	_, err := uuid.FromString(strings.TrimSpace(request.GameId))
+	if err != nil {
+		fieldErrors = append(fieldErrors, grpc.FieldViolation("game_id", err))
+	}
+
+	if fieldErrors == nil {
+		return nil
+	}
+
+	return grpc.InvalidArgumentErrorWithField(fieldErrors...)
+}
@@ -6,18 +6,25 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/cockroachdb/errors"
 	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
 	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/lobby"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/password"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/stringsutil"
 	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	gameProto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
 	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
 	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
 )
@@ -29,17 +36,51 @@ func (a *actions) CreateLobby(ctx context.Context, request *connect.Request[prot
 		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
 	}
 
+	expansions := make([]game.Expansion, 0, len(request.Msg.GetExpansions()))
+	for _, name := range request.Msg.GetExpansions() {
+		expansion := game.Expansion(strings.ToUpper(strings.TrimSpace(name)))
+		if !expansion.IsValid() {
+			return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("expansions", game.ErrInvalidExpansion))
+		}
+		expansions = append(expansions, expansion)
+	}
+
+	visibility := lobby.VisibilityPublic
+	if name := strings.TrimSpace(request.Msg.GetVisibility()); name != "" {
+		visibility = lobby.Visibility(strings.ToUpper(name))
+	}
+
+	// Only the hash of the password is kept in the lobby.
+	var passwordHash string
+	if secret := request.Msg.GetPassword(); secret != "" {
+		passwordHash, err = password.Hash(secret)
+		if err != nil {
+			return nil, grpc.InternalError(err)
+		}
+	}
+
 	lobbyId := uuid.Must(uuid.NewV7())
 	lobbyName := request.Msg.GetLobbyName()
 	lobbyCode, _ := stringsutil.GenerateRandomCode(6) // LobbyCode have 6 characters
 
 	if err := domains.CommandBus.HandleCommand(ctx, &lobby.CreateLobby{
-		LobbyID:    lobbyId,
-		LobbyCode:  lobbyCode,
-		LobbyName:  lobbyName,
-		HostUserID: userID,
+		LobbyID:         lobbyId,
+		LobbyCode:       lobbyCode,
+		LobbyName:       lobbyName,
+		HostUserID:      userID,
+		Expansions:      expansions,
+		AllowSpectators: request.Msg.GetAllowSpectators(),
+		SpectatorDelay:  time.Duration(request.Msg.GetSpectatorDelay()) * time.Millisecond,
+		Ranked:          request.Msg.GetRanked(),
+		MaxPlayers:      int(request.Msg.GetMaxPlayers()),
+		Visibility:      visibility,
+		PasswordHash:    passwordHash,
 	}); err != nil {
-		if errors.Is(err, lobby.ErrLobbyAlreadyCreated) {
+		var fieldErr *common.CommandFieldError
+		switch {
+		case errors.As(err, &fieldErr):
+			return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation(fieldErr.Field, fieldErr))
+		case errors.Is(err, lobby.ErrLobbyAlreadyCreated):
 			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby already created"))
 		}
 
@@ -58,16 +99,26 @@ func (a *actions) JoinLobby(ctx context.Context, request *connect.Request[proto.
 		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
 	}
 
-	lobbies, err := domains.LobbyRepo.FindAll(ctx)
-	if err != nil {
-		return nil, grpc.NotFoundError(err)
-	}
+	lobbyCode := strings.TrimSpace(request.Msg.GetLobbyCode())
 
 	var lobbyState *lobby.Lobby
-	for _, lobby := range lobbies {
-		if lobby.LobbyCode == request.Msg.GetLobbyCode() {
-			lobbyState = lobby
-			break
+	if lobbyCode == "" {
+		// Public lobbies can also be joined by their id, the aggregate refuses the other ones.
+		lobbyState, err = domains.LobbyRepo.Find(ctx, strings.TrimSpace(request.Msg.GetLobbyId()))
+		if err != nil {
+			return nil, grpc.NotFoundError(errors.New("lobby not found"))
+		}
+	} else {
+		lobbies, err := domains.LobbyRepo.FindAll(ctx)
+		if err != nil {
+			return nil, grpc.NotFoundError(err)
+		}
+
+		for _, lobby := range lobbies {
+			if lobby.LobbyCode == lobbyCode {
+				lobbyState = lobby
+				break
+			}
 		}
 	}
 
@@ -82,11 +133,24 @@ func (a *actions) JoinLobby(ctx context.Context, request *connect.Request[proto.
 	lobbyID := lobbyState.GetLobbyID()
 
 	if err := domains.CommandBus.HandleCommand(ctx, &lobby.JoinLobby{
-		LobbyID: lobbyID,
-		UserID:  userID,
+		LobbyID:   lobbyID,
+		UserID:    userID,
+		LobbyCode: lobbyCode,
+		Password:  request.Msg.GetPassword(),
 	}); err != nil {
-		if errors.Is(err, lobby.ErrLobbyNotAvailable) {
+		switch {
+		case errors.Is(err, lobby.ErrLobbyNotAvailable):
 			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby is not available"))
+		case errors.Is(err, lobby.ErrLobbyAlreadyStarted):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby already started"))
+		case errors.Is(err, lobby.ErrAlreadyLobbyParticipant):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "user_id", "user is already in the lobby"))
+		case errors.Is(err, lobby.ErrLobbyNotPublic):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_code", "lobby can only be joined with its code"))
+		case errors.Is(err, lobby.ErrInvalidLobbyPassword):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "password", "invalid lobby password"))
+		case errors.Is(err, lobby.ErrLobbyFull):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "participants", fmt.Sprintf("a lobby takes at most %d participants", lobbyState.GetCapacity())))
 		}
 
 		return nil, grpc.InternalError(err)
@@ -132,6 +196,10 @@ func (a *actions) LeaveLobby(ctx context.Context, request *connect.Request[proto
 			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby is not availale"))
 		}
 
+		if errors.Is(err, lobby.ErrLobbyAlreadyStarted) {
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "game of the lobby is not finished"))
+		}
+
 		return nil, grpc.InternalError(err)
 	}
 
@@ -145,6 +213,256 @@ func (a *actions) LeaveLobby(ctx context.Context, request *connect.Request[proto
 	}), nil
 }
 
+func (a *actions) StartGame(ctx context.Context, request *connect.Request[proto.StartGameRequest]) (response *connect.Response[proto.StartGameResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	lobbyState, err := domains.LobbyRepo.Find(ctx, request.Msg.GetLobbyId())
+	if err != nil {
+		if errors.Is(err, eventing.ErrEntityNotFound) {
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "no such lobby"))
+		}
+
+		return nil, grpc.NotFoundError(err)
+	}
+
+	lobbyID := lobbyState.GetLobbyID()
+	gameID := uuid.Must(uuid.NewV7())
+
+	events, err := domains.CommandBus.HandleCommandEx(ctx, &lobby.StartGame{
+		LobbyID: lobbyID,
+		UserID:  userID,
+		GameID:  gameID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, lobby.ErrLobbyNotAvailable):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby is not available"))
+		case errors.Is(err, lobby.ErrLobbyAlreadyStarted):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby already started"))
+		case errors.Is(err, lobby.ErrNotLobbyHost):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "user_id", "only the host can start the game"))
+		case errors.Is(err, lobby.ErrInvalidParticipantCount):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "participants", fmt.Sprintf("a game needs between %d and %d participants", lobby.MinParticipants, lobbyState.GetCapacity())))
+		}
+
+		return nil, grpc.InternalError(err)
+	}
+
+	// The game is created from the started event rather than the projection,
+	// so it is played with exactly the settings the lobby was locked with.
+	var started *lobby.LobbyStarted
+	for _, event := range events {
+		if data, ok := event.Data().(*lobby.LobbyStarted); ok {
+			started = data
+		}
+	}
+	if started == nil {
+		return nil, grpc.InternalError(errors.New("the lobby was started without a started event"))
+	}
+
+	// The gameserver already reports its errors as grpc errors.
+	if _, err := a.gameServerClient.CreateGame(ctx, connect.NewRequest(&gameProto.CreateGameRequest{
+		GameId:     gameID.String(),
+		LobbyId:    lobbyID.String(),
+		PlayerIds:  stringsutil.ConvertUUIDsToStrings(started.GetParticipants()),
+		Expansions: expansionsToStrings(started.GetExpansions()),
+		Bots:       botsToStrings(started.GetBots()),
+		Ranked:     started.GetRanked(),
+		Rules:      gameRulesToGameProto(started.GetRules()),
+	})); err != nil {
+		// The lobby was locked for a game that does not exist, it goes back to waiting so the host can try again.
+		// The request may have been cancelled, the lobby is put back whatever happened to the caller.
+		if abortErr := domains.CommandBus.HandleCommand(a.context, &lobby.AbortStart{
+			LobbyID: lobbyID,
+			GameID:  gameID,
+		}); abortErr != nil {
+			log.Global().ErrorContext(ctx, "failed to abort the start of the lobby", zap.String("lobby_id", lobbyID.String()), zap.Error(abortErr))
+		} else if emitErr := a.emitLobbyUpdateEvent(lobbyID); emitErr != nil {
+			log.Global().ErrorContext(ctx, "failed to emit the lobby update", zap.String("lobby_id", lobbyID.String()), zap.Error(emitErr))
+		}
+
+		return nil, err
+	}
+
+	err = a.emitLobbyUpdateEvent(lobbyID)
+	if err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	return connect.NewResponse(&proto.StartGameResponse{
+		LobbyId: lobbyID.String(),
+		GameId:  gameID.String(),
+	}), nil
+}
+
+func (a *actions) AddBot(ctx context.Context, request *connect.Request[proto.AddBotRequest]) (response *connect.Response[proto.AddBotResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	difficulty := game.BotDifficulty(strings.ToUpper(strings.TrimSpace(request.Msg.GetDifficulty())))
+	if !difficulty.IsValid() {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("difficulty", errors.New("unknown difficulty")))
+	}
+
+	lobbyState, err := domains.LobbyRepo.Find(ctx, request.Msg.GetLobbyId())
+	if err != nil {
+		if errors.Is(err, eventing.ErrEntityNotFound) {
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "no such lobby"))
+		}
+
+		return nil, grpc.NotFoundError(err)
+	}
+
+	lobbyID := lobbyState.GetLobbyID()
+	botID := uuid.Must(uuid.NewV7())
+
+	if err := domains.CommandBus.HandleCommand(ctx, &lobby.AddBot{
+		LobbyID:    lobbyID,
+		UserID:     userID,
+		BotID:      botID,
+		Difficulty: difficulty,
+	}); err != nil {
+		switch {
+		case errors.Is(err, lobby.ErrLobbyNotAvailable):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby is not available"))
+		case errors.Is(err, lobby.ErrLobbyAlreadyStarted):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby already started"))
+		case errors.Is(err, lobby.ErrNotLobbyHost):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "user_id", "only the host can add bots"))
+		case errors.Is(err, lobby.ErrLobbyFull):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "participants", fmt.Sprintf("a lobby takes at most %d participants", lobbyState.GetCapacity())))
+		}
+
+		return nil, grpc.InternalError(err)
+	}
+
+	err = a.emitLobbyUpdateEvent(lobbyID)
+	if err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	return connect.NewResponse(&proto.AddBotResponse{
+		LobbyId: lobbyID.String(),
+		BotId:   botID.String(),
+	}), nil
+}
+
+func (a *actions) VoteRematch(ctx context.Context, request *connect.Request[proto.VoteRematchRequest]) (response *connect.Response[proto.VoteRematchResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	lobbyState, err := domains.LobbyRepo.Find(ctx, request.Msg.GetLobbyId())
+	if err != nil {
+		if errors.Is(err, eventing.ErrEntityNotFound) {
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "no such lobby"))
+		}
+
+		return nil, grpc.NotFoundError(err)
+	}
+
+	if lobbyState.GetGameID() == uuid.Nil {
+		return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby is not started"))
+	}
+
+	lobbyID := lobbyState.GetLobbyID()
+
+	events, err := domains.CommandBus.HandleCommandEx(ctx, &lobby.VoteRematch{
+		LobbyID: lobbyID,
+		UserID:  userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, lobby.ErrLobbyNotAvailable):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby is not available"))
+		case errors.Is(err, lobby.ErrLobbyNotStarted):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby is not started"))
+		case errors.Is(err, lobby.ErrLobbyGameNotFinished):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", "game is not finished"))
+		case errors.Is(err, lobby.ErrNotLobbyParticipant):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "user_id", "user not part of the lobby"))
+		case errors.Is(err, lobby.ErrRematchAlreadyVoted):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "user_id", "user already voted for a rematch"))
+		}
+
+		return nil, grpc.InternalError(err)
+	}
+
+	reopened := false
+	for _, event := range events {
+		if event.EventType() == lobby.EventTypeLobbyReopened {
+			reopened = true
+		}
+	}
+
+	err = a.emitLobbyUpdateEvent(lobbyID)
+	if err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	return connect.NewResponse(&proto.VoteRematchResponse{
+		LobbyId:  lobbyID.String(),
+		Reopened: reopened,
+	}), nil
+}
+
+func (a *actions) UpdateLobbySettings(ctx context.Context, request *connect.Request[proto.UpdateLobbySettingsRequest]) (response *connect.Response[proto.UpdateLobbySettingsResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	lobbyState, err := domains.LobbyRepo.Find(ctx, request.Msg.GetLobbyId())
+	if err != nil {
+		if errors.Is(err, eventing.ErrEntityNotFound) {
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "no such lobby"))
+		}
+
+		return nil, grpc.NotFoundError(err)
+	}
+
+	lobbyID := lobbyState.GetLobbyID()
+
+	if err := domains.CommandBus.HandleCommand(ctx, &lobby.UpdateSettings{
+		LobbyID: lobbyID,
+		UserID:  userID,
+		Rules:   game.GameRulesFromProto(request.Msg.GetRules()),
+	}); err != nil {
+		var fieldErr *common.CommandFieldError
+		switch {
+		case errors.As(err, &fieldErr):
+			return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation(fieldErr.Field, fieldErr))
+		case errors.Is(err, lobby.ErrLobbyNotAvailable):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby is not available"))
+		case errors.Is(err, lobby.ErrLobbyAlreadyStarted):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "lobby_id", "lobby already started"))
+		case errors.Is(err, lobby.ErrNotLobbyHost):
+			return nil, grpc.PreconditionError(grpc.PreconditionFailure("state", "user_id", "only the host can change the settings"))
+		}
+
+		return nil, grpc.InternalError(err)
+	}
+
+	err = a.emitLobbyUpdateEvent(lobbyID)
+	if err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	return connect.NewResponse(&proto.UpdateLobbySettingsResponse{
+		LobbyId: lobbyID.String(),
+	}), nil
+}
+
 func (a *actions) emitLobbyUpdateEvent(lobbyID uuid.UUID) error {
 	msg := &proto.Lobby{
 		LobbyId: lobbyID.String(),
@@ -163,6 +481,32 @@ func (a *actions) emitLobbyUpdateEvent(lobbyID uuid.UUID) error {
 	return nil
 }
 
+// gameRulesToProto converts the house rules of a lobby for the clients.
+func gameRulesToProto(rules game.GameRules) *proto.GameRules {
+	return &proto.GameRules{
+		ReactionWindow:        rules.ReactionWindow.Milliseconds(),
+		TurnTimeout:           rules.TurnTimeout.Milliseconds(),
+		Defuses:               int32(rules.Defuses),
+		NopeDefuse:            rules.NopeDefuse,
+		RevealEliminatedHands: rules.RevealEliminatedHands,
+		RemovedCards:          cardsToStrings(rules.RemovedCards),
+		EliminateDisconnected: rules.EliminateDisconnected,
+	}
+}
+
+// gameRulesToGameProto converts the house rules of a lobby for the gameserver.
+func gameRulesToGameProto(rules game.GameRules) *gameProto.GameRules {
+	return &gameProto.GameRules{
+		ReactionWindow:        rules.ReactionWindow.Milliseconds(),
+		TurnTimeout:           rules.TurnTimeout.Milliseconds(),
+		Defuses:               int32(rules.Defuses),
+		NopeDefuse:            rules.NopeDefuse,
+		RevealEliminatedHands: rules.RevealEliminatedHands,
+		RemovedCards:          cardsToStrings(rules.RemovedCards),
+		EliminateDisconnected: rules.EliminateDisconnected,
+	}
+}
+
 type GetLobbyRequestValidator proto.GetLobbyRequest
 
 func (request *GetLobbyRequestValidator) Validate() error {
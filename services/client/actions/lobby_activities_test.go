@@ -0,0 +1,70 @@
+package actions_test
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/lobby"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/actions"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
+)
+
+func (as *ActionsSuite) Test_StartGame_CreateGameFails() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lobbyID := uuid.Must(uuid.NewV7())
+	hostID := uuid.Must(uuid.NewV7())
+	playerID := uuid.Must(uuid.NewV7())
+	as.NoError(domains.CommandBus.HandleCommand(ctx, &lobby.CreateLobby{LobbyID: lobbyID, LobbyCode: "KITTEN", LobbyName: "Kittens", HostUserID: hostID}))
+	as.NoError(domains.CommandBus.HandleCommand(ctx, &lobby.JoinLobby{LobbyID: lobbyID, UserID: playerID}))
+
+	as.gameServer.err = connect.NewError(connect.CodeUnavailable, errors.New("gameserver is down"))
+
+	resp, err := actions.NewActions(ctx, "test").StartGame(context.WithValue(ctx, grpc.AuthToken, hostID), connect.NewRequest(&proto.StartGameRequest{
+		LobbyId: lobbyID.String(),
+	}))
+	as.Nil(resp)
+	as.Equal(connect.CodeUnavailable, connect.CodeOf(err))
+	as.Len(as.gameServer.created, 1)
+
+	// The lobby is not left waiting for a game that was never created.
+	lobbyState, err := domains.LobbyRepo.Find(ctx, lobbyID.String())
+	as.NoError(err)
+	as.Equal(uuid.Nil, lobbyState.GetGameID())
+
+	as.NoError(domains.CommandBus.HandleCommand(ctx, &lobby.StartGame{LobbyID: lobbyID, UserID: hostID, GameID: uuid.Must(uuid.NewV7())}))
+}
+
+func (as *ActionsSuite) Test_AddBot_LobbyFull() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lobbyID := uuid.Must(uuid.NewV7())
+	hostID := uuid.Must(uuid.NewV7())
+	as.NoError(domains.CommandBus.HandleCommand(ctx, &lobby.CreateLobby{LobbyID: lobbyID, LobbyCode: "KITTEN", LobbyName: "Kittens", HostUserID: hostID, MaxPlayers: 2}))
+	as.NoError(domains.CommandBus.HandleCommand(ctx, &lobby.JoinLobby{LobbyID: lobbyID, UserID: uuid.Must(uuid.NewV7())}))
+
+	resp, err := actions.NewActions(ctx, "test").AddBot(context.WithValue(ctx, grpc.AuthToken, hostID), connect.NewRequest(&proto.AddBotRequest{
+		LobbyId:    lobbyID.String(),
+		Difficulty: "EASY",
+	}))
+	as.Nil(resp)
+	as.Equal(connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	// The host lowered the capacity of the lobby below what the game allows.
+	var connectErr *connect.Error
+	as.True(errors.As(err, &connectErr))
+	as.Len(connectErr.Details(), 1)
+	detail, err := connectErr.Details()[0].Value()
+	as.NoError(err)
+	violation, ok := detail.(*errdetails.PreconditionFailure_Violation)
+	as.True(ok)
+	as.Equal("a lobby takes at most 2 participants", violation.GetDescription())
+}
@@ -3,6 +3,7 @@ package actions
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -50,6 +51,17 @@ func (a *actions) StreamLobby(ctx context.Context, request *connect.Request[prot
 		return grpc.NotFoundError(err)
 	}
 
+	// The players of the game of the lobby stay connected while they only follow the lobby.
+	if gameID := lobbyState.GetGameID(); gameID != uuid.Nil && slices.Contains(lobbyState.GetParticipants(), userID) {
+		gameState, err := domains.GameRepo.Find(ctx, gameID.String())
+		if err == nil {
+			closePresence := a.trackPresence(ctx, gameState, userID)
+			defer closePresence()
+		} else if !errors.Is(err, eventing.ErrEntityNotFound) {
+			log.Global().WarnContext(ctx, "Error finding game of lobby", zap.Error(err), zap.String("lobby_id", request.Msg.GetLobbyId()))
+		}
+	}
+
 	lobbyChan := make(chan *nats.Msg, constants.NatsChannelBufferSize)
 	lobbyUpdateStream, err := a.bus.ChanSubscribe(fmt.Sprintf("%s.%s", constants.LobbyStream, request.Msg.GetLobbyId()), lobbyChan)
 	if err != nil {
@@ -94,13 +106,29 @@ func (a *actions) StreamLobby(ctx context.Context, request *connect.Request[prot
 			}
 		}()
 
+		gameID := ""
+		if lobbyState.GetGameID() != uuid.Nil {
+			gameID = lobbyState.GetGameID().String()
+		}
+
 		reply := &proto.GetLobbyReply{
 			Lobby: &proto.Lobby{
-				LobbyId:      lobbyState.GetLobbyID().String(),
-				LobbyCode:    lobbyState.GetLobbyCode(),
-				LobbyName:    lobbyState.GetLobbyName(),
-				HostUserId:   lobbyState.GetHostUserID().String(),
-				Participants: stringsutil.ConvertUUIDsToStrings(lobbyState.GetParticipants()),
+				LobbyId:         lobbyState.GetLobbyID().String(),
+				LobbyCode:       lobbyState.GetLobbyCode(),
+				LobbyName:       lobbyState.GetLobbyName(),
+				HostUserId:      lobbyState.GetHostUserID().String(),
+				Participants:    stringsutil.ConvertUUIDsToStrings(lobbyState.GetParticipants()),
+				GameId:          gameID,
+				Expansions:      expansionsToStrings(lobbyState.GetExpansions()),
+				Bots:            botsToStrings(lobbyState.GetBots()),
+				AllowSpectators: lobbyState.GetAllowSpectators(),
+				RematchVotes:    stringsutil.ConvertUUIDsToStrings(lobbyState.GetRematchVotes()),
+				Ranked:          lobbyState.GetRanked(),
+				Rules:           gameRulesToProto(lobbyState.GetRules()),
+				MaxPlayers:      int32(lobbyState.GetCapacity()),
+				Visibility:      lobbyState.GetVisibility().String(),
+				HasPassword:     lobbyState.GetHasPassword(),
+				SpectatorDelay:  lobbyState.GetSpectatorDelay().Milliseconds(),
 			},
 		}
 
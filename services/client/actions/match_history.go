@@ -0,0 +1,60 @@
+package actions
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	gameProto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
+)
+
+func (a *actions) ListMatchHistory(ctx context.Context, request *connect.Request[proto.ListMatchHistoryRequest]) (response *connect.Response[proto.ListMatchHistoryResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	// The match history is kept by the gameserver, which also validates the cursor.
+	res, err := a.gameServerClient.ListMatchHistory(ctx, connect.NewRequest(&gameProto.ListMatchHistoryRequest{
+		UserId: userID.String(),
+		Cursor: request.Msg.GetCursor(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*proto.Match, 0, len(res.Msg.GetMatches()))
+	for _, match := range res.Msg.GetMatches() {
+		participants := make([]*proto.MatchParticipant, 0, len(match.GetParticipants()))
+		for _, participant := range match.GetParticipants() {
+			participants = append(participants, &proto.MatchParticipant{
+				PlayerId:         participant.GetPlayerId(),
+				FinishPosition:   participant.GetFinishPosition(),
+				Bot:              participant.GetBot(),
+				EliminationCause: participant.GetEliminationCause(),
+				EliminatedAt:     participant.GetEliminatedAt(),
+			})
+		}
+
+		matches = append(matches, &proto.Match{
+			GameId:       match.GetGameId(),
+			LobbyId:      match.GetLobbyId(),
+			WinnerId:     match.GetWinnerId(),
+			Expansions:   match.GetExpansions(),
+			StartedAt:    match.GetStartedAt(),
+			FinishedAt:   match.GetFinishedAt(),
+			Duration:     match.GetDuration(),
+			Participants: participants,
+		})
+	}
+
+	return connect.NewResponse(&proto.ListMatchHistoryResponse{
+		Matches:    matches,
+		NextCursor: res.Msg.GetNextCursor(),
+	}), nil
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/presence"
+	gameProto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
+)
+
+// presenceTimeout bounds the calls made to the game server when a stream opens or closes.
+const presenceTimeout = 10 * time.Second
+
+// trackPresence registers a stream of a player on their game, reconnecting the player if their
+// grace period is running. The returned func closes the stream, the player is disconnected once
+// their last stream is closed, whichever server it was opened on.
+func (a *actions) trackPresence(ctx context.Context, gameState *game.Game, userID uuid.UUID) func() {
+	if gameState.GetFinished() || !gameState.IsPlayer(userID) || gameState.IsBot(userID) {
+		return func() {}
+	}
+
+	gameID := gameState.GetGameID()
+	stream, err := domains.Presence.Open(ctx, gameID, userID, presence.KindPlayer)
+	if err != nil {
+		// The stream is served all the same, the player is only not told apart from a disconnected one.
+		log.Global().WarnContext(ctx, "Error registering player stream", zap.Error(err), zap.String("game_id", gameID.String()), zap.String("user_id", userID.String()))
+		return func() {}
+	}
+
+	if gameState.IsDisconnected(userID) {
+		a.reconnectPlayer(ctx, gameID, userID)
+	}
+
+	return func() {
+		// The stream context is already done, the player is disconnected on behalf of the server.
+		ctx, cancel := context.WithTimeout(a.context, presenceTimeout)
+		defer cancel()
+
+		if err := stream.Close(ctx); err != nil {
+			log.Global().WarnContext(ctx, "Error closing player stream", zap.Error(err), zap.String("game_id", gameID.String()), zap.String("user_id", userID.String()))
+		}
+
+		a.leaveGame(ctx, gameID, userID)
+	}
+}
+
+// openGameStream registers a stream on a game against the stream limit of the game, shared by all
+// the servers. The returned func closes the stream.
+func (a *actions) openGameStream(ctx context.Context, gameID uuid.UUID, userID uuid.UUID, kind presence.Kind) (func(), error) {
+	stream, ok, err := domains.Presence.OpenLimited(ctx, gameID, userID, kind, config.Instance().GetInt("clientserver.game.max_streams"))
+	if err != nil {
+		log.Global().ErrorContext(ctx, "Error registering game stream", zap.Error(err), zap.String("game_id", gameID.String()), zap.String("user_id", userID.String()))
+		return nil, grpc.InternalError(err)
+	}
+	if !ok {
+		return nil, grpc.ResourceExhaustedError(helpers.ErrTooManyStreams)
+	}
+
+	return func() {
+		ctx, cancel := context.WithTimeout(a.context, presenceTimeout)
+		defer cancel()
+
+		if err := stream.Close(ctx); err != nil {
+			log.Global().WarnContext(ctx, "Error closing game stream", zap.Error(err), zap.String("game_id", gameID.String()), zap.String("user_id", userID.String()))
+		}
+	}, nil
+}
+
+// spectatorCount returns the number of spectators following a game on all the servers.
+func (a *actions) spectatorCount(gameID uuid.UUID) int32 {
+	return int32(domains.Spectators.Get(gameID))
+}
+
+// streamExpired handles the streams of the servers that went down without closing them.
+func (a *actions) streamExpired(ctx context.Context, key presence.Key) {
+	if key.Kind != presence.KindPlayer {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, presenceTimeout)
+	defer cancel()
+
+	a.leaveGame(ctx, key.GameID, key.UserID)
+}
+
+// leaveGame disconnects a player who has no stream left on any server.
+func (a *actions) leaveGame(ctx context.Context, gameID uuid.UUID, userID uuid.UUID) {
+	if streams, err := domains.Presence.Count(ctx, gameID, presence.KindPlayer, userID); err != nil || streams > 0 {
+		if err != nil {
+			log.Global().WarnContext(ctx, "Error counting player streams", zap.Error(err), zap.String("game_id", gameID.String()), zap.String("user_id", userID.String()))
+		}
+		return
+	}
+
+	if _, err := a.gameServerClient.DisconnectPlayer(ctx, connect.NewRequest(&gameProto.DisconnectPlayerRequest{
+		GameId:   gameID.String(),
+		PlayerId: userID.String(),
+	})); err != nil {
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			log.Global().WarnContext(ctx, "Error disconnecting player", zap.Error(err), zap.String("game_id", gameID.String()), zap.String("user_id", userID.String()))
+		}
+		return
+	}
+
+	// A stream opened on another server in the meantime may have found the player still connected.
+	if streams, err := domains.Presence.Count(ctx, gameID, presence.KindPlayer, userID); err == nil && streams > 0 {
+		a.reconnectPlayer(ctx, gameID, userID)
+	}
+}
+
+func (a *actions) reconnectPlayer(ctx context.Context, gameID uuid.UUID, userID uuid.UUID) {
+	if _, err := a.gameServerClient.ReconnectPlayer(ctx, connect.NewRequest(&gameProto.ReconnectPlayerRequest{
+		GameId:   gameID.String(),
+		PlayerId: userID.String(),
+	})); err != nil && connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		log.Global().WarnContext(ctx, "Error reconnecting player", zap.Error(err), zap.String("game_id", gameID.String()), zap.String("user_id", userID.String()))
+	}
+}
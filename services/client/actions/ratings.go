@@ -0,0 +1,88 @@
+package actions
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/clientserver/go"
+	gameProto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/client/helpers"
+)
+
+// The ratings are kept by the gameserver, which also validates the period and the page.
+
+func (a *actions) GetLeaderboard(ctx context.Context, request *connect.Request[proto.GetLeaderboardRequest]) (response *connect.Response[proto.GetLeaderboardResponse], err error) {
+	if _, ok := ctx.Value(grpc.AuthToken).(uuid.UUID); !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	res, err := a.gameServerClient.GetLeaderboard(ctx, connect.NewRequest(&gameProto.GetLeaderboardRequest{
+		Period: request.Msg.GetPeriod(),
+		Page:   request.Msg.GetPage(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := make([]*proto.PlayerRating, 0, len(res.Msg.GetRatings()))
+	for _, rating := range res.Msg.GetRatings() {
+		ratings = append(ratings, playerRatingToProto(rating))
+	}
+
+	return connect.NewResponse(&proto.GetLeaderboardResponse{
+		Ratings: ratings,
+		HasMore: res.Msg.GetHasMore(),
+	}), nil
+}
+
+func (a *actions) GetPlayerRating(ctx context.Context, request *connect.Request[proto.GetPlayerRatingRequest]) (response *connect.Response[proto.GetPlayerRatingResponse], err error) {
+	userID, ok := ctx.Value(grpc.AuthToken).(uuid.UUID)
+	if !ok {
+		// This should never happen as this endpoint should be authenticated
+		return nil, grpc.UnauthenticatedError(helpers.ErrInvalidSession)
+	}
+
+	if id := strings.TrimSpace(request.Msg.GetUserId()); id != "" {
+		userID, err = uuid.FromString(id)
+		if err != nil {
+			return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("user_id", err))
+		}
+	}
+
+	res, err := a.gameServerClient.GetPlayerRating(ctx, connect.NewRequest(&gameProto.GetPlayerRatingRequest{
+		UserId: userID.String(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*proto.RatingChange, 0, len(res.Msg.GetHistory()))
+	for _, change := range res.Msg.GetHistory() {
+		history = append(history, &proto.RatingChange{
+			GameId:         change.GetGameId(),
+			RatingBefore:   change.GetRatingBefore(),
+			RatingAfter:    change.GetRatingAfter(),
+			FinishPosition: change.GetFinishPosition(),
+			FinishedAt:     change.GetFinishedAt(),
+		})
+	}
+
+	return connect.NewResponse(&proto.GetPlayerRatingResponse{
+		Rating:  playerRatingToProto(res.Msg.GetRating()),
+		History: history,
+	}), nil
+}
+
+func playerRatingToProto(rating *gameProto.PlayerRating) *proto.PlayerRating {
+	return &proto.PlayerRating{
+		PlayerId: rating.GetPlayerId(),
+		Rank:     rating.GetRank(),
+		Rating:   rating.GetRating(),
+		Games:    rating.GetGames(),
+	}
+}
@@ -4,12 +4,22 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/samber/do"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/chat"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
 	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/command_handler/bus"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
 	consumerinvalidator "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/middleware/consumer_invalidator"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/lobby"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/emotes"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/presence"
 	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains"
 	"github.com/sweetloveinyourheart/exploding-kittens/services/client/domains/match"
 )
@@ -45,5 +55,56 @@ func InitializeRepos(ctx context.Context) error {
 		return err
 	}
 
+	gameMw := consumerinvalidator.NewMiddleware(eventing.MatchEvents(game.AllEventTypes), func(ctx context.Context, event common.Event) {
+		if game, ok := event.Data().(match.GameIDer); ok {
+			domains.GameSubscriber.Publish(game.GetGameID().String(), struct{}{})
+		}
+	})
+
+	domains.GameRepo, err = game.CreateNATSRepoGames(ctx, appID, gameMw)
+	if err != nil {
+		return err
+	}
+
+	js, err := jetstream.New(do.MustInvokeNamed[*nats.Conn](nil, fmt.Sprintf("%s-conn", constants.Bus)))
+	if err != nil {
+		return err
+	}
+
+	domains.ChatStore, err = chat.NewStore(ctx, js, config.Instance().GetInt("clientserver.chat.history_size"))
+	if err != nil {
+		return err
+	}
+
+	domains.ChatLimiter, err = chat.NewSharedRateLimiter(ctx, js, constants.ChatLimitBucket, config.Instance().GetInt("clientserver.chat.rate_limit"), config.Instance().GetDuration("clientserver.chat.rate_window"))
+	if err != nil {
+		return err
+	}
+
+	domains.EmoteCooldown, err = chat.NewSharedRateLimiter(ctx, js, constants.EmoteLimitBucket, 1, config.Instance().GetDuration("clientserver.emotes.cooldown"))
+	if err != nil {
+		return err
+	}
+
+	domains.Mutes, err = emotes.NewMuteStore(ctx, js)
+	if err != nil {
+		return err
+	}
+
+	domains.Presence, err = presence.NewRegistry(ctx, js, config.Instance().GetDuration("clientserver.presence.heartbeat"))
+	if err != nil {
+		return err
+	}
+
+	// The players see the spectators joining and leaving on any server.
+	domains.Spectators = presence.NewCounter()
+	err = domains.Presence.Watch(ctx, presence.KindSpectator, func(gameID uuid.UUID, count int) {
+		domains.Spectators.Set(gameID, count)
+		domains.GameSubscriber.Publish(gameID.String(), struct{}{})
+	})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -3,9 +3,13 @@ package domains
 import (
 	"github.com/juju/pubsub/v2"
 
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/chat"
 	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/command_handler/bus"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/lobby"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/emotes"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/presence"
 )
 
 // LobbyRepo is the repository for the Lobby aggregate.
@@ -13,4 +17,27 @@ var LobbyRepo eventing.ReadRepo[lobby.Lobby, *lobby.Lobby]
 
 var LobbySubscriber = pubsub.NewSimpleHub(&pubsub.SimpleHubConfig{})
 
+// GameRepo is the repository for the Game aggregate.
+var GameRepo eventing.ReadRepo[game.Game, *game.Game]
+
+var GameSubscriber = pubsub.NewSimpleHub(&pubsub.SimpleHubConfig{})
+
 var CommandBus *bus.CommandHandler
+
+// ChatStore keeps the recent messages of the lobby and game chats.
+var ChatStore *chat.Store
+
+// ChatLimiter limits the chat messages of each user on all the clientservers.
+var ChatLimiter *chat.SharedRateLimiter
+
+// EmoteCooldown lets each user send a single emote per cooldown on all the clientservers.
+var EmoteCooldown *chat.SharedRateLimiter
+
+// Mutes keeps the players each user muted in their games.
+var Mutes *emotes.MuteStore
+
+// Presence keeps the streams the players have open on their games, on every clientserver.
+var Presence *presence.Registry
+
+// Spectators keeps the number of spectators following each game, on every clientserver.
+var Spectators *presence.Counter
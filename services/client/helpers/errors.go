@@ -5,4 +5,5 @@ import "github.com/cockroachdb/errors"
 var (
 	ErrInvalidLogin   = errors.New("invalid login")
 	ErrInvalidSession = errors.New("invalid session")
+	ErrTooManyStreams = errors.New("too many streams on the game")
 )
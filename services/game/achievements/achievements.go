@@ -0,0 +1,113 @@
+package achievements
+
+import (
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/projectors"
+)
+
+// Achievement is a badge a user unlocks once.
+type Achievement struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+var (
+	BombSquad = Achievement{
+		ID:          "BOMB_SQUAD",
+		Name:        "Bomb Squad",
+		Description: "Defuse 3 kittens in one game",
+	}
+	LastWord = Achievement{
+		ID:          "LAST_WORD",
+		Name:        "The Last Word",
+		Description: "Win a game in which you played the fourth Nope of a chain",
+	}
+	TopCat = Achievement{
+		ID:          "TOP_CAT",
+		Name:        "Top Cat",
+		Description: "Win 10 games",
+	}
+)
+
+// All lists every achievement, in the order they are shown.
+var All = []Achievement{BombSquad, LastWord, TopCat}
+
+// Find returns the achievement with the given id.
+func Find(id string) (Achievement, bool) {
+	for _, achievement := range All {
+		if achievement.ID == id {
+			return achievement, true
+		}
+	}
+
+	return Achievement{}, false
+}
+
+// NewRules returns the rules of every achievement. A new achievement only needs a rule here,
+// the game logic is left untouched.
+func NewRules(awarder *Awarder, loadEvents projectors.GameEventsLoader) []Rule {
+	return []Rule{
+		&CountRule{
+			Achievement: BombSquad,
+			EventType:   game.EventTypeKittenDefused,
+			Threshold:   3,
+			PerGame:     true,
+			Credit: func(event common.Event) (uuid.UUID, bool) {
+				data, ok := event.Data().(*game.KittenDefused)
+				// A Defuse played by the turn timer is not to the credit of the player.
+				if !ok || data.Random {
+					return uuid.Nil, false
+				}
+
+				return data.GetPlayerID(), true
+			},
+			awarder: awarder,
+		},
+		&GameRule{
+			Achievement: LastWord,
+			Check:       wonAfterNopeChain(4),
+			awarder:     awarder,
+			loadEvents:  loadEvents,
+		},
+		&CountRule{
+			Achievement: TopCat,
+			EventType:   game.EventTypeGameFinished,
+			Threshold:   10,
+			Credit: func(event common.Event) (uuid.UUID, bool) {
+				data, ok := event.Data().(*game.GameFinished)
+				if !ok {
+					return uuid.Nil, false
+				}
+
+				return data.GetWinnerID(), true
+			},
+			awarder: awarder,
+		},
+	}
+}
+
+// wonAfterNopeChain returns the winner of the game when they played the Nope making a chain of length
+// Nopes at some point of the game.
+func wonAfterNopeChain(length int) func(events []common.Event) []uuid.UUID {
+	return func(events []common.Event) []uuid.UUID {
+		chained := make(map[uuid.UUID]bool)
+		for _, event := range events {
+			switch data := event.Data().(type) {
+			case *game.ActionNoped:
+				if data.GetNopeCount() == length {
+					chained[data.GetPlayerID()] = true
+				}
+			case *game.GameFinished:
+				if chained[data.GetWinnerID()] {
+					return []uuid.UUID{data.GetWinnerID()}
+				}
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package achievements
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/notifications"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/repos"
+)
+
+// Notifier sends a notification to its user.
+type Notifier func(ctx context.Context, notification *notifications.Notification) error
+
+// BotChecker tells whether a player of a game is played by the server.
+type BotChecker func(ctx context.Context, gameID uuid.UUID, playerID uuid.UUID) (bool, error)
+
+// Awarder stores the achievements unlocked by the users and notifies them.
+type Awarder struct {
+	repo   repos.IAchievementRepository
+	isBot  BotChecker
+	notify Notifier
+}
+
+func NewAwarder(repo repos.IAchievementRepository, isBot BotChecker, notify Notifier) *Awarder {
+	return &Awarder{
+		repo:   repo,
+		isBot:  isBot,
+		notify: notify,
+	}
+}
+
+// Award unlocks an achievement for a user, the user is only notified the first time.
+func (a *Awarder) Award(ctx context.Context, achievement Achievement, userID uuid.UUID, gameID uuid.UUID, at time.Time) error {
+	unlocked, err := a.repo.Unlock(ctx, &models.UserAchievement{
+		UserID:        userID,
+		AchievementID: achievement.ID,
+		GameID:        gameID,
+		UnlockedAt:    at,
+	})
+	if err != nil || !unlocked {
+		return err
+	}
+
+	// The achievement is already stored, a lost notification is not worth handling the event again.
+	if err := a.notify(ctx, &notifications.Notification{
+		Type:      notifications.NotificationTypeAchievementUnlocked,
+		UserID:    userID,
+		Timestamp: at,
+		AchievementUnlocked: &notifications.AchievementUnlocked{
+			AchievementID: achievement.ID,
+			Name:          achievement.Name,
+			Description:   achievement.Description,
+			GameID:        gameID,
+		},
+	}); err != nil {
+		log.Global().WarnContext(ctx, "failed to notify the unlocked achievement", zap.String("user_id", userID.String()), zap.String("achievement_id", achievement.ID), zap.Error(err))
+	}
+
+	return nil
+}
+
+// IsBot tells whether a player of a game is played by the server, bots never unlock achievements.
+func (a *Awarder) IsBot(ctx context.Context, gameID uuid.UUID, playerID uuid.UUID) (bool, error) {
+	return a.isBot(ctx, gameID, playerID)
+}
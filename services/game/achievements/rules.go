@@ -0,0 +1,133 @@
+package achievements
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/projectors"
+)
+
+// Rule unlocks an achievement from the events of the game bus, every rule is handled by its own consumer.
+type Rule interface {
+	eventing.EventHandler
+	// EventTypes are the game events the rule is delivered.
+	EventTypes() []common.EventType
+}
+
+// handlerType is the handler type of the rule of an achievement, it names the consumer of the rule.
+func handlerType(achievement Achievement) common.EventHandlerType {
+	return common.EventHandlerType("achievement_" + strings.ToLower(achievement.ID))
+}
+
+// CountRule unlocks an achievement once a player was credited with Threshold events, within a single
+// game when PerGame is set.
+type CountRule struct {
+	Achievement Achievement
+	EventType   common.EventType
+	Threshold   int
+	PerGame     bool
+	// Credit returns the player credited with the event, false when the event does not count.
+	Credit func(event common.Event) (uuid.UUID, bool)
+
+	awarder *Awarder
+}
+
+var _ Rule = (*CountRule)(nil)
+
+func (r *CountRule) HandlerType() common.EventHandlerType {
+	return handlerType(r.Achievement)
+}
+
+func (r *CountRule) EventTypes() []common.EventType {
+	return []common.EventType{r.EventType}
+}
+
+func (r *CountRule) HandleEvent(ctx context.Context, event common.Event) error {
+	if event.EventType() != r.EventType {
+		return nil
+	}
+
+	playerID, ok := r.Credit(event)
+	if !ok {
+		return nil
+	}
+
+	gameID := uuid.FromStringOrNil(event.AggregateID())
+	if bot, err := r.awarder.IsBot(ctx, gameID, playerID); err != nil || bot {
+		return err
+	}
+
+	scope := ""
+	if r.PerGame {
+		scope = gameID.String()
+	}
+
+	// The events are counted by their position in the game, an event delivered twice is counted once.
+	count, err := r.awarder.repo.RecordProgress(ctx, &models.AchievementProgress{
+		UserID:        playerID,
+		AchievementID: r.Achievement.ID,
+		Scope:         scope,
+		EventKey:      fmt.Sprintf("%s.%d", event.AggregateID(), event.Version()),
+		RecordedAt:    event.Timestamp(),
+	})
+	if err != nil || count < r.Threshold {
+		return err
+	}
+
+	return r.awarder.Award(ctx, r.Achievement, playerID, gameID, event.Timestamp())
+}
+
+// GameRule unlocks an achievement from all the events of a game, once it is finished.
+type GameRule struct {
+	Achievement Achievement
+	// Check returns the players who unlock the achievement in the finished game.
+	Check func(events []common.Event) []uuid.UUID
+
+	awarder    *Awarder
+	loadEvents projectors.GameEventsLoader
+}
+
+var _ Rule = (*GameRule)(nil)
+
+func (r *GameRule) HandlerType() common.EventHandlerType {
+	return handlerType(r.Achievement)
+}
+
+func (r *GameRule) EventTypes() []common.EventType {
+	return []common.EventType{game.EventTypeGameFinished}
+}
+
+func (r *GameRule) HandleEvent(ctx context.Context, event common.Event) error {
+	data, ok := event.Data().(*game.GameFinished)
+	if !ok {
+		return nil
+	}
+
+	events, err := r.loadEvents(ctx, data.GetGameID())
+	if err != nil {
+		return err
+	}
+
+	for _, playerID := range r.Check(events) {
+		bot, err := r.awarder.IsBot(ctx, data.GetGameID(), playerID)
+		if err != nil {
+			return err
+		}
+		if bot {
+			continue
+		}
+
+		if err := r.awarder.Award(ctx, r.Achievement, playerID, data.GetGameID(), event.Timestamp()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package achievements_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	goTesting "testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/notifications"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/achievements"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+	gameserver_mock "github.com/sweetloveinyourheart/exploding-kittens/services/game/repos/mock"
+)
+
+type RulesSuite struct {
+	*testing.Suite
+	mockAchievementRepository *gameserver_mock.MockAchievementRepository
+	gameID                    uuid.UUID
+	bots                      map[uuid.UUID]bool
+	notified                  []*notifications.Notification
+	events                    []common.Event
+}
+
+func TestRulesSuite(t *goTesting.T) {
+	rs := &RulesSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, rs)
+}
+
+func (rs *RulesSuite) SetupTest() {
+	rs.mockAchievementRepository = new(gameserver_mock.MockAchievementRepository)
+	rs.gameID = uuid.Must(uuid.NewV7())
+	rs.bots = make(map[uuid.UUID]bool)
+	rs.notified = nil
+	rs.events = nil
+}
+
+// rule returns the rule of the achievement.
+func (rs *RulesSuite) rule(achievement achievements.Achievement) achievements.Rule {
+	awarder := achievements.NewAwarder(rs.mockAchievementRepository,
+		func(ctx context.Context, gameID uuid.UUID, playerID uuid.UUID) (bool, error) {
+			return rs.bots[playerID], nil
+		},
+		func(ctx context.Context, notification *notifications.Notification) error {
+			rs.notified = append(rs.notified, notification)
+			return nil
+		},
+	)
+	loadEvents := func(ctx context.Context, gameID uuid.UUID) ([]common.Event, error) {
+		return rs.events, nil
+	}
+
+	for _, rule := range achievements.NewRules(awarder, loadEvents) {
+		if rule.HandlerType() == common.EventHandlerType("achievement_"+strings.ToLower(achievement.ID)) {
+			return rule
+		}
+	}
+	rs.FailNow("no rule for the achievement", achievement.ID)
+
+	return nil
+}
+
+// event appends an event of the game to the game events.
+func (rs *RulesSuite) event(data interface{ EventType() common.EventType }) common.Event {
+	version := len(rs.events) + 1
+	event := eventing.NewEvent(data.EventType(), data, time.UnixMilli(1700000000000).Add(time.Duration(version)*time.Second),
+		eventing.ForAggregate(game.AggregateType, rs.gameID.String(), uint64(version)))
+	rs.events = append(rs.events, event)
+
+	return event
+}
+
+func (rs *RulesSuite) Test_BombSquad_UnlocksAtThirdDefuse() {
+	ctx := context.Background()
+	playerID := uuid.Must(uuid.NewV7())
+	rule := rs.rule(achievements.BombSquad)
+
+	for count := 1; count <= 3; count++ {
+		event := rs.event(&game.KittenDefused{GameID: rs.gameID, PlayerID: playerID})
+		rs.mockAchievementRepository.On("RecordProgress", mock.Anything, mock.MatchedBy(func(progress *models.AchievementProgress) bool {
+			return progress.EventKey == fmt.Sprintf("%s.%d", event.AggregateID(), event.Version()) &&
+				progress.UserID == playerID && progress.Scope == rs.gameID.String()
+		})).Return(count, nil).Once()
+	}
+	rs.mockAchievementRepository.On("Unlock", mock.Anything, mock.MatchedBy(func(achievement *models.UserAchievement) bool {
+		return achievement.UserID == playerID && achievement.AchievementID == achievements.BombSquad.ID && achievement.GameID == rs.gameID
+	})).Return(true, nil).Once()
+
+	for _, event := range rs.events {
+		rs.NoError(rule.HandleEvent(ctx, event))
+	}
+
+	rs.Len(rs.notified, 1)
+	rs.Equal(notifications.NotificationTypeAchievementUnlocked, rs.notified[0].Type)
+	rs.Equal(achievements.BombSquad.ID, rs.notified[0].AchievementUnlocked.AchievementID)
+	rs.mockAchievementRepository.AssertExpectations(rs.T())
+}
+
+func (rs *RulesSuite) Test_BombSquad_AlreadyUnlockedIsNotNotified() {
+	playerID := uuid.Must(uuid.NewV7())
+	event := rs.event(&game.KittenDefused{GameID: rs.gameID, PlayerID: playerID})
+
+	rs.mockAchievementRepository.On("RecordProgress", mock.Anything, mock.Anything).Return(4, nil).Once()
+	rs.mockAchievementRepository.On("Unlock", mock.Anything, mock.Anything).Return(false, nil).Once()
+
+	rs.NoError(rs.rule(achievements.BombSquad).HandleEvent(context.Background(), event))
+	rs.Empty(rs.notified)
+	rs.mockAchievementRepository.AssertExpectations(rs.T())
+}
+
+func (rs *RulesSuite) Test_BombSquad_TimerDefuseAndBotsAreNotCounted() {
+	playerID, botID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	rs.bots[botID] = true
+	rule := rs.rule(achievements.BombSquad)
+
+	rs.NoError(rule.HandleEvent(context.Background(), rs.event(&game.KittenDefused{GameID: rs.gameID, PlayerID: playerID, Random: true})))
+	rs.NoError(rule.HandleEvent(context.Background(), rs.event(&game.KittenDefused{GameID: rs.gameID, PlayerID: botID})))
+	rs.mockAchievementRepository.AssertNotCalled(rs.T(), "RecordProgress", mock.Anything, mock.Anything)
+}
+
+func (rs *RulesSuite) Test_LastWord_WinnerPlayedFourthNope() {
+	winnerID, loserID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	rs.event(&game.ActionNoped{GameID: rs.gameID, PlayerID: loserID, NopeCount: 3})
+	rs.event(&game.ActionNoped{GameID: rs.gameID, PlayerID: winnerID, NopeCount: 4})
+	finished := rs.event(&game.GameFinished{GameID: rs.gameID, WinnerID: winnerID})
+
+	rs.mockAchievementRepository.On("Unlock", mock.Anything, mock.MatchedBy(func(achievement *models.UserAchievement) bool {
+		return achievement.UserID == winnerID && achievement.AchievementID == achievements.LastWord.ID
+	})).Return(true, nil).Once()
+
+	rs.NoError(rs.rule(achievements.LastWord).HandleEvent(context.Background(), finished))
+	rs.Len(rs.notified, 1)
+	rs.mockAchievementRepository.AssertExpectations(rs.T())
+}
+
+func (rs *RulesSuite) Test_LastWord_ShorterChainDoesNotUnlock() {
+	winnerID := uuid.Must(uuid.NewV7())
+	rs.event(&game.ActionNoped{GameID: rs.gameID, PlayerID: winnerID, NopeCount: 3})
+	finished := rs.event(&game.GameFinished{GameID: rs.gameID, WinnerID: winnerID})
+
+	rs.NoError(rs.rule(achievements.LastWord).HandleEvent(context.Background(), finished))
+	rs.mockAchievementRepository.AssertNotCalled(rs.T(), "Unlock", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,46 @@
+package actions
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/achievements"
+)
+
+func (a *actions) ListAchievements(ctx context.Context, request *connect.Request[proto.ListAchievementsRequest]) (response *connect.Response[proto.ListAchievementsResponse], err error) {
+	userID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetUserId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("user_id", err))
+	}
+
+	unlocked, err := a.achievementRepo.ListUserAchievements(ctx, userID)
+	if err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	result := make([]*proto.Achievement, 0, len(unlocked))
+	for _, userAchievement := range unlocked {
+		// The achievements which were removed from the catalogue are no longer shown.
+		achievement, ok := achievements.Find(userAchievement.AchievementID)
+		if !ok {
+			continue
+		}
+
+		result = append(result, &proto.Achievement{
+			AchievementId: achievement.ID,
+			Name:          achievement.Name,
+			Description:   achievement.Description,
+			GameId:        userAchievement.GameID.String(),
+			UnlockedAt:    userAchievement.UnlockedAt.UnixMilli(),
+		})
+	}
+
+	return connect.NewResponse(&proto.ListAchievementsResponse{
+		Achievements: result,
+	}), nil
+}
@@ -0,0 +1,49 @@
+package actions_test
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/achievements"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/actions"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+func (as *ActionsSuite) Test_ListAchievements_SkipsUnknownAchievements() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	userID, gameID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	unlockedAt := time.UnixMilli(1700000000000)
+
+	as.mockAchievementRepository.On("ListUserAchievements", mock.Anything, userID).Return([]models.UserAchievement{
+		{UserID: userID, AchievementID: achievements.BombSquad.ID, GameID: gameID, UnlockedAt: unlockedAt},
+		{UserID: userID, AchievementID: "RETIRED", GameID: gameID, UnlockedAt: unlockedAt},
+	}, nil).Once()
+
+	resp, err := actions.NewActions(ctx, "test").ListAchievements(ctx, connect.NewRequest(&proto.ListAchievementsRequest{
+		UserId: userID.String(),
+	}))
+	as.NoError(err)
+	as.Len(resp.Msg.GetAchievements(), 1)
+	as.Equal(achievements.BombSquad.Name, resp.Msg.GetAchievements()[0].GetName())
+	as.Equal(gameID.String(), resp.Msg.GetAchievements()[0].GetGameId())
+	as.Equal(unlockedAt.UnixMilli(), resp.Msg.GetAchievements()[0].GetUnlockedAt())
+	as.mockAchievementRepository.AssertExpectations(as.T())
+}
+
+func (as *ActionsSuite) Test_ListAchievements_InvalidUserID() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp, err := actions.NewActions(ctx, "test").ListAchievements(ctx, connect.NewRequest(&proto.ListAchievementsRequest{
+		UserId: "not-a-uuid",
+	}))
+	as.Nil(resp)
+	as.Equal(connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -0,0 +1,39 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/samber/do"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/interceptors"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/repos"
+)
+
+type actions struct {
+	context     context.Context
+	defaultAuth func(context.Context, string) (context.Context, error)
+
+	matchRepo       repos.IMatchRepository
+	ratingRepo      repos.IRatingRepository
+	achievementRepo repos.IAchievementRepository
+}
+
+// AuthFuncOverride is a callback function that overrides the default authorization middleware in the GRPC layer.
+// The gameserver is only called by other services, so every endpoint requires a service token.
+func (a *actions) AuthFuncOverride(ctx context.Context, token string, fullMethodName string) (context.Context, error) {
+	return a.defaultAuth(ctx, token)
+}
+
+func NewActions(ctx context.Context, signingToken string) *actions {
+	matchRepo := do.MustInvoke[repos.IMatchRepository](nil)
+	ratingRepo := do.MustInvoke[repos.IRatingRepository](nil)
+	achievementRepo := do.MustInvoke[repos.IAchievementRepository](nil)
+
+	return &actions{
+		context:         ctx,
+		defaultAuth:     interceptors.ConnectServerAuthHandler(signingToken),
+		matchRepo:       matchRepo,
+		ratingRepo:      ratingRepo,
+		achievementRepo: achievementRepo,
+	}
+}
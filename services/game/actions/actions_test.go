@@ -0,0 +1,73 @@
+package actions_test
+
+import (
+	"context"
+	goTesting "testing"
+
+	"github.com/samber/do"
+	"github.com/stretchr/testify/suite"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/command_handler/bus"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/domains"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/repos"
+	gameserver_mock "github.com/sweetloveinyourheart/exploding-kittens/services/game/repos/mock"
+)
+
+type ActionsSuite struct {
+	*testing.Suite
+	handler                   *stubCommandHandler
+	mockMatchRepository       *gameserver_mock.MockMatchRepository
+	mockRatingRepository      *gameserver_mock.MockRatingRepository
+	mockAchievementRepository *gameserver_mock.MockAchievementRepository
+}
+
+func TestActionsSuite(t *goTesting.T) {
+	as := &ActionsSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, as)
+}
+
+func (as *ActionsSuite) SetupTest() {
+	as.handler = &stubCommandHandler{}
+	domains.CommandBus = bus.NewCommandHandler()
+
+	as.mockMatchRepository = new(gameserver_mock.MockMatchRepository)
+	do.Override[repos.IMatchRepository](nil, func(i *do.Injector) (repos.IMatchRepository, error) {
+		return as.mockMatchRepository, nil
+	})
+
+	as.mockRatingRepository = new(gameserver_mock.MockRatingRepository)
+	do.Override[repos.IRatingRepository](nil, func(i *do.Injector) (repos.IRatingRepository, error) {
+		return as.mockRatingRepository, nil
+	})
+
+	as.mockAchievementRepository = new(gameserver_mock.MockAchievementRepository)
+	do.Override[repos.IAchievementRepository](nil, func(i *do.Injector) (repos.IAchievementRepository, error) {
+		return as.mockAchievementRepository, nil
+	})
+}
+
+// handle routes the command type to the stub handler.
+func (as *ActionsSuite) handle(commandType common.CommandType) {
+	as.NoError(domains.CommandBus.SetHandler(as.handler, commandType))
+}
+
+// stubCommandHandler records the handled commands and fails with err.
+type stubCommandHandler struct {
+	commands []eventing.Command
+	err      error
+}
+
+func (h *stubCommandHandler) HandleCommand(ctx context.Context, cmd eventing.Command) error {
+	h.commands = append(h.commands, cmd)
+	return h.err
+}
+
+func (h *stubCommandHandler) HandleCommandEx(ctx context.Context, cmd eventing.Command) ([]common.Event, error) {
+	return nil, h.HandleCommand(ctx, cmd)
+}
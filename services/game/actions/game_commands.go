@@ -0,0 +1,326 @@
+package actions
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/domains"
+)
+
+func (a *actions) CreateGame(ctx context.Context, request *connect.Request[proto.CreateGameRequest]) (response *connect.Response[proto.CreateGameResponse], err error) {
+	gameID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetGameId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("game_id", err))
+	}
+
+	lobbyID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetLobbyId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("lobby_id", err))
+	}
+
+	playerIDs := make([]uuid.UUID, 0, len(request.Msg.GetPlayerIds()))
+	for _, id := range request.Msg.GetPlayerIds() {
+		playerID, err := uuid.FromString(strings.TrimSpace(id))
+		if err != nil {
+			return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("player_ids", err))
+		}
+		playerIDs = append(playerIDs, playerID)
+	}
+
+	expansions := make([]game.Expansion, 0, len(request.Msg.GetExpansions()))
+	for _, expansion := range request.Msg.GetExpansions() {
+		expansions = append(expansions, game.Expansion(strings.ToUpper(strings.TrimSpace(expansion))))
+	}
+
+	bots := make(map[uuid.UUID]game.BotDifficulty, len(request.Msg.GetBots()))
+	for id, difficulty := range request.Msg.GetBots() {
+		botID, err := uuid.FromString(strings.TrimSpace(id))
+		if err != nil {
+			return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("bots", err))
+		}
+		bots[botID] = game.BotDifficulty(strings.ToUpper(strings.TrimSpace(difficulty)))
+	}
+
+	// The timers the rules leave to the server are the ones of its config.
+	rules := game.GameRulesFromProto(request.Msg.GetRules())
+	if rules.ReactionWindow == 0 {
+		rules.ReactionWindow = config.Instance().GetDuration("gameserver.game.reaction_window")
+	}
+	if rules.TurnTimeout == 0 {
+		rules.TurnTimeout = config.Instance().GetDuration("gameserver.game.turn_timeout")
+	}
+
+	if err := domains.CommandBus.HandleCommand(ctx, &game.CreateGame{
+		GameID:      gameID,
+		LobbyID:     lobbyID,
+		PlayerIDs:   playerIDs,
+		AFKTimeouts: config.Instance().GetInt("gameserver.game.afk_timeouts"),
+		Expansions:  expansions,
+		Bots:        bots,
+		Ranked:      request.Msg.GetRanked(),
+		Rules:       rules,
+	}); err != nil {
+		return nil, gameCommandError(err)
+	}
+
+	return connect.NewResponse(&proto.CreateGameResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) DrawCard(ctx context.Context, request *connect.Request[proto.DrawCardRequest]) (response *connect.Response[proto.DrawCardResponse], err error) {
+	gameID, playerID, err := parsePlayerRequest(request.Msg.GetGameId(), request.Msg.GetPlayerId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := domains.CommandBus.HandleCommand(ctx, &game.DrawCard{
+		GameID:   gameID,
+		PlayerID: playerID,
+	}); err != nil {
+		return nil, gameCommandError(err)
+	}
+
+	return connect.NewResponse(&proto.DrawCardResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) PlayCard(ctx context.Context, request *connect.Request[proto.PlayCardRequest]) (response *connect.Response[proto.PlayCardResponse], err error) {
+	gameID, playerID, err := parsePlayerRequest(request.Msg.GetGameId(), request.Msg.GetPlayerId())
+	if err != nil {
+		return nil, err
+	}
+
+	targetPlayerID, err := optionalUUID(request.Msg.GetTargetPlayerId())
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("target_player_id", err))
+	}
+
+	if err := domains.CommandBus.HandleCommand(ctx, &game.PlayCard{
+		GameID:         gameID,
+		PlayerID:       playerID,
+		Card:           parseCard(request.Msg.GetCard()),
+		TargetPlayerID: targetPlayerID,
+	}); err != nil {
+		return nil, gameCommandError(err)
+	}
+
+	return connect.NewResponse(&proto.PlayCardResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) PlayCombo(ctx context.Context, request *connect.Request[proto.PlayComboRequest]) (response *connect.Response[proto.PlayComboResponse], err error) {
+	gameID, playerID, err := parsePlayerRequest(request.Msg.GetGameId(), request.Msg.GetPlayerId())
+	if err != nil {
+		return nil, err
+	}
+
+	targetPlayerID, err := optionalUUID(request.Msg.GetTargetPlayerId())
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("target_player_id", err))
+	}
+
+	cards := make([]game.CardType, 0, len(request.Msg.GetCards()))
+	for _, card := range request.Msg.GetCards() {
+		cards = append(cards, parseCard(card))
+	}
+
+	if err := domains.CommandBus.HandleCommand(ctx, &game.PlayCombo{
+		GameID:         gameID,
+		PlayerID:       playerID,
+		Cards:          cards,
+		TargetPlayerID: targetPlayerID,
+		NamedCard:      parseCard(request.Msg.GetNamedCard()),
+	}); err != nil {
+		return nil, gameCommandError(err)
+	}
+
+	return connect.NewResponse(&proto.PlayComboResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) ChooseCard(ctx context.Context, request *connect.Request[proto.ChooseCardRequest]) (response *connect.Response[proto.ChooseCardResponse], err error) {
+	gameID, playerID, err := parsePlayerRequest(request.Msg.GetGameId(), request.Msg.GetPlayerId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := domains.CommandBus.HandleCommand(ctx, &game.ChooseCard{
+		GameID:   gameID,
+		PlayerID: playerID,
+		Card:     parseCard(request.Msg.GetCard()),
+	}); err != nil {
+		return nil, gameCommandError(err)
+	}
+
+	return connect.NewResponse(&proto.ChooseCardResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) DefuseKitten(ctx context.Context, request *connect.Request[proto.DefuseKittenRequest]) (response *connect.Response[proto.DefuseKittenResponse], err error) {
+	gameID, playerID, err := parsePlayerRequest(request.Msg.GetGameId(), request.Msg.GetPlayerId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := domains.CommandBus.HandleCommand(ctx, &game.DefuseKitten{
+		GameID:   gameID,
+		PlayerID: playerID,
+		Position: int(request.Msg.GetPosition()),
+	}); err != nil {
+		return nil, gameCommandError(err)
+	}
+
+	return connect.NewResponse(&proto.DefuseKittenResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) AlterFuture(ctx context.Context, request *connect.Request[proto.AlterFutureRequest]) (response *connect.Response[proto.AlterFutureResponse], err error) {
+	gameID, playerID, err := parsePlayerRequest(request.Msg.GetGameId(), request.Msg.GetPlayerId())
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]game.CardType, 0, len(request.Msg.GetCards()))
+	for _, card := range request.Msg.GetCards() {
+		cards = append(cards, parseCard(card))
+	}
+
+	if err := domains.CommandBus.HandleCommand(ctx, &game.AlterFuture{
+		GameID:   gameID,
+		PlayerID: playerID,
+		Cards:    cards,
+	}); err != nil {
+		return nil, gameCommandError(err)
+	}
+
+	return connect.NewResponse(&proto.AlterFutureResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) DisconnectPlayer(ctx context.Context, request *connect.Request[proto.DisconnectPlayerRequest]) (response *connect.Response[proto.DisconnectPlayerResponse], err error) {
+	gameID, playerID, err := parsePlayerRequest(request.Msg.GetGameId(), request.Msg.GetPlayerId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := domains.CommandBus.HandleCommand(ctx, &game.DisconnectPlayer{
+		GameID:   gameID,
+		PlayerID: playerID,
+		Grace:    config.Instance().GetDuration("gameserver.game.disconnect_grace"),
+	}); err != nil {
+		return nil, gameCommandError(err)
+	}
+
+	return connect.NewResponse(&proto.DisconnectPlayerResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+func (a *actions) ReconnectPlayer(ctx context.Context, request *connect.Request[proto.ReconnectPlayerRequest]) (response *connect.Response[proto.ReconnectPlayerResponse], err error) {
+	gameID, playerID, err := parsePlayerRequest(request.Msg.GetGameId(), request.Msg.GetPlayerId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := domains.CommandBus.HandleCommand(ctx, &game.ReconnectPlayer{
+		GameID:   gameID,
+		PlayerID: playerID,
+	}); err != nil {
+		return nil, gameCommandError(err)
+	}
+
+	return connect.NewResponse(&proto.ReconnectPlayerResponse{
+		GameId: gameID.String(),
+	}), nil
+}
+
+// parsePlayerRequest parses the ids every command of a player carries.
+func parsePlayerRequest(gameID string, playerID string) (uuid.UUID, uuid.UUID, error) {
+	parsedGameID, err := uuid.FromString(strings.TrimSpace(gameID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("game_id", err))
+	}
+
+	parsedPlayerID, err := uuid.FromString(strings.TrimSpace(playerID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("player_id", err))
+	}
+
+	return parsedGameID, parsedPlayerID, nil
+}
+
+// parseCard normalizes a card type sent by a client.
+func parseCard(card string) game.CardType {
+	return game.CardType(strings.ToUpper(strings.TrimSpace(card)))
+}
+
+// optionalUUID parses an optional id, an empty string is uuid.Nil.
+func optionalUUID(id string) (uuid.UUID, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return uuid.Nil, nil
+	}
+
+	return uuid.FromString(id)
+}
+
+// gamePreconditionErrors are the game rule violations reported back to the client as failed preconditions.
+var gamePreconditionErrors = []error{
+	game.ErrGameAlreadyCreated,
+	game.ErrGameNotAvailable,
+	game.ErrPlayerNotInGame,
+	game.ErrNotPlayersTurn,
+	game.ErrCardNotInHand,
+	game.ErrCardNotPlayable,
+	game.ErrDrawPileEmpty,
+	game.ErrReactionWindowOpen,
+	game.ErrNoPendingAction,
+	game.ErrDefusePending,
+	game.ErrNoPendingDefuse,
+	game.ErrInvalidPosition,
+	game.ErrGameFinished,
+	game.ErrInvalidCombo,
+	game.ErrInvalidTarget,
+	game.ErrInteractionPending,
+	game.ErrNoPendingInteraction,
+	game.ErrInvalidChoice,
+	game.ErrInvalidExpansion,
+	game.ErrInvalidArrangement,
+	game.ErrPlayerDisconnected,
+	game.ErrPlayerNotDisconnected,
+	game.ErrDisconnectKept,
+	game.ErrNotEnoughCards,
+	game.ErrOutdatedCommand,
+}
+
+// gameCommandError converts an error returned by a game command into a grpc error.
+func gameCommandError(err error) error {
+	var fieldErr *common.CommandFieldError
+	if errors.As(err, &fieldErr) {
+		return grpc.InvalidArgumentErrorWithField(grpc.FieldViolation(fieldErr.Field, fieldErr))
+	}
+
+	for _, preconditionErr := range gamePreconditionErrors {
+		if errors.Is(err, preconditionErr) {
+			return grpc.PreconditionError(grpc.PreconditionFailure("state", "game_id", preconditionErr.Error()))
+		}
+	}
+
+	return grpc.InternalError(err)
+}
@@ -0,0 +1,103 @@
+package actions_test
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/actions"
+)
+
+func (as *ActionsSuite) Test_PlayCard_HandlesCommand() {
+	as.handle(game.PlayCardCommand)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gameID := uuid.Must(uuid.NewV7())
+	playerID := uuid.Must(uuid.NewV7())
+	targetPlayerID := uuid.Must(uuid.NewV7())
+
+	resp, err := actions.NewActions(ctx, "test").PlayCard(ctx, connect.NewRequest(&proto.PlayCardRequest{
+		GameId:         gameID.String(),
+		PlayerId:       playerID.String(),
+		Card:           " favor ",
+		TargetPlayerId: targetPlayerID.String(),
+	}))
+	as.NoError(err)
+	as.Equal(gameID.String(), resp.Msg.GetGameId())
+
+	as.Equal([]any{&game.PlayCard{
+		GameID:         gameID,
+		PlayerID:       playerID,
+		Card:           game.CardFavor,
+		TargetPlayerID: targetPlayerID,
+	}}, toAny(as.handler.commands))
+}
+
+func (as *ActionsSuite) Test_DrawCard_InvalidPlayer() {
+	as.handle(game.DrawCardCommand)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp, err := actions.NewActions(ctx, "test").DrawCard(ctx, connect.NewRequest(&proto.DrawCardRequest{
+		GameId:   uuid.Must(uuid.NewV7()).String(),
+		PlayerId: "not-a-uuid",
+	}))
+	as.Nil(resp)
+	as.Equal(connect.CodeInvalidArgument, connect.CodeOf(err))
+	as.Empty(as.handler.commands)
+}
+
+func (as *ActionsSuite) Test_DrawCard_RuleViolationIsPrecondition() {
+	as.handle(game.DrawCardCommand)
+	as.handler.err = game.ErrNotPlayersTurn
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp, err := actions.NewActions(ctx, "test").DrawCard(ctx, connect.NewRequest(&proto.DrawCardRequest{
+		GameId:   uuid.Must(uuid.NewV7()).String(),
+		PlayerId: uuid.Must(uuid.NewV7()).String(),
+	}))
+	as.Nil(resp)
+	as.Equal(connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
+func (as *ActionsSuite) Test_CreateGame_PassesBots() {
+	as.handle(game.CreateGameCommand)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gameID := uuid.Must(uuid.NewV7())
+	lobbyID := uuid.Must(uuid.NewV7())
+	hostID := uuid.Must(uuid.NewV7())
+	botID := uuid.Must(uuid.NewV7())
+
+	_, err := actions.NewActions(ctx, "test").CreateGame(ctx, connect.NewRequest(&proto.CreateGameRequest{
+		GameId:    gameID.String(),
+		LobbyId:   lobbyID.String(),
+		PlayerIds: []string{hostID.String(), botID.String()},
+		Bots:      map[string]string{botID.String(): " hard "},
+	}))
+	as.NoError(err)
+
+	as.Len(as.handler.commands, 1)
+	cmd, ok := as.handler.commands[0].(*game.CreateGame)
+	as.True(ok)
+	as.Equal(map[uuid.UUID]game.BotDifficulty{botID: game.BotHard}, cmd.Bots)
+}
+
+func toAny[T any](values []T) []any {
+	result := make([]any, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+
+	return result
+}
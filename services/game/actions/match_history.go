@@ -0,0 +1,119 @@
+package actions
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+// MatchHistoryPageSize is the number of matches returned by a page of the match history.
+const MatchHistoryPageSize = 20
+
+func (a *actions) ListMatchHistory(ctx context.Context, request *connect.Request[proto.ListMatchHistoryRequest]) (response *connect.Response[proto.ListMatchHistoryResponse], err error) {
+	userID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetUserId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("user_id", err))
+	}
+
+	cursor, err := decodeMatchCursor(request.Msg.GetCursor())
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("cursor", err))
+	}
+
+	// One more match than the page is read to know whether there is a next page.
+	matches, err := a.matchRepo.ListMatchesByPlayer(ctx, userID, cursor, MatchHistoryPageSize+1)
+	if err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	var nextCursor string
+	if len(matches) > MatchHistoryPageSize {
+		matches = matches[:MatchHistoryPageSize]
+		last := matches[len(matches)-1]
+		nextCursor, err = encodeMatchCursor(&models.MatchCursor{
+			FinishedAt: last.FinishedAt,
+			GameID:     last.GameID,
+		})
+		if err != nil {
+			return nil, grpc.InternalError(err)
+		}
+	}
+
+	result := make([]*proto.Match, 0, len(matches))
+	for _, match := range matches {
+		result = append(result, matchToProto(match))
+	}
+
+	return connect.NewResponse(&proto.ListMatchHistoryResponse{
+		Matches:    result,
+		NextCursor: nextCursor,
+	}), nil
+}
+
+func matchToProto(match models.Match) *proto.Match {
+	participants := make([]*proto.MatchParticipant, 0, len(match.Participants))
+	for _, participant := range match.Participants {
+		var eliminatedAt int64
+		if participant.EliminatedAt != nil {
+			eliminatedAt = participant.EliminatedAt.UnixMilli()
+		}
+
+		participants = append(participants, &proto.MatchParticipant{
+			PlayerId:         participant.PlayerID.String(),
+			FinishPosition:   int32(participant.FinishPosition),
+			Bot:              participant.Bot,
+			EliminationCause: participant.EliminationCause,
+			EliminatedAt:     eliminatedAt,
+		})
+	}
+
+	return &proto.Match{
+		GameId:       match.GameID.String(),
+		LobbyId:      match.LobbyID.String(),
+		WinnerId:     match.WinnerID.String(),
+		Expansions:   match.Expansions,
+		StartedAt:    match.StartedAt.UnixMilli(),
+		FinishedAt:   match.FinishedAt.UnixMilli(),
+		Duration:     match.Duration.Milliseconds(),
+		Participants: participants,
+	}
+}
+
+// encodeMatchCursor turns the position of the last match of a page into an opaque token for the clients.
+func encodeMatchCursor(cursor *models.MatchCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeMatchCursor reads a token made by encodeMatchCursor, an empty token is the first page.
+func decodeMatchCursor(token string) (*models.MatchCursor, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	var cursor models.MatchCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.GameID == uuid.Nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	return &cursor, nil
+}
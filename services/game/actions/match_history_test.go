@@ -0,0 +1,78 @@
+package actions_test
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/actions"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+func (as *ActionsSuite) Test_ListMatchHistory_PagesWithCursor() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	userID := uuid.Must(uuid.NewV7())
+	finishedAt := time.UnixMilli(1700000000000)
+
+	matches := make([]models.Match, 0, actions.MatchHistoryPageSize+1)
+	for i := 0; i <= actions.MatchHistoryPageSize; i++ {
+		matches = append(matches, models.Match{
+			GameID:     uuid.Must(uuid.NewV7()),
+			LobbyID:    uuid.Must(uuid.NewV7()),
+			WinnerID:   userID,
+			StartedAt:  finishedAt.Add(-time.Duration(i+1) * time.Hour),
+			FinishedAt: finishedAt.Add(-time.Duration(i) * time.Hour),
+			Duration:   time.Hour,
+			Participants: []models.MatchParticipant{
+				{PlayerID: userID, FinishPosition: 1},
+			},
+		})
+	}
+	last := matches[actions.MatchHistoryPageSize-1]
+
+	as.mockMatchRepository.On("ListMatchesByPlayer", mock.Anything, userID, (*models.MatchCursor)(nil), actions.MatchHistoryPageSize+1).Return(matches, nil).Once()
+	as.mockMatchRepository.On("ListMatchesByPlayer", mock.Anything, userID, mock.MatchedBy(func(cursor *models.MatchCursor) bool {
+		return cursor.GameID == last.GameID && cursor.FinishedAt.Equal(last.FinishedAt)
+	}), actions.MatchHistoryPageSize+1).Return(matches[actions.MatchHistoryPageSize:], nil).Once()
+
+	a := actions.NewActions(ctx, "test")
+
+	resp, err := a.ListMatchHistory(ctx, connect.NewRequest(&proto.ListMatchHistoryRequest{
+		UserId: userID.String(),
+	}))
+	as.NoError(err)
+	as.Len(resp.Msg.GetMatches(), actions.MatchHistoryPageSize)
+	as.NotEmpty(resp.Msg.GetNextCursor())
+	as.Equal(matches[0].GameID.String(), resp.Msg.GetMatches()[0].GetGameId())
+	as.Equal(time.Hour.Milliseconds(), resp.Msg.GetMatches()[0].GetDuration())
+	as.Equal(int32(1), resp.Msg.GetMatches()[0].GetParticipants()[0].GetFinishPosition())
+
+	resp, err = a.ListMatchHistory(ctx, connect.NewRequest(&proto.ListMatchHistoryRequest{
+		UserId: userID.String(),
+		Cursor: resp.Msg.GetNextCursor(),
+	}))
+	as.NoError(err)
+	as.Len(resp.Msg.GetMatches(), 1)
+	as.Empty(resp.Msg.GetNextCursor())
+
+	as.mockMatchRepository.AssertExpectations(as.T())
+}
+
+func (as *ActionsSuite) Test_ListMatchHistory_InvalidCursor() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp, err := actions.NewActions(ctx, "test").ListMatchHistory(ctx, connect.NewRequest(&proto.ListMatchHistoryRequest{
+		UserId: uuid.Must(uuid.NewV7()).String(),
+		Cursor: "not a cursor",
+	}))
+	as.Nil(resp)
+	as.Equal(connect.CodeInvalidArgument, connect.CodeOf(err))
+	as.mockMatchRepository.AssertNotCalled(as.T(), "ListMatchesByPlayer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,110 @@
+package actions
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/grpc"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/timeutil"
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/ratings"
+)
+
+// LeaderboardPageSize is the number of players returned by a page of the leaderboard.
+const LeaderboardPageSize = 20
+
+// RatingHistorySize is the number of rating changes returned with the rating of a player.
+const RatingHistorySize = 20
+
+func (a *actions) GetLeaderboard(ctx context.Context, request *connect.Request[proto.GetLeaderboardRequest]) (response *connect.Response[proto.GetLeaderboardResponse], err error) {
+	period := ratings.Period(strings.ToUpper(strings.TrimSpace(request.Msg.GetPeriod())))
+	if period == "" {
+		period = ratings.PeriodAllTime
+	}
+	if !period.IsValid() {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("period", errors.New("unknown period")))
+	}
+
+	page := int(request.Msg.GetPage())
+	if page < 0 {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("page", errors.New("negative page")))
+	}
+	if page == 0 {
+		page = 1
+	}
+
+	// One more player than the page is read to know whether there is a next page.
+	leaderboard, err := a.ratingRepo.ListLeaderboard(ctx, period.Since(timeutil.Clock.Now()), (page-1)*LeaderboardPageSize, LeaderboardPageSize+1)
+	if err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	hasMore := len(leaderboard) > LeaderboardPageSize
+	if hasMore {
+		leaderboard = leaderboard[:LeaderboardPageSize]
+	}
+
+	result := make([]*proto.PlayerRating, 0, len(leaderboard))
+	for _, rating := range leaderboard {
+		result = append(result, playerRatingToProto(rating))
+	}
+
+	return connect.NewResponse(&proto.GetLeaderboardResponse{
+		Ratings: result,
+		HasMore: hasMore,
+	}), nil
+}
+
+func (a *actions) GetPlayerRating(ctx context.Context, request *connect.Request[proto.GetPlayerRatingRequest]) (response *connect.Response[proto.GetPlayerRatingResponse], err error) {
+	userID, err := uuid.FromString(strings.TrimSpace(request.Msg.GetUserId()))
+	if err != nil {
+		return nil, grpc.InvalidArgumentErrorWithField(grpc.FieldViolation("user_id", err))
+	}
+
+	rating, ok, err := a.ratingRepo.GetPlayerRating(ctx, userID)
+	if err != nil {
+		return nil, grpc.InternalError(err)
+	}
+	if !ok {
+		// A player without a rated game is shown with the rating of a first game, and no rank.
+		rating = models.PlayerRating{
+			PlayerID: userID,
+			Rating:   ratings.DefaultElo.InitialRating(),
+		}
+	}
+
+	changes, err := a.ratingRepo.ListRatingChanges(ctx, userID, RatingHistorySize)
+	if err != nil {
+		return nil, grpc.InternalError(err)
+	}
+
+	history := make([]*proto.RatingChange, 0, len(changes))
+	for _, change := range changes {
+		history = append(history, &proto.RatingChange{
+			GameId:         change.GameID.String(),
+			RatingBefore:   change.RatingBefore,
+			RatingAfter:    change.RatingAfter,
+			FinishPosition: int32(change.FinishPosition),
+			FinishedAt:     change.FinishedAt.UnixMilli(),
+		})
+	}
+
+	return connect.NewResponse(&proto.GetPlayerRatingResponse{
+		Rating:  playerRatingToProto(rating),
+		History: history,
+	}), nil
+}
+
+func playerRatingToProto(rating models.PlayerRating) *proto.PlayerRating {
+	return &proto.PlayerRating{
+		PlayerId: rating.PlayerID.String(),
+		Rank:     int32(rating.Rank),
+		Rating:   rating.Rating,
+		Games:    int32(rating.Games),
+	}
+}
@@ -0,0 +1,72 @@
+package actions_test
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	proto "github.com/sweetloveinyourheart/exploding-kittens/proto/code/gameserver/go"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/actions"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+func (as *ActionsSuite) Test_GetLeaderboard_PageOfPeriod() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leaderboard := make([]models.PlayerRating, 0, actions.LeaderboardPageSize+1)
+	for i := 0; i <= actions.LeaderboardPageSize; i++ {
+		leaderboard = append(leaderboard, models.PlayerRating{
+			PlayerID: uuid.Must(uuid.NewV7()),
+			Rating:   float64(2000 - i),
+			Games:    1,
+			Rank:     actions.LeaderboardPageSize + i + 1,
+		})
+	}
+
+	as.mockRatingRepository.On("ListLeaderboard", mock.Anything, mock.MatchedBy(func(since *time.Time) bool {
+		return since != nil && time.Since(*since) > 6*24*time.Hour && time.Since(*since) < 8*24*time.Hour
+	}), actions.LeaderboardPageSize, actions.LeaderboardPageSize+1).Return(leaderboard, nil).Once()
+
+	resp, err := actions.NewActions(ctx, "test").GetLeaderboard(ctx, connect.NewRequest(&proto.GetLeaderboardRequest{
+		Period: " weekly ",
+		Page:   2,
+	}))
+	as.NoError(err)
+	as.True(resp.Msg.GetHasMore())
+	as.Len(resp.Msg.GetRatings(), actions.LeaderboardPageSize)
+	as.Equal(int32(actions.LeaderboardPageSize+1), resp.Msg.GetRatings()[0].GetRank())
+	as.mockRatingRepository.AssertExpectations(as.T())
+}
+
+func (as *ActionsSuite) Test_GetLeaderboard_UnknownPeriod() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp, err := actions.NewActions(ctx, "test").GetLeaderboard(ctx, connect.NewRequest(&proto.GetLeaderboardRequest{
+		Period: "YEARLY",
+	}))
+	as.Nil(resp)
+	as.Equal(connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func (as *ActionsSuite) Test_GetPlayerRating_Unrated() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	userID := uuid.Must(uuid.NewV7())
+	as.mockRatingRepository.On("GetPlayerRating", mock.Anything, userID).Return(models.PlayerRating{}, false, nil).Once()
+	as.mockRatingRepository.On("ListRatingChanges", mock.Anything, userID, actions.RatingHistorySize).Return([]models.RatingChange{}, nil).Once()
+
+	resp, err := actions.NewActions(ctx, "test").GetPlayerRating(ctx, connect.NewRequest(&proto.GetPlayerRatingRequest{
+		UserId: userID.String(),
+	}))
+	as.NoError(err)
+	as.Equal(userID.String(), resp.Msg.GetRating().GetPlayerId())
+	as.Equal(float64(1500), resp.Msg.GetRating().GetRating())
+	as.Zero(resp.Msg.GetRating().GetRank())
+	as.Empty(resp.Msg.GetHistory())
+}
@@ -0,0 +1,16 @@
+package domains
+
+import (
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/command_handler/bus"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+)
+
+// CommandBus is the command bus command processing.
+var CommandBus *bus.CommandHandler
+
+// GameRepo is the repository for the Game aggregate.
+var GameRepo eventing.ReadRepo[game.Game, *game.Game]
+
+// GameScheduler issues the deadline commands of games, such as closing reaction windows.
+var GameScheduler *game.Scheduler
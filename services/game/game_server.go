@@ -0,0 +1,126 @@
+package game
+
+import (
+	"context"
+	"embed"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/samber/do"
+	"go.uber.org/zap"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/config"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/command_handler/bus"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	consumerinvalidator "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/middleware/consumer_invalidator"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/notifications"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/achievements"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/domains"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/projectors"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/ratings"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/repos"
+)
+
+//go:embed migrations/*.sql
+var FS embed.FS
+
+func InitializeRepos(ctx context.Context) error {
+	appID := fmt.Sprintf("gameserver-%s", config.Instance().GetString("gameserver.id"))
+
+	if err := InitializeCoreRepos(appID, ctx); err != nil {
+		log.Global().ErrorContext(ctx, "failed to initialize core repos", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func InitializeCoreRepos(appID string, ctx context.Context) error {
+	var err error
+
+	domains.CommandBus = bus.NewCommandHandler()
+	err = game.AddNATSGameCommandHandlers(ctx, appID, domains.CommandBus)
+	if err != nil {
+		return err
+	}
+
+	// Every game event may move a deadline, so the scheduler is refreshed once the projection is up to date.
+	mw := consumerinvalidator.NewMiddleware(eventing.MatchEvents(game.AllEventTypes), func(ctx context.Context, event common.Event) {
+		if domains.GameScheduler == nil {
+			return
+		}
+
+		gameID, err := uuid.FromString(event.AggregateID())
+		if err != nil {
+			return
+		}
+
+		if err := domains.GameScheduler.Refresh(ctx, gameID); err != nil {
+			log.Global().ErrorContext(ctx, "failed to refresh game deadlines", zap.String("game_id", gameID.String()), zap.Error(err))
+		}
+	})
+
+	domains.GameRepo, err = game.CreateNATSRepoGames(ctx, appID, mw)
+	if err != nil {
+		return err
+	}
+
+	js, err := jetstream.New(do.MustInvokeNamed[*nats.Conn](nil, fmt.Sprintf("%s-conn", constants.Bus)))
+	if err != nil {
+		return err
+	}
+
+	activeGames, err := game.NewActiveGames(ctx, js)
+	if err != nil {
+		return err
+	}
+
+	domains.GameScheduler = game.NewScheduler(domains.GameRepo, domains.CommandBus, activeGames)
+	if err := domains.GameScheduler.Start(ctx); err != nil {
+		return err
+	}
+
+	matchRepo := do.MustInvoke[repos.IMatchRepository](nil)
+	rater := ratings.NewRater(matchRepo, do.MustInvoke[repos.IRatingRepository](nil), ratings.DefaultElo)
+	if config.Instance().GetBool("gameserver.ratings.recompute") {
+		// The ratings are computed again before new games are handled, they are applied in the order the games finished.
+		log.Global().InfoContext(ctx, "recomputing the ratings from the match history")
+		if err := rater.Recompute(ctx); err != nil {
+			return err
+		}
+	}
+
+	matchHistory := projectors.NewMatchHistoryProjector(matchRepo, rater, game.LoadGameEvents)
+	err = game.AddNATSGameEventHandler(ctx, appID, matchHistory, projectors.MatchHistoryEvents)
+	if err != nil {
+		return err
+	}
+
+	bus := do.MustInvokeNamed[*nats.Conn](nil, fmt.Sprintf("%s-conn", constants.Bus))
+	awarder := achievements.NewAwarder(do.MustInvoke[repos.IAchievementRepository](nil),
+		func(ctx context.Context, gameID uuid.UUID, playerID uuid.UUID) (bool, error) {
+			gameState, err := domains.GameRepo.Find(ctx, gameID.String())
+			if err != nil {
+				return false, err
+			}
+
+			return gameState.IsBot(playerID), nil
+		},
+		func(ctx context.Context, notification *notifications.Notification) error {
+			return notifications.Publish(bus, notification)
+		},
+	)
+	for _, rule := range achievements.NewRules(awarder, game.LoadGameEvents) {
+		if err := game.AddNATSGameEventHandler(ctx, appID, rule, rule.EventTypes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
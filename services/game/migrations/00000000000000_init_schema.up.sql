@@ -0,0 +1,12 @@
+
+-- GAMES --
+
+CREATE TABLE games (
+    game_id         UUID                        NOT NULL,
+    lobby_id        UUID                        NOT NULL,
+    player_ids      JSONB                       NOT NULL    DEFAULT '[]', -- Array of user IDs, in turn order
+    created_at      TIMESTAMP WITH TIME ZONE    NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE    NOT NULL,
+
+    PRIMARY KEY (game_id)
+);
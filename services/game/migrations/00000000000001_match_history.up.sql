@@ -0,0 +1,31 @@
+-- MATCHES --
+
+CREATE TABLE matches (
+    game_id         UUID                        NOT NULL,
+    lobby_id        UUID                        NOT NULL,
+    winner_id       UUID                        NOT NULL,
+    expansions      JSONB                       NOT NULL    DEFAULT '[]', -- Array of the card sets added to the base deck
+    started_at      TIMESTAMP WITH TIME ZONE    NOT NULL,
+    finished_at     TIMESTAMP WITH TIME ZONE    NOT NULL,
+    duration_ms     BIGINT                      NOT NULL,
+
+    PRIMARY KEY (game_id)
+);
+
+CREATE INDEX ON matches (finished_at DESC, game_id DESC);
+
+-- MATCH PARTICIPANTS --
+
+-- A row is written by the elimination of the player, then completed once the game is finished.
+CREATE TABLE match_participants (
+    game_id             UUID                        NOT NULL,
+    player_id           UUID                        NOT NULL,
+    finish_position     INT, -- 1 for the winner, NULL until the game is finished
+    elimination_cause   VARCHAR(255), -- NULL for the winner
+    eliminated_at       TIMESTAMP WITH TIME ZONE,
+    bot                 BOOLEAN                     NOT NULL    DEFAULT FALSE,
+
+    PRIMARY KEY (game_id, player_id)
+);
+
+CREATE INDEX ON match_participants (player_id);
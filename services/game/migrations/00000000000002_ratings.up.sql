@@ -0,0 +1,35 @@
+-- MATCHES --
+
+ALTER TABLE matches ADD COLUMN ranked BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX ON matches (finished_at, game_id) WHERE ranked;
+
+-- PLAYER RATINGS --
+
+-- The current rating of every player of a rated game, rebuilt from the matches when the formula changes.
+CREATE TABLE player_ratings (
+    player_id       UUID                        NOT NULL,
+    rating          DOUBLE PRECISION            NOT NULL,
+    games           INT                         NOT NULL    DEFAULT 0, -- Number of rated games
+    updated_at      TIMESTAMP WITH TIME ZONE    NOT NULL,
+
+    PRIMARY KEY (player_id)
+);
+
+CREATE INDEX ON player_ratings (rating DESC, player_id);
+
+-- RATING HISTORY --
+
+CREATE TABLE rating_history (
+    game_id             UUID                        NOT NULL,
+    player_id           UUID                        NOT NULL,
+    rating_before       DOUBLE PRECISION            NOT NULL,
+    rating_after        DOUBLE PRECISION            NOT NULL,
+    finish_position     INT                         NOT NULL,
+    finished_at         TIMESTAMP WITH TIME ZONE    NOT NULL,
+
+    PRIMARY KEY (game_id, player_id)
+);
+
+CREATE INDEX ON rating_history (player_id, finished_at DESC);
+CREATE INDEX ON rating_history (finished_at);
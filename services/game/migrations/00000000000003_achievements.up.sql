@@ -0,0 +1,23 @@
+-- USER ACHIEVEMENTS --
+
+CREATE TABLE user_achievements (
+    user_id         UUID                        NOT NULL,
+    achievement_id  VARCHAR(64)                 NOT NULL,
+    game_id         UUID                        NOT NULL, -- The game the achievement was unlocked in
+    unlocked_at     TIMESTAMP WITH TIME ZONE    NOT NULL,
+
+    PRIMARY KEY (user_id, achievement_id)
+);
+
+-- ACHIEVEMENT PROGRESS --
+
+-- A row per event counted toward an achievement, so an event handled twice is only counted once.
+CREATE TABLE achievement_progress (
+    user_id         UUID                        NOT NULL,
+    achievement_id  VARCHAR(64)                 NOT NULL,
+    scope           VARCHAR(64)                 NOT NULL, -- The game for the achievements of a single game, empty otherwise
+    event_key       VARCHAR(128)                NOT NULL, -- The aggregate and the version of the event
+    recorded_at     TIMESTAMP WITH TIME ZONE    NOT NULL,
+
+    PRIMARY KEY (user_id, achievement_id, scope, event_key)
+);
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/stringsutil"
+)
+
+// UserAchievement is an achievement unlocked by a user.
+type UserAchievement struct {
+	UserID        uuid.UUID `json:"user_id"`
+	AchievementID string    `json:"achievement_id"`
+	GameID        uuid.UUID `json:"game_id"`
+	UnlockedAt    time.Time `json:"unlocked_at"`
+}
+
+func (ua UserAchievement) Validate() error {
+	if ua.UserID == uuid.Nil {
+		return errors.New("UserID: nil")
+	}
+
+	if stringsutil.IsBlank(ua.AchievementID) {
+		return errors.New("AchievementID: blank")
+	}
+
+	return nil
+}
+
+// AchievementProgress is an event counted toward an achievement.
+type AchievementProgress struct {
+	UserID        uuid.UUID `json:"user_id"`
+	AchievementID string    `json:"achievement_id"`
+	Scope         string    `json:"scope"`
+	EventKey      string    `json:"event_key"`
+	RecordedAt    time.Time `json:"recorded_at"`
+}
+
+func (ap AchievementProgress) Validate() error {
+	if ap.UserID == uuid.Nil {
+		return errors.New("UserID: nil")
+	}
+
+	if stringsutil.IsBlank(ap.AchievementID) {
+		return errors.New("AchievementID: blank")
+	}
+
+	if stringsutil.IsBlank(ap.EventKey) {
+		return errors.New("EventKey: blank")
+	}
+
+	return nil
+}
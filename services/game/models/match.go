@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+)
+
+type Match struct {
+	GameID       uuid.UUID          `json:"game_id"`
+	LobbyID      uuid.UUID          `json:"lobby_id"`
+	WinnerID     uuid.UUID          `json:"winner_id"`
+	Expansions   []string           `json:"expansions"`
+	Ranked       bool               `json:"ranked"`
+	StartedAt    time.Time          `json:"started_at"`
+	FinishedAt   time.Time          `json:"finished_at"`
+	Duration     time.Duration      `json:"duration"`
+	Participants []MatchParticipant `json:"participants"`
+}
+
+func (m Match) Validate() error {
+	if m.GameID == uuid.Nil {
+		return errors.New("GameID: nil")
+	}
+
+	if m.LobbyID == uuid.Nil {
+		return errors.New("LobbyID: nil")
+	}
+
+	if m.WinnerID == uuid.Nil {
+		return errors.New("WinnerID: nil")
+	}
+
+	if m.FinishedAt.Before(m.StartedAt) {
+		return errors.New("FinishedAt: before StartedAt")
+	}
+
+	return nil
+}
+
+// MatchParticipant is a player of a finished game, the row is written as soon as the player is eliminated.
+type MatchParticipant struct {
+	GameID         uuid.UUID `json:"game_id"`
+	PlayerID       uuid.UUID `json:"player_id"`
+	FinishPosition int       `json:"finish_position"` // 1 for the winner, 0 until the game is finished
+	Bot            bool      `json:"bot"`
+	// EliminationCause and EliminatedAt are only set for the players who were eliminated.
+	EliminationCause string     `json:"elimination_cause,omitempty"`
+	EliminatedAt     *time.Time `json:"eliminated_at,omitempty"` // Pointer to handle null
+}
+
+func (mp MatchParticipant) Validate() error {
+	if mp.GameID == uuid.Nil {
+		return errors.New("GameID: nil")
+	}
+
+	if mp.PlayerID == uuid.Nil {
+		return errors.New("PlayerID: nil")
+	}
+
+	if mp.FinishPosition < 0 {
+		return errors.New("FinishPosition: negative")
+	}
+
+	return nil
+}
+
+// MatchCursor is the position of the last match of a page, the next page starts right after it.
+type MatchCursor struct {
+	FinishedAt time.Time `json:"finished_at"`
+	GameID     uuid.UUID `json:"game_id"`
+}
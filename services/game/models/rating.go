@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+)
+
+type PlayerRating struct {
+	PlayerID  uuid.UUID `json:"player_id"`
+	Rating    float64   `json:"rating"`
+	Games     int       `json:"games"`
+	Rank      int       `json:"rank"` // 1 for the best rated player, only set when read
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RatingChange is how a rated game changed the rating of one of its players.
+type RatingChange struct {
+	GameID         uuid.UUID `json:"game_id"`
+	PlayerID       uuid.UUID `json:"player_id"`
+	RatingBefore   float64   `json:"rating_before"`
+	RatingAfter    float64   `json:"rating_after"`
+	FinishPosition int       `json:"finish_position"`
+	FinishedAt     time.Time `json:"finished_at"`
+}
+
+func (rc RatingChange) Validate() error {
+	if rc.GameID == uuid.Nil {
+		return errors.New("GameID: nil")
+	}
+
+	if rc.PlayerID == uuid.Nil {
+		return errors.New("PlayerID: nil")
+	}
+
+	if rc.FinishPosition < 1 {
+		return errors.New("FinishPosition: not positive")
+	}
+
+	return nil
+}
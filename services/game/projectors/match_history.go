@@ -0,0 +1,133 @@
+package projectors
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/ratings"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/repos"
+)
+
+// MatchHistoryEvents are the game events the match history is written from.
+var MatchHistoryEvents = []common.EventType{
+	game.EventTypePlayerEliminated,
+	game.EventTypeGameFinished,
+}
+
+// GameEventsLoader reads all the events of a game, in order.
+type GameEventsLoader func(ctx context.Context, gameID uuid.UUID) ([]common.Event, error)
+
+// MatchHistoryProjector writes the eliminations as they happen, and the whole match once the game is finished.
+// The ratings of the players are moved as soon as a rated match is written.
+type MatchHistoryProjector struct {
+	matchRepo  repos.IMatchRepository
+	rater      *ratings.Rater
+	loadEvents GameEventsLoader
+}
+
+var _ eventing.EventHandler = (*MatchHistoryProjector)(nil)
+
+func NewMatchHistoryProjector(matchRepo repos.IMatchRepository, rater *ratings.Rater, loadEvents GameEventsLoader) *MatchHistoryProjector {
+	return &MatchHistoryProjector{
+		matchRepo:  matchRepo,
+		rater:      rater,
+		loadEvents: loadEvents,
+	}
+}
+
+func (p *MatchHistoryProjector) HandlerType() common.EventHandlerType {
+	return common.EventHandlerType("match_history")
+}
+
+func (p *MatchHistoryProjector) HandleEvent(ctx context.Context, event common.Event) error {
+	switch data := event.Data().(type) {
+	case *game.PlayerEliminated:
+		eliminatedAt := event.Timestamp()
+		return p.matchRepo.RecordElimination(ctx, &models.MatchParticipant{
+			GameID:           data.GetGameID(),
+			PlayerID:         data.GetPlayerID(),
+			EliminationCause: data.GetCause().String(),
+			EliminatedAt:     &eliminatedAt,
+		})
+	case *game.GameFinished:
+		// The finish order and the settings of the game are taken from the event stream rather than
+		// the projection, which may not have caught up with the end of the game yet.
+		events, err := p.loadEvents(ctx, data.GetGameID())
+		if err != nil {
+			return err
+		}
+
+		match, err := NewMatch(ctx, events)
+		if err != nil {
+			return err
+		}
+
+		if err := p.matchRepo.RecordMatch(ctx, match); err != nil {
+			return err
+		}
+
+		return p.rater.Rate(ctx, *match)
+	}
+
+	return nil
+}
+
+// NewMatch projects all the events of a finished game into its match record.
+func NewMatch(ctx context.Context, events []common.Event) (*models.Match, error) {
+	projector := game.NewProjector()
+	state := &game.Game{}
+	for _, event := range events {
+		var err error
+		if state, err = projector.Project(ctx, event, state); err != nil {
+			return nil, err
+		}
+	}
+
+	if !state.GetFinished() {
+		return nil, game.ErrGameNotFinished
+	}
+
+	startedAt := events[0].Timestamp()
+	finishedAt := events[len(events)-1].Timestamp()
+
+	expansions := make([]string, 0, len(state.GetExpansions()))
+	for _, expansion := range state.GetExpansions() {
+		expansions = append(expansions, expansion.String())
+	}
+
+	match := &models.Match{
+		GameID:       state.GetGameID(),
+		LobbyID:      state.GetLobbyID(),
+		WinnerID:     state.GetWinnerID(),
+		Expansions:   expansions,
+		Ranked:       state.GetRanked(),
+		StartedAt:    startedAt,
+		FinishedAt:   finishedAt,
+		Duration:     finishedAt.Sub(startedAt),
+		Participants: make([]models.MatchParticipant, 0, len(state.GetPlayerIDs())),
+	}
+
+	match.Participants = append(match.Participants, models.MatchParticipant{
+		GameID:         match.GameID,
+		PlayerID:       match.WinnerID,
+		FinishPosition: 1,
+		Bot:            state.IsBot(match.WinnerID),
+	})
+
+	// The first player out finishes last.
+	for i, playerID := range state.GetEliminatedPlayerIDs() {
+		match.Participants = append(match.Participants, models.MatchParticipant{
+			GameID:         match.GameID,
+			PlayerID:       playerID,
+			FinishPosition: len(state.GetPlayerIDs()) - i,
+			Bot:            state.IsBot(playerID),
+		})
+	}
+
+	return match, nil
+}
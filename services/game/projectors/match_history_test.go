@@ -0,0 +1,164 @@
+package projectors_test
+
+import (
+	"context"
+	goTesting "testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/projectors"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/ratings"
+	gameserver_mock "github.com/sweetloveinyourheart/exploding-kittens/services/game/repos/mock"
+)
+
+type MatchHistorySuite struct {
+	*testing.Suite
+	mockMatchRepository  *gameserver_mock.MockMatchRepository
+	mockRatingRepository *gameserver_mock.MockRatingRepository
+
+	gameID    uuid.UUID
+	lobbyID   uuid.UUID
+	playerIDs []uuid.UUID
+	botID     uuid.UUID
+	bots      map[uuid.UUID]game.BotDifficulty
+	ranked    bool
+	startedAt time.Time
+}
+
+func TestMatchHistorySuite(t *goTesting.T) {
+	ms := &MatchHistorySuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, ms)
+}
+
+func (ms *MatchHistorySuite) SetupTest() {
+	ms.mockMatchRepository = new(gameserver_mock.MockMatchRepository)
+	ms.mockRatingRepository = new(gameserver_mock.MockRatingRepository)
+	ms.gameID = uuid.Must(uuid.NewV7())
+	ms.lobbyID = uuid.Must(uuid.NewV7())
+	ms.botID = uuid.Must(uuid.NewV7())
+	ms.playerIDs = []uuid.UUID{uuid.Must(uuid.NewV7()), ms.botID, uuid.Must(uuid.NewV7())}
+	ms.bots = map[uuid.UUID]game.BotDifficulty{ms.botID: game.BotEasy}
+	ms.ranked = false
+	ms.startedAt = time.UnixMilli(1700000000000).UTC()
+}
+
+func (ms *MatchHistorySuite) aggregate(version uint64) eventing.EventOption {
+	return eventing.ForAggregate(game.AggregateType, ms.gameID.String(), version)
+}
+
+// events returns the events of a game won by the first player, the third one is eliminated first.
+func (ms *MatchHistorySuite) events() []common.Event {
+	return []common.Event{
+		eventing.NewEvent(game.EventTypeGameCreated, &game.GameCreated{
+			GameID:     ms.gameID,
+			LobbyID:    ms.lobbyID,
+			PlayerIDs:  ms.playerIDs,
+			DeckSeed:   make([]byte, 32),
+			Expansions: []game.Expansion{game.ExpansionImplodingKittens},
+			Bots:       ms.bots,
+			Ranked:     ms.ranked,
+		}, ms.startedAt, ms.aggregate(1)),
+		eventing.NewEvent(game.EventTypePlayerEliminated, &game.PlayerEliminated{
+			GameID:   ms.gameID,
+			PlayerID: ms.playerIDs[2],
+			Cause:    game.EliminationCauseExploded,
+		}, ms.startedAt.Add(time.Minute), ms.aggregate(2)),
+		eventing.NewEvent(game.EventTypePlayerEliminated, &game.PlayerEliminated{
+			GameID:   ms.gameID,
+			PlayerID: ms.botID,
+			Cause:    game.EliminationCauseImploded,
+		}, ms.startedAt.Add(2*time.Minute), ms.aggregate(3)),
+		eventing.NewEvent(game.EventTypeGameFinished, &game.GameFinished{
+			GameID:   ms.gameID,
+			WinnerID: ms.playerIDs[0],
+		}, ms.startedAt.Add(2*time.Minute), ms.aggregate(4)),
+	}
+}
+
+func (ms *MatchHistorySuite) Test_NewMatch_FinishOrder() {
+	match, err := projectors.NewMatch(context.Background(), ms.events())
+	ms.NoError(err)
+
+	ms.Equal(ms.gameID, match.GameID)
+	ms.Equal(ms.lobbyID, match.LobbyID)
+	ms.Equal(ms.playerIDs[0], match.WinnerID)
+	ms.Equal([]string{game.ExpansionImplodingKittens.String()}, match.Expansions)
+	ms.Equal(2*time.Minute, match.Duration)
+	ms.Equal([]models.MatchParticipant{
+		{GameID: ms.gameID, PlayerID: ms.playerIDs[0], FinishPosition: 1},
+		{GameID: ms.gameID, PlayerID: ms.playerIDs[2], FinishPosition: 3},
+		{GameID: ms.gameID, PlayerID: ms.botID, FinishPosition: 2, Bot: true},
+	}, match.Participants)
+}
+
+func (ms *MatchHistorySuite) Test_NewMatch_NotFinished() {
+	events := ms.events()
+
+	_, err := projectors.NewMatch(context.Background(), events[:len(events)-1])
+	ms.ErrorIs(err, game.ErrGameNotFinished)
+}
+
+// projector returns a match history projector reading the given events.
+func (ms *MatchHistorySuite) projector(events []common.Event) *projectors.MatchHistoryProjector {
+	rater := ratings.NewRater(ms.mockMatchRepository, ms.mockRatingRepository, ratings.DefaultElo)
+	return projectors.NewMatchHistoryProjector(ms.mockMatchRepository, rater, func(ctx context.Context, gameID uuid.UUID) ([]common.Event, error) {
+		ms.Equal(ms.gameID, gameID)
+		return events, nil
+	})
+}
+
+func (ms *MatchHistorySuite) Test_HandleEvent_RecordsEliminationAndMatch() {
+	events := ms.events()
+	projector := ms.projector(events)
+
+	eliminatedAt := events[1].Timestamp()
+	ms.mockMatchRepository.On("RecordElimination", mock.Anything, &models.MatchParticipant{
+		GameID:           ms.gameID,
+		PlayerID:         ms.playerIDs[2],
+		EliminationCause: game.EliminationCauseExploded.String(),
+		EliminatedAt:     &eliminatedAt,
+	}).Return(nil).Once()
+	ms.mockMatchRepository.On("RecordMatch", mock.Anything, mock.MatchedBy(func(match *models.Match) bool {
+		return match.GameID == ms.gameID && len(match.Participants) == len(ms.playerIDs)
+	})).Return(nil).Once()
+
+	ms.NoError(projector.HandleEvent(context.Background(), events[1]))
+	ms.NoError(projector.HandleEvent(context.Background(), events[3]))
+	// Other events of the game are ignored.
+	ms.NoError(projector.HandleEvent(context.Background(), events[0]))
+
+	ms.mockMatchRepository.AssertExpectations(ms.T())
+	// The game is not ranked.
+	ms.mockRatingRepository.AssertNotCalled(ms.T(), "RecordRatingChanges", mock.Anything, mock.Anything)
+}
+
+func (ms *MatchHistorySuite) Test_HandleEvent_RatesRankedMatch() {
+	ms.ranked = true
+	ms.bots = nil
+	events := ms.events()
+	projector := ms.projector(events)
+
+	ms.mockMatchRepository.On("RecordMatch", mock.Anything, mock.MatchedBy(func(match *models.Match) bool {
+		return match.Ranked
+	})).Return(nil).Once()
+	ms.mockRatingRepository.On("GetRatings", mock.Anything, mock.Anything).Return(map[uuid.UUID]models.PlayerRating{}, nil).Once()
+	ms.mockRatingRepository.On("RecordRatingChanges", mock.Anything, mock.MatchedBy(func(changes []models.RatingChange) bool {
+		return len(changes) == len(ms.playerIDs) && changes[0].PlayerID == ms.playerIDs[0] && changes[0].RatingAfter > changes[0].RatingBefore
+	})).Return(true, nil).Once()
+
+	ms.NoError(projector.HandleEvent(context.Background(), events[3]))
+
+	ms.mockMatchRepository.AssertExpectations(ms.T())
+	ms.mockRatingRepository.AssertExpectations(ms.T())
+}
@@ -0,0 +1,60 @@
+package ratings
+
+import "math"
+
+// Formula computes the new ratings of the players of a game.
+type Formula interface {
+	// InitialRating is the rating of a player before their first rated game.
+	InitialRating() float64
+	// Rate returns the ratings of the players once the game is over, positions[i] is the finish
+	// position of the player rated ratings[i], 1 for the winner.
+	Rate(ratings []float64, positions []int) []float64
+}
+
+// Elo is a multiplayer Elo, a game of n players counts as the n-1 duels of each player against every other
+// one, the player who finished higher winning the duel.
+type Elo struct {
+	// K is the most a player can win or lose in a game.
+	K       float64
+	Initial float64
+}
+
+var DefaultElo = Elo{K: 32, Initial: 1500}
+
+var _ Formula = Elo{}
+
+func (e Elo) InitialRating() float64 {
+	return e.Initial
+}
+
+func (e Elo) Rate(ratings []float64, positions []int) []float64 {
+	result := make([]float64, len(ratings))
+	copy(result, ratings)
+	if len(ratings) < 2 {
+		return result
+	}
+
+	// Every duel is worth K/(n-1), so a game moves a rating by at most K whatever the number of players.
+	k := e.K / float64(len(ratings)-1)
+	for i := range ratings {
+		var delta float64
+		for j := range ratings {
+			if i == j {
+				continue
+			}
+
+			expected := 1 / (1 + math.Pow(10, (ratings[j]-ratings[i])/400))
+			actual := 0.5
+			switch {
+			case positions[i] < positions[j]:
+				actual = 1
+			case positions[i] > positions[j]:
+				actual = 0
+			}
+			delta += k * (actual - expected)
+		}
+		result[i] += delta
+	}
+
+	return result
+}
@@ -0,0 +1,40 @@
+package ratings
+
+import "time"
+
+// Period limits a leaderboard to the players who played a rated game recently.
+type Period string
+
+const (
+	PeriodAllTime Period = "ALL_TIME"
+	PeriodMonthly Period = "MONTHLY"
+	PeriodWeekly  Period = "WEEKLY"
+)
+
+func (p Period) String() string {
+	return string(p)
+}
+
+func (p Period) IsValid() bool {
+	switch p {
+	case PeriodAllTime, PeriodMonthly, PeriodWeekly:
+		return true
+	}
+
+	return false
+}
+
+// Since returns the start of the period ending at now, nil for all time.
+func (p Period) Since(now time.Time) *time.Time {
+	var since time.Time
+	switch p {
+	case PeriodMonthly:
+		since = now.AddDate(0, 0, -30)
+	case PeriodWeekly:
+		since = now.AddDate(0, 0, -7)
+	default:
+		return nil
+	}
+
+	return &since
+}
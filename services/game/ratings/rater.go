@@ -0,0 +1,120 @@
+package ratings
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/repos"
+)
+
+// recomputePageSize is the number of matches read at once while the ratings are computed again.
+const recomputePageSize = 100
+
+// Rater moves the ratings of the players once a rated game is recorded in the match history.
+type Rater struct {
+	matchRepo  repos.IMatchRepository
+	ratingRepo repos.IRatingRepository
+	formula    Formula
+}
+
+func NewRater(matchRepo repos.IMatchRepository, ratingRepo repos.IRatingRepository, formula Formula) *Rater {
+	return &Rater{
+		matchRepo:  matchRepo,
+		ratingRepo: ratingRepo,
+		formula:    formula,
+	}
+}
+
+// Rated tells whether a match changes the ratings of its players, only the ranked games played without bots do.
+func Rated(match models.Match) bool {
+	if !match.Ranked || len(match.Participants) < 2 {
+		return false
+	}
+
+	for _, participant := range match.Participants {
+		if participant.Bot || participant.FinishPosition < 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Rate applies a match to the ratings of its players, a match which is not rated or was already applied is skipped.
+func (r *Rater) Rate(ctx context.Context, match models.Match) error {
+	if !Rated(match) {
+		return nil
+	}
+
+	playerIDs := make([]uuid.UUID, 0, len(match.Participants))
+	for _, participant := range match.Participants {
+		playerIDs = append(playerIDs, participant.PlayerID)
+	}
+
+	current, err := r.ratingRepo.GetRatings(ctx, playerIDs)
+	if err != nil {
+		return err
+	}
+
+	before := make([]float64, 0, len(match.Participants))
+	positions := make([]int, 0, len(match.Participants))
+	for _, participant := range match.Participants {
+		rating, ok := current[participant.PlayerID]
+		if !ok {
+			rating.Rating = r.formula.InitialRating()
+		}
+		before = append(before, rating.Rating)
+		positions = append(positions, participant.FinishPosition)
+	}
+
+	after := r.formula.Rate(before, positions)
+
+	changes := make([]models.RatingChange, 0, len(match.Participants))
+	for i, participant := range match.Participants {
+		changes = append(changes, models.RatingChange{
+			GameID:         match.GameID,
+			PlayerID:       participant.PlayerID,
+			RatingBefore:   before[i],
+			RatingAfter:    after[i],
+			FinishPosition: participant.FinishPosition,
+			FinishedAt:     match.FinishedAt,
+		})
+	}
+
+	_, err = r.ratingRepo.RecordRatingChanges(ctx, changes)
+	return err
+}
+
+// Recompute drops every rating and applies the ranked matches again in the order they finished,
+// which is how a change of the formula reaches the ratings of past games.
+func (r *Rater) Recompute(ctx context.Context) error {
+	if err := r.ratingRepo.Reset(ctx); err != nil {
+		return err
+	}
+
+	var cursor *models.MatchCursor
+	for {
+		matches, err := r.matchRepo.ListRankedMatches(ctx, cursor, recomputePageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, match := range matches {
+			if err := r.Rate(ctx, match); err != nil {
+				return err
+			}
+		}
+
+		if len(matches) < recomputePageSize {
+			return nil
+		}
+
+		last := matches[len(matches)-1]
+		cursor = &models.MatchCursor{
+			FinishedAt: last.FinishedAt,
+			GameID:     last.GameID,
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package ratings_test
+
+import (
+	"context"
+	goTesting "testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/testing"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/ratings"
+	gameserver_mock "github.com/sweetloveinyourheart/exploding-kittens/services/game/repos/mock"
+)
+
+type RaterSuite struct {
+	*testing.Suite
+	mockMatchRepository  *gameserver_mock.MockMatchRepository
+	mockRatingRepository *gameserver_mock.MockRatingRepository
+}
+
+func TestRaterSuite(t *goTesting.T) {
+	rs := &RaterSuite{
+		Suite: testing.MakeSuite(t),
+	}
+
+	suite.Run(t, rs)
+}
+
+func (rs *RaterSuite) SetupTest() {
+	rs.mockMatchRepository = new(gameserver_mock.MockMatchRepository)
+	rs.mockRatingRepository = new(gameserver_mock.MockRatingRepository)
+}
+
+// match returns a ranked match finished in the order of the given players.
+func (rs *RaterSuite) match(finishedAt time.Time, playerIDs ...uuid.UUID) models.Match {
+	match := models.Match{
+		GameID:     uuid.Must(uuid.NewV7()),
+		LobbyID:    uuid.Must(uuid.NewV7()),
+		WinnerID:   playerIDs[0],
+		Ranked:     true,
+		StartedAt:  finishedAt.Add(-time.Hour),
+		FinishedAt: finishedAt,
+		Duration:   time.Hour,
+	}
+	for i, playerID := range playerIDs {
+		match.Participants = append(match.Participants, models.MatchParticipant{
+			GameID:         match.GameID,
+			PlayerID:       playerID,
+			FinishPosition: i + 1,
+		})
+	}
+
+	return match
+}
+
+func (rs *RaterSuite) Test_Elo_IsZeroSum() {
+	after := ratings.DefaultElo.Rate([]float64{1500, 1600, 1400, 1500}, []int{2, 1, 4, 3})
+
+	var before, total float64
+	for i, rating := range []float64{1500, 1600, 1400, 1500} {
+		before += rating
+		total += after[i]
+	}
+	rs.InDelta(before, total, 1e-9)
+	rs.Greater(after[1], 1600.0)
+	rs.Less(after[2], 1400.0)
+}
+
+func (rs *RaterSuite) Test_Elo_FirstGameMovesByHalfK() {
+	after := ratings.DefaultElo.Rate([]float64{1500, 1500}, []int{1, 2})
+
+	rs.InDelta(1516, after[0], 1e-9)
+	rs.InDelta(1484, after[1], 1e-9)
+}
+
+func (rs *RaterSuite) Test_Rated() {
+	playerIDs := []uuid.UUID{uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())}
+
+	match := rs.match(time.Now(), playerIDs...)
+	rs.True(ratings.Rated(match))
+
+	match.Ranked = false
+	rs.False(ratings.Rated(match))
+
+	match = rs.match(time.Now(), playerIDs...)
+	match.Participants[1].Bot = true
+	rs.False(ratings.Rated(match))
+}
+
+func (rs *RaterSuite) Test_Rate_StartsFromCurrentRatings() {
+	winnerID, loserID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	match := rs.match(time.Now(), winnerID, loserID)
+
+	rs.mockRatingRepository.On("GetRatings", mock.Anything, []uuid.UUID{winnerID, loserID}).Return(map[uuid.UUID]models.PlayerRating{
+		loserID: {PlayerID: loserID, Rating: 1600, Games: 3},
+	}, nil).Once()
+	rs.mockRatingRepository.On("RecordRatingChanges", mock.Anything, mock.MatchedBy(func(changes []models.RatingChange) bool {
+		return len(changes) == 2 &&
+			changes[0].RatingBefore == ratings.DefaultElo.InitialRating() && changes[0].RatingAfter > changes[0].RatingBefore &&
+			changes[1].RatingBefore == 1600 && changes[1].RatingAfter < 1600 &&
+			changes[1].FinishPosition == 2 && changes[1].FinishedAt.Equal(match.FinishedAt)
+	})).Return(true, nil).Once()
+
+	rs.NoError(ratings.NewRater(rs.mockMatchRepository, rs.mockRatingRepository, ratings.DefaultElo).Rate(context.Background(), match))
+	rs.mockRatingRepository.AssertExpectations(rs.T())
+}
+
+func (rs *RaterSuite) Test_Recompute_AppliesMatchesInOrder() {
+	playerIDs := []uuid.UUID{uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())}
+	first := rs.match(time.UnixMilli(1700000000000), playerIDs...)
+	second := rs.match(time.UnixMilli(1700000100000), playerIDs[1], playerIDs[0])
+
+	applied := []uuid.UUID{}
+	rs.mockRatingRepository.On("Reset", mock.Anything).Return(nil).Once()
+	rs.mockMatchRepository.On("ListRankedMatches", mock.Anything, (*models.MatchCursor)(nil), mock.Anything).Return([]models.Match{first, second}, nil).Once()
+	rs.mockRatingRepository.On("GetRatings", mock.Anything, mock.Anything).Return(map[uuid.UUID]models.PlayerRating{}, nil)
+	rs.mockRatingRepository.On("RecordRatingChanges", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		applied = append(applied, args.Get(1).([]models.RatingChange)[0].GameID)
+	}).Return(true, nil)
+
+	rs.NoError(ratings.NewRater(rs.mockMatchRepository, rs.mockRatingRepository, ratings.DefaultElo).Recompute(context.Background()))
+	rs.Equal([]uuid.UUID{first.GameID, second.GameID}, applied)
+	rs.mockMatchRepository.AssertExpectations(rs.T())
+	rs.mockRatingRepository.AssertExpectations(rs.T())
+}
@@ -0,0 +1,127 @@
+package repos
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/db"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+type AchievementRepository struct {
+	Tx db.DbOrTx
+}
+
+func NewAchievementRepository(tx db.DbOrTx) IAchievementRepository {
+	return &AchievementRepository{
+		Tx: tx,
+	}
+}
+
+// RecordProgress counts an event toward an achievement and returns the number of events counted in the
+// same scope. Recording the same event again does not change the count.
+func (repo *AchievementRepository) RecordProgress(ctx context.Context, progress *models.AchievementProgress) (int, error) {
+	if err := progress.Validate(); err != nil {
+		return 0, err
+	}
+
+	query := `
+		INSERT INTO achievement_progress (
+			user_id,
+			achievement_id,
+			scope,
+			event_key,
+			recorded_at
+		)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, achievement_id, scope, event_key) DO NOTHING;
+	`
+	if _, err := repo.Tx.Exec(ctx,
+		query,
+		progress.UserID,
+		progress.AchievementID,
+		progress.Scope,
+		progress.EventKey,
+		progress.RecordedAt,
+	); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	var count int
+	countQuery := `
+		SELECT COUNT(*)
+		FROM achievement_progress
+		WHERE user_id = $1 AND achievement_id = $2 AND scope = $3;
+	`
+	if err := repo.Tx.QueryRow(ctx, countQuery, progress.UserID, progress.AchievementID, progress.Scope).Scan(&count); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+// Unlock awards an achievement to a user, false is returned when the user already had it.
+func (repo *AchievementRepository) Unlock(ctx context.Context, achievement *models.UserAchievement) (bool, error) {
+	if err := achievement.Validate(); err != nil {
+		return false, err
+	}
+
+	query := `
+		INSERT INTO user_achievements (
+			user_id,
+			achievement_id,
+			game_id,
+			unlocked_at
+		)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, achievement_id) DO NOTHING;
+	`
+	tag, err := repo.Tx.Exec(ctx,
+		query,
+		achievement.UserID,
+		achievement.AchievementID,
+		achievement.GameID,
+		achievement.UnlockedAt,
+	)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListUserAchievements returns the achievements of a user, the first unlocked first.
+func (repo *AchievementRepository) ListUserAchievements(ctx context.Context, userID uuid.UUID) ([]models.UserAchievement, error) {
+	query := `
+		SELECT user_id, achievement_id, game_id, unlocked_at
+		FROM user_achievements
+		WHERE user_id = $1
+		ORDER BY unlocked_at, achievement_id;
+	`
+	rows, err := repo.Tx.Query(ctx, query, userID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	achievements := make([]models.UserAchievement, 0)
+	for rows.Next() {
+		var achievement models.UserAchievement
+		if err := rows.Scan(
+			&achievement.UserID,
+			&achievement.AchievementID,
+			&achievement.GameID,
+			&achievement.UnlockedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		achievements = append(achievements, achievement)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return achievements, nil
+}
@@ -0,0 +1,32 @@
+package repos
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+type IMatchRepository interface {
+	RecordElimination(ctx context.Context, participant *models.MatchParticipant) error
+	RecordMatch(ctx context.Context, match *models.Match) error
+	ListMatchesByPlayer(ctx context.Context, playerID uuid.UUID, cursor *models.MatchCursor, limit int) ([]models.Match, error)
+	ListRankedMatches(ctx context.Context, cursor *models.MatchCursor, limit int) ([]models.Match, error)
+}
+
+type IRatingRepository interface {
+	GetRatings(ctx context.Context, playerIDs []uuid.UUID) (map[uuid.UUID]models.PlayerRating, error)
+	GetPlayerRating(ctx context.Context, playerID uuid.UUID) (models.PlayerRating, bool, error)
+	RecordRatingChanges(ctx context.Context, changes []models.RatingChange) (bool, error)
+	ListLeaderboard(ctx context.Context, since *time.Time, offset int, limit int) ([]models.PlayerRating, error)
+	ListRatingChanges(ctx context.Context, playerID uuid.UUID, limit int) ([]models.RatingChange, error)
+	Reset(ctx context.Context) error
+}
+
+type IAchievementRepository interface {
+	RecordProgress(ctx context.Context, progress *models.AchievementProgress) (int, error)
+	Unlock(ctx context.Context, achievement *models.UserAchievement) (bool, error)
+	ListUserAchievements(ctx context.Context, userID uuid.UUID) ([]models.UserAchievement, error)
+}
@@ -0,0 +1,251 @@
+package repos
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/db"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/stringsutil"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+type MatchRepository struct {
+	Tx db.DbOrTx
+}
+
+func NewMatchRepository(tx db.DbOrTx) IMatchRepository {
+	return &MatchRepository{
+		Tx: tx,
+	}
+}
+
+// RecordElimination writes how a player left a game, it may be called before or after RecordMatch.
+func (repo *MatchRepository) RecordElimination(ctx context.Context, participant *models.MatchParticipant) error {
+	if err := participant.Validate(); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO match_participants (
+			game_id,
+			player_id,
+			elimination_cause,
+			eliminated_at
+		)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (game_id, player_id) DO UPDATE
+		SET elimination_cause = EXCLUDED.elimination_cause, eliminated_at = EXCLUDED.eliminated_at;
+	`
+	_, err := repo.Tx.Exec(ctx,
+		query,
+		participant.GameID,
+		participant.PlayerID,
+		participant.EliminationCause,
+		participant.EliminatedAt,
+	)
+
+	return errors.WithStack(err)
+}
+
+// RecordMatch writes a finished game along with the finish position of its participants. Recording
+// the same game again is a no-op, so the events of a game can safely be handled more than once.
+func (repo *MatchRepository) RecordMatch(ctx context.Context, match *models.Match) error {
+	if err := match.Validate(); err != nil {
+		return err
+	}
+
+	participantQuery := `
+		INSERT INTO match_participants (
+			game_id,
+			player_id,
+			finish_position,
+			bot
+		)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (game_id, player_id) DO UPDATE
+		SET finish_position = EXCLUDED.finish_position, bot = EXCLUDED.bot;
+	`
+	for _, participant := range match.Participants {
+		if err := participant.Validate(); err != nil {
+			return err
+		}
+
+		if _, err := repo.Tx.Exec(ctx,
+			participantQuery,
+			participant.GameID,
+			participant.PlayerID,
+			participant.FinishPosition,
+			participant.Bot,
+		); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	expansions, err := json.Marshal(match.Expansions)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// The match is written last, a listed match always has all of its participants.
+	matchQuery := `
+		INSERT INTO matches (
+			game_id,
+			lobby_id,
+			winner_id,
+			expansions,
+			ranked,
+			started_at,
+			finished_at,
+			duration_ms
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (game_id) DO NOTHING;
+	`
+	_, err = repo.Tx.Exec(ctx,
+		matchQuery,
+		match.GameID,
+		match.LobbyID,
+		match.WinnerID,
+		expansions,
+		match.Ranked,
+		match.StartedAt,
+		match.FinishedAt,
+		match.Duration.Milliseconds(),
+	)
+
+	return errors.WithStack(err)
+}
+
+// ListMatchesByPlayer returns the finished games of a player with their participants, the most recent
+// first. A nil cursor starts from the most recent game.
+func (repo *MatchRepository) ListMatchesByPlayer(ctx context.Context, playerID uuid.UUID, cursor *models.MatchCursor, limit int) ([]models.Match, error) {
+	var cursorFinishedAt *time.Time
+	cursorGameID := uuid.Nil
+	if cursor != nil {
+		cursorFinishedAt = &cursor.FinishedAt
+		cursorGameID = cursor.GameID
+	}
+
+	query := `
+		SELECT m.game_id, m.lobby_id, m.winner_id, m.expansions, m.ranked, m.started_at, m.finished_at, m.duration_ms
+		FROM matches m
+		JOIN match_participants p ON p.game_id = m.game_id
+		WHERE p.player_id = $1
+			AND ($2::TIMESTAMP WITH TIME ZONE IS NULL OR (m.finished_at, m.game_id) < ($2, $3))
+		ORDER BY m.finished_at DESC, m.game_id DESC
+		LIMIT $4;
+	`
+
+	return repo.listMatches(ctx, limit, query, playerID, cursorFinishedAt, cursorGameID, limit)
+}
+
+// ListRankedMatches returns the ranked games with their participants, the oldest first, which is the order
+// the ratings are computed in. A nil cursor starts from the oldest game.
+func (repo *MatchRepository) ListRankedMatches(ctx context.Context, cursor *models.MatchCursor, limit int) ([]models.Match, error) {
+	var cursorFinishedAt *time.Time
+	cursorGameID := uuid.Nil
+	if cursor != nil {
+		cursorFinishedAt = &cursor.FinishedAt
+		cursorGameID = cursor.GameID
+	}
+
+	query := `
+		SELECT game_id, lobby_id, winner_id, expansions, ranked, started_at, finished_at, duration_ms
+		FROM matches
+		WHERE ranked
+			AND ($1::TIMESTAMP WITH TIME ZONE IS NULL OR (finished_at, game_id) > ($1, $2))
+		ORDER BY finished_at, game_id
+		LIMIT $3;
+	`
+
+	return repo.listMatches(ctx, limit, query, cursorFinishedAt, cursorGameID, limit)
+}
+
+// listMatches runs a query on the matches and reads the participants of the matches it returns.
+func (repo *MatchRepository) listMatches(ctx context.Context, limit int, query string, args ...any) ([]models.Match, error) {
+	rows, err := repo.Tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	matches := make([]models.Match, 0, limit)
+	indexes := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var match models.Match
+		var expansions []byte
+		var durationMs int64
+		if err := rows.Scan(
+			&match.GameID,
+			&match.LobbyID,
+			&match.WinnerID,
+			&expansions,
+			&match.Ranked,
+			&match.StartedAt,
+			&match.FinishedAt,
+			&durationMs,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		if err := json.Unmarshal(expansions, &match.Expansions); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		match.Duration = time.Duration(durationMs) * time.Millisecond
+		match.Participants = []models.MatchParticipant{}
+
+		indexes[match.GameID] = len(matches)
+		matches = append(matches, match)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(matches) == 0 {
+		return matches, nil
+	}
+
+	gameIDs := make([]uuid.UUID, 0, len(matches))
+	for _, match := range matches {
+		gameIDs = append(gameIDs, match.GameID)
+	}
+
+	participantQuery := `
+		SELECT game_id, player_id, COALESCE(finish_position, 0), bot, COALESCE(elimination_cause, ''), eliminated_at
+		FROM match_participants
+		WHERE game_id = ANY($1::UUID[])
+		ORDER BY game_id, finish_position;
+	`
+	participantRows, err := repo.Tx.Query(ctx, participantQuery, stringsutil.ConvertUUIDsToStrings(gameIDs))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer participantRows.Close()
+
+	for participantRows.Next() {
+		var participant models.MatchParticipant
+		if err := participantRows.Scan(
+			&participant.GameID,
+			&participant.PlayerID,
+			&participant.FinishPosition,
+			&participant.Bot,
+			&participant.EliminationCause,
+			&participant.EliminatedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		if i, ok := indexes[participant.GameID]; ok {
+			matches[i].Participants = append(matches[i].Participants, participant)
+		}
+	}
+	if err := participantRows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return matches, nil
+}
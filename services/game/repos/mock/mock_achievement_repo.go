@@ -0,0 +1,29 @@
+package gameserver_mock
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+type MockAchievementRepository struct {
+	mock.Mock
+}
+
+func (m *MockAchievementRepository) RecordProgress(ctx context.Context, progress *models.AchievementProgress) (int, error) {
+	args := m.Called(ctx, progress)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockAchievementRepository) Unlock(ctx context.Context, achievement *models.UserAchievement) (bool, error) {
+	args := m.Called(ctx, achievement)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAchievementRepository) ListUserAchievements(ctx context.Context, userID uuid.UUID) ([]models.UserAchievement, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.UserAchievement), args.Error(1)
+}
@@ -0,0 +1,34 @@
+package gameserver_mock
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+type MockMatchRepository struct {
+	mock.Mock
+}
+
+func (m *MockMatchRepository) RecordElimination(ctx context.Context, participant *models.MatchParticipant) error {
+	args := m.Called(ctx, participant)
+	return args.Error(0)
+}
+
+func (m *MockMatchRepository) RecordMatch(ctx context.Context, match *models.Match) error {
+	args := m.Called(ctx, match)
+	return args.Error(0)
+}
+
+func (m *MockMatchRepository) ListMatchesByPlayer(ctx context.Context, playerID uuid.UUID, cursor *models.MatchCursor, limit int) ([]models.Match, error) {
+	args := m.Called(ctx, playerID, cursor, limit)
+	return args.Get(0).([]models.Match), args.Error(1)
+}
+
+func (m *MockMatchRepository) ListRankedMatches(ctx context.Context, cursor *models.MatchCursor, limit int) ([]models.Match, error) {
+	args := m.Called(ctx, cursor, limit)
+	return args.Get(0).([]models.Match), args.Error(1)
+}
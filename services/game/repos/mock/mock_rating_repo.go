@@ -0,0 +1,45 @@
+package gameserver_mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+type MockRatingRepository struct {
+	mock.Mock
+}
+
+func (m *MockRatingRepository) GetRatings(ctx context.Context, playerIDs []uuid.UUID) (map[uuid.UUID]models.PlayerRating, error) {
+	args := m.Called(ctx, playerIDs)
+	return args.Get(0).(map[uuid.UUID]models.PlayerRating), args.Error(1)
+}
+
+func (m *MockRatingRepository) GetPlayerRating(ctx context.Context, playerID uuid.UUID) (models.PlayerRating, bool, error) {
+	args := m.Called(ctx, playerID)
+	return args.Get(0).(models.PlayerRating), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRatingRepository) RecordRatingChanges(ctx context.Context, changes []models.RatingChange) (bool, error) {
+	args := m.Called(ctx, changes)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRatingRepository) ListLeaderboard(ctx context.Context, since *time.Time, offset int, limit int) ([]models.PlayerRating, error) {
+	args := m.Called(ctx, since, offset, limit)
+	return args.Get(0).([]models.PlayerRating), args.Error(1)
+}
+
+func (m *MockRatingRepository) ListRatingChanges(ctx context.Context, playerID uuid.UUID, limit int) ([]models.RatingChange, error) {
+	args := m.Called(ctx, playerID, limit)
+	return args.Get(0).([]models.RatingChange), args.Error(1)
+}
+
+func (m *MockRatingRepository) Reset(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
@@ -0,0 +1,235 @@
+package repos
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/db"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/stringsutil"
+	"github.com/sweetloveinyourheart/exploding-kittens/services/game/models"
+)
+
+type RatingRepository struct {
+	Tx db.DbOrTx
+}
+
+func NewRatingRepository(tx db.DbOrTx) IRatingRepository {
+	return &RatingRepository{
+		Tx: tx,
+	}
+}
+
+// GetRatings returns the current rating of the given players, the players who never played a rated game are left out.
+func (repo *RatingRepository) GetRatings(ctx context.Context, playerIDs []uuid.UUID) (map[uuid.UUID]models.PlayerRating, error) {
+	query := `
+		SELECT player_id, rating, games, updated_at
+		FROM player_ratings
+		WHERE player_id = ANY($1::UUID[]);
+	`
+	rows, err := repo.Tx.Query(ctx, query, stringsutil.ConvertUUIDsToStrings(playerIDs))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	ratings := make(map[uuid.UUID]models.PlayerRating, len(playerIDs))
+	for rows.Next() {
+		var rating models.PlayerRating
+		if err := rows.Scan(
+			&rating.PlayerID,
+			&rating.Rating,
+			&rating.Games,
+			&rating.UpdatedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		ratings[rating.PlayerID] = rating
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ratings, nil
+}
+
+func (repo *RatingRepository) GetPlayerRating(ctx context.Context, playerID uuid.UUID) (models.PlayerRating, bool, error) {
+	var rating models.PlayerRating
+
+	query := `
+		SELECT r.player_id, r.rating, r.games, r.updated_at,
+			(SELECT COUNT(*) + 1 FROM player_ratings o WHERE o.rating > r.rating)
+		FROM player_ratings r
+		WHERE r.player_id = $1;
+	`
+	err := repo.Tx.QueryRow(ctx, query, playerID).Scan(
+		&rating.PlayerID,
+		&rating.Rating,
+		&rating.Games,
+		&rating.UpdatedAt,
+		&rating.Rank,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return models.PlayerRating{}, false, nil
+		}
+
+		return models.PlayerRating{}, false, errors.WithStack(err)
+	}
+
+	return rating, true, nil
+}
+
+// RecordRatingChanges writes the rating changes of a game and moves the ratings of its players. The changes
+// of a game are only applied once, false is returned when they were already recorded.
+func (repo *RatingRepository) RecordRatingChanges(ctx context.Context, changes []models.RatingChange) (bool, error) {
+	if len(changes) == 0 {
+		return false, nil
+	}
+
+	gameID, finishedAt := changes[0].GameID, changes[0].FinishedAt
+	playerIDs := make([]uuid.UUID, 0, len(changes))
+	ratingsBefore := make([]float64, 0, len(changes))
+	ratingsAfter := make([]float64, 0, len(changes))
+	finishPositions := make([]int32, 0, len(changes))
+	for _, change := range changes {
+		if err := change.Validate(); err != nil {
+			return false, err
+		}
+
+		if change.GameID != gameID {
+			return false, errors.New("GameID: changes of several games")
+		}
+
+		playerIDs = append(playerIDs, change.PlayerID)
+		ratingsBefore = append(ratingsBefore, change.RatingBefore)
+		ratingsAfter = append(ratingsAfter, change.RatingAfter)
+		finishPositions = append(finishPositions, int32(change.FinishPosition))
+	}
+
+	// The history and the ratings are written by a single statement, the ratings only move
+	// for the history rows which were not already there.
+	query := `
+		WITH history AS (
+			INSERT INTO rating_history (
+				game_id,
+				player_id,
+				rating_before,
+				rating_after,
+				finish_position,
+				finished_at
+			)
+			SELECT $1, c.player_id, c.rating_before, c.rating_after, c.finish_position, $2
+			FROM UNNEST($3::UUID[], $4::DOUBLE PRECISION[], $5::DOUBLE PRECISION[], $6::INT[])
+				AS c(player_id, rating_before, rating_after, finish_position)
+			ON CONFLICT (game_id, player_id) DO NOTHING
+			RETURNING player_id, rating_after, finished_at
+		)
+		INSERT INTO player_ratings (player_id, rating, games, updated_at)
+		SELECT player_id, rating_after, 1, finished_at
+		FROM history
+		ON CONFLICT (player_id) DO UPDATE
+		SET rating = EXCLUDED.rating, games = player_ratings.games + 1, updated_at = EXCLUDED.updated_at;
+	`
+	tag, err := repo.Tx.Exec(ctx,
+		query,
+		gameID,
+		finishedAt,
+		stringsutil.ConvertUUIDsToStrings(playerIDs),
+		ratingsBefore,
+		ratingsAfter,
+		finishPositions,
+	)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListLeaderboard returns the best rated players first. When since is set, only the players who played
+// a rated game since then are listed, and ranked among themselves.
+func (repo *RatingRepository) ListLeaderboard(ctx context.Context, since *time.Time, offset int, limit int) ([]models.PlayerRating, error) {
+	query := `
+		SELECT r.player_id, r.rating, r.games, r.updated_at, RANK() OVER (ORDER BY r.rating DESC)
+		FROM player_ratings r
+		WHERE $1::TIMESTAMP WITH TIME ZONE IS NULL
+			OR EXISTS (SELECT 1 FROM rating_history h WHERE h.player_id = r.player_id AND h.finished_at >= $1)
+		ORDER BY r.rating DESC, r.player_id
+		OFFSET $2
+		LIMIT $3;
+	`
+	rows, err := repo.Tx.Query(ctx, query, since, offset, limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	ratings := make([]models.PlayerRating, 0, limit)
+	for rows.Next() {
+		var rating models.PlayerRating
+		if err := rows.Scan(
+			&rating.PlayerID,
+			&rating.Rating,
+			&rating.Games,
+			&rating.UpdatedAt,
+			&rating.Rank,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		ratings = append(ratings, rating)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ratings, nil
+}
+
+// ListRatingChanges returns the latest rating changes of a player, the most recent first.
+func (repo *RatingRepository) ListRatingChanges(ctx context.Context, playerID uuid.UUID, limit int) ([]models.RatingChange, error) {
+	query := `
+		SELECT game_id, player_id, rating_before, rating_after, finish_position, finished_at
+		FROM rating_history
+		WHERE player_id = $1
+		ORDER BY finished_at DESC, game_id DESC
+		LIMIT $2;
+	`
+	rows, err := repo.Tx.Query(ctx, query, playerID, limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	changes := make([]models.RatingChange, 0, limit)
+	for rows.Next() {
+		var change models.RatingChange
+		if err := rows.Scan(
+			&change.GameID,
+			&change.PlayerID,
+			&change.RatingBefore,
+			&change.RatingAfter,
+			&change.FinishPosition,
+			&change.FinishedAt,
+		); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return changes, nil
+}
+
+// Reset removes every rating and rating change, before the ratings are computed again from the matches.
+func (repo *RatingRepository) Reset(ctx context.Context) error {
+	_, err := repo.Tx.Exec(ctx, `TRUNCATE player_ratings, rating_history;`)
+	return errors.WithStack(err)
+}
@@ -1 +1,53 @@
 package lobby
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	eventing "github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/common"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/lobby"
+)
+
+// InteractionEvents are the game events the lobbies follow.
+var InteractionEvents = []common.EventType{
+	game.EventTypeGameFinished,
+}
+
+// InteractionProcessManager keeps the lobbies in step with their games, a lobby is told once its game is
+// finished so the participants can leave it or vote for a rematch.
+type InteractionProcessManager struct {
+	commandBus eventing.CommandHandler
+}
+
+var _ eventing.EventHandler = (*InteractionProcessManager)(nil)
+
+func NewInteractionProcessManager(commandBus eventing.CommandHandler) *InteractionProcessManager {
+	return &InteractionProcessManager{
+		commandBus: commandBus,
+	}
+}
+
+func (pm *InteractionProcessManager) HandlerType() common.EventHandlerType {
+	return common.EventHandlerType("lobby_interaction")
+}
+
+func (pm *InteractionProcessManager) HandleEvent(ctx context.Context, event common.Event) error {
+	data, ok := event.Data().(*game.GameFinished)
+	if !ok || data.GetLobbyID().IsNil() {
+		return nil
+	}
+
+	err := pm.commandBus.HandleCommand(ctx, &lobby.FinishGame{
+		LobbyID: data.GetLobbyID(),
+		GameID:  data.GetGameID(),
+	})
+	// The event is delivered again after a restart, the lobby already knows about the game by then.
+	if errors.Is(err, lobby.ErrLobbyNotPlaying) {
+		return nil
+	}
+
+	return err
+}
@@ -13,9 +13,11 @@ import (
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/constants"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/command_handler/bus"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domain-eventing/event_bus/nats"
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/game"
 	"github.com/sweetloveinyourheart/exploding-kittens/pkg/domains/lobby"
 	log "github.com/sweetloveinyourheart/exploding-kittens/pkg/logger"
 	"github.com/sweetloveinyourheart/exploding-kittens/services/lobby/domains"
+	lobbyDomain "github.com/sweetloveinyourheart/exploding-kittens/services/lobby/domains/lobby"
 )
 
 //go:embed migrations/*.sql
@@ -55,6 +57,12 @@ func InitializeCoreRepos(appID string, ctx context.Context) error {
 		return err
 	}
 
+	interaction := lobbyDomain.NewInteractionProcessManager(domains.CommandBus)
+	err = game.AddNATSGameEventHandler(ctx, appID, interaction, lobbyDomain.InteractionEvents)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
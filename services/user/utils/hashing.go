@@ -2,12 +2,12 @@ package utils
 
 import (
 	"crypto/rand"
-	"crypto/sha256"
 	"encoding/base64"
 	"math/big"
 
 	"github.com/gofrs/uuid"
-	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/sweetloveinyourheart/exploding-kittens/pkg/password"
 )
 
 // This file contains the hashing and random string genration functions, this should be
@@ -17,9 +17,7 @@ const (
 	RAND_UPPER_CASE = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	RAND_LOWER_CASE = "abcdefghijklmnopqrstuvwxyz"
 
-	SYSTEM_SALT              = "cWNZhnAiHeO8Y6UFW2HKor5WE6vbq8SC"
-	PASSWORD_HASH_ITERATIONS = 1000
-	SESSION_HASH_ITERATIONS  = 10
+	SESSION_HASH_ITERATIONS = 10
 )
 
 // Generates a random string of inputed length
@@ -57,17 +55,17 @@ func SessionHash(userId uuid.UUID) (string, error) {
 		return "", err
 	}
 
-	hashBase := make([]byte, 0, len(randomStr)+len(SYSTEM_SALT)+len(userId))
+	hashBase := make([]byte, 0, len(randomStr)+len(password.SystemSalt)+len(userId))
 	hashBase = append(hashBase, []byte(randomStr)...)
 
 	// Add system salt
-	hashBase = append(hashBase, []byte(SYSTEM_SALT)...)
+	hashBase = append(hashBase, []byte(password.SystemSalt)...)
 
 	// Add user id
 	hashBase = append(hashBase, userId.Bytes()...)
 
 	// Run pbkdf2 some amount of times
-	hashBytes := pbkdf2.Key(hashBase, []byte(SYSTEM_SALT), PASSWORD_HASH_ITERATIONS, 32, sha256.New)
+	hashBytes := password.Key(hashBase, []byte(password.SystemSalt))
 
 	// Convert to string
 	encodedHash := base64.URLEncoding.EncodeToString(hashBytes)